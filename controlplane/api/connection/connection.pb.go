@@ -340,11 +340,13 @@ func (m *Connection) GetState() State {
 }
 
 type ConnectionEvent struct {
-	Type                 ConnectionEventType    `protobuf:"varint,1,opt,name=type,proto3,enum=connection.ConnectionEventType" json:"type,omitempty"`
-	Connections          map[string]*Connection `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Type        ConnectionEventType    `protobuf:"varint,1,opt,name=type,proto3,enum=connection.ConnectionEventType" json:"type,omitempty"`
+	Connections map[string]*Connection `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// revision - monotonically increasing revision of a monitor server state after the event, 0 if the event can't be resumed from
+	Revision             uint64   `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ConnectionEvent) Reset()         { *m = ConnectionEvent{} }
@@ -386,11 +388,31 @@ func (m *ConnectionEvent) GetConnections() map[string]*Connection {
 	return nil
 }
 
+func (m *ConnectionEvent) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// MonitorScopeSelector - filters monitored connections, empty criteria match any connection
 type MonitorScopeSelector struct {
-	PathSegments         []*PathSegment `protobuf:"bytes,1,rep,name=path_segments,json=pathSegments,proto3" json:"path_segments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PathSegments []*PathSegment `protobuf:"bytes,1,rep,name=path_segments,json=pathSegments,proto3" json:"path_segments,omitempty"`
+	// network_service - name of a network service of a connection
+	NetworkService string `protobuf:"bytes,2,opt,name=network_service,json=networkService,proto3" json:"network_service,omitempty"`
+	// labels - labels a connection should have, each of them with the same value
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// connection_ids - ids of connections
+	ConnectionIds []string `protobuf:"bytes,4,rep,name=connection_ids,json=connectionIds,proto3" json:"connection_ids,omitempty"`
+	// network_service_endpoint_name - name of an endpoint a connection is established with
+	NetworkServiceEndpointName string `protobuf:"bytes,5,opt,name=network_service_endpoint_name,json=networkServiceEndpointName,proto3" json:"network_service_endpoint_name,omitempty"`
+	// resume_from_revision - revision of the last event received before reconnect, only events after it are sent if server
+	// still keeps them, otherwise INITIAL_STATE_TRANSFER is sent. Filtered streams are never resumed and always start
+	// with INITIAL_STATE_TRANSFER
+	ResumeFromRevision   uint64   `protobuf:"varint,6,opt,name=resume_from_revision,json=resumeFromRevision,proto3" json:"resume_from_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MonitorScopeSelector) Reset()         { *m = MonitorScopeSelector{} }
@@ -425,6 +447,41 @@ func (m *MonitorScopeSelector) GetPathSegments() []*PathSegment {
 	return nil
 }
 
+func (m *MonitorScopeSelector) GetNetworkService() string {
+	if m != nil {
+		return m.NetworkService
+	}
+	return ""
+}
+
+func (m *MonitorScopeSelector) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *MonitorScopeSelector) GetConnectionIds() []string {
+	if m != nil {
+		return m.ConnectionIds
+	}
+	return nil
+}
+
+func (m *MonitorScopeSelector) GetNetworkServiceEndpointName() string {
+	if m != nil {
+		return m.NetworkServiceEndpointName
+	}
+	return ""
+}
+
+func (m *MonitorScopeSelector) GetResumeFromRevision() uint64 {
+	if m != nil {
+		return m.ResumeFromRevision
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("connection.State", State_name, State_value)
 	proto.RegisterEnum("connection.ConnectionEventType", ConnectionEventType_name, ConnectionEventType_value)
@@ -437,57 +494,63 @@ func init() {
 	proto.RegisterType((*ConnectionEvent)(nil), "connection.ConnectionEvent")
 	proto.RegisterMapType((map[string]*Connection)(nil), "connection.ConnectionEvent.ConnectionsEntry")
 	proto.RegisterType((*MonitorScopeSelector)(nil), "connection.MonitorScopeSelector")
+	proto.RegisterMapType((map[string]string)(nil), "connection.MonitorScopeSelector.LabelsEntry")
 }
 
 func init() { proto.RegisterFile("connection.proto", fileDescriptor_51baa40a1cc6b48b) }
 
 var fileDescriptor_51baa40a1cc6b48b = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdb, 0x6e, 0xdb, 0x46,
-	0x10, 0x35, 0x75, 0x73, 0x35, 0xf2, 0x85, 0xde, 0xba, 0x2e, 0xcb, 0xa2, 0xa8, 0x20, 0xb8, 0xb0,
-	0x60, 0x18, 0x54, 0x21, 0xf7, 0xa1, 0x35, 0x9a, 0x00, 0x8a, 0xcd, 0x00, 0x02, 0x6c, 0x45, 0xa0,
-	0xe8, 0x04, 0xf0, 0x8b, 0x40, 0x51, 0x13, 0x89, 0x11, 0xb9, 0x4b, 0x90, 0x6b, 0xc7, 0x7a, 0xc8,
-	0x77, 0xe5, 0x03, 0xf2, 0x3f, 0xf9, 0x86, 0x80, 0xcb, 0x95, 0x48, 0x4b, 0x82, 0x01, 0xbf, 0xed,
-	0xce, 0xce, 0xec, 0x99, 0x73, 0xf6, 0x0c, 0x09, 0xaa, 0xcb, 0x28, 0x45, 0x97, 0x7b, 0x8c, 0x1a,
-	0x61, 0xc4, 0x38, 0x23, 0x90, 0x45, 0xf4, 0x7a, 0xc8, 0xe7, 0x21, 0xc6, 0x2d, 0xee, 0x05, 0x18,
-	0x73, 0x27, 0x08, 0xb3, 0x55, 0x9a, 0xad, 0xcf, 0x26, 0x1e, 0x9f, 0xde, 0x8f, 0x0c, 0x97, 0x05,
-	0x2d, 0x8a, 0xfc, 0x33, 0x8b, 0x66, 0x31, 0x46, 0x0f, 0x9e, 0x8b, 0x01, 0xc6, 0xd3, 0x4d, 0x21,
-	0x97, 0x51, 0x1e, 0x31, 0x3f, 0xf4, 0x1d, 0x8a, 0x2d, 0x27, 0xf4, 0x5a, 0x19, 0x5e, 0x72, 0x84,
-	0x8f, 0x7c, 0x3d, 0x92, 0x82, 0x35, 0xbe, 0x2a, 0x50, 0xbd, 0x41, 0x77, 0xea, 0x50, 0x2f, 0x0e,
-	0x88, 0x0a, 0x45, 0xd7, 0x8f, 0x35, 0xa5, 0xae, 0x34, 0xab, 0x56, 0xb2, 0x24, 0x04, 0x4a, 0x49,
-	0xbf, 0x5a, 0x41, 0x84, 0xc4, 0x9a, 0x98, 0x00, 0xa1, 0x13, 0x39, 0x01, 0x72, 0x8c, 0x62, 0xad,
-	0x58, 0x2f, 0x36, 0x6b, 0xed, 0xbf, 0x8c, 0x1c, 0xeb, 0xe5, 0x85, 0x46, 0x7f, 0x99, 0x67, 0x52,
-	0x1e, 0xcd, 0xad, 0x5c, 0xa1, 0xfe, 0x0a, 0xf6, 0x57, 0x8e, 0x13, 0xfc, 0x19, 0xce, 0x17, 0xf8,
-	0x33, 0x9c, 0x93, 0x43, 0x28, 0x3f, 0x38, 0xfe, 0xfd, 0xa2, 0x81, 0x74, 0x73, 0x51, 0xf8, 0x57,
-	0x69, 0x7c, 0x81, 0x5a, 0xdf, 0xe1, 0xd3, 0x01, 0x4e, 0x02, 0xa4, 0x3c, 0x69, 0x94, 0x3a, 0x01,
-	0xca, 0x5a, 0xb1, 0x26, 0x7b, 0x50, 0xf0, 0xc6, 0xb2, 0xb2, 0xe0, 0x8d, 0x93, 0xcb, 0x38, 0x9b,
-	0x21, 0xd5, 0x8a, 0xe9, 0x65, 0x62, 0x43, 0xfe, 0x81, 0x6d, 0x7c, 0x0c, 0xbd, 0x08, 0x63, 0xad,
-	0x54, 0x57, 0x9a, 0xb5, 0xb6, 0x6e, 0x4c, 0x18, 0x9b, 0xf8, 0x98, 0x4a, 0x34, 0xba, 0xff, 0x68,
-	0xd8, 0x8b, 0x27, 0xb2, 0x16, 0xa9, 0x8d, 0x3b, 0x28, 0x25, 0xf0, 0xc9, 0x9d, 0x1e, 0x1d, 0xe3,
-	0xa3, 0x00, 0xde, 0xb5, 0xd2, 0x0d, 0xf9, 0x1f, 0x76, 0x43, 0x87, 0x4f, 0x87, 0x71, 0xda, 0x5d,
-	0xac, 0x15, 0x84, 0x4a, 0xbf, 0xe6, 0x55, 0xca, 0x75, 0x6f, 0xed, 0x84, 0xd9, 0x26, 0x6e, 0x7c,
-	0x2b, 0x02, 0x5c, 0x2e, 0x13, 0x25, 0x0d, 0x65, 0x49, 0xe3, 0x04, 0xf6, 0xa5, 0x09, 0x86, 0xd2,
-	0x05, 0x92, 0xe3, 0x9e, 0x0c, 0x0f, 0xd2, 0x28, 0x39, 0x87, 0x6a, 0xb0, 0x78, 0x0a, 0xc1, 0xb9,
-	0xd6, 0xfe, 0x65, 0xe3, 0x3b, 0x59, 0x59, 0x1e, 0x79, 0x0d, 0xdb, 0xd2, 0x22, 0x52, 0x8e, 0x63,
-	0x63, 0xdd, 0x3c, 0x59, 0x77, 0x97, 0x69, 0xc4, 0x5a, 0x14, 0x91, 0x0b, 0xa8, 0xf8, 0xce, 0x08,
-	0xfd, 0x58, 0x2b, 0x0b, 0xce, 0x8d, 0x3c, 0x62, 0x56, 0x67, 0x5c, 0x8b, 0xa4, 0xd4, 0x16, 0xb2,
-	0x82, 0x1c, 0x43, 0x29, 0x11, 0x42, 0xab, 0x08, 0x60, 0x75, 0x55, 0x2d, 0x4b, 0x9c, 0x92, 0x0e,
-	0xfc, 0xb1, 0xc2, 0x7f, 0x88, 0x74, 0x1c, 0x32, 0x8f, 0xf2, 0xa1, 0xf0, 0xc0, 0xb6, 0x50, 0x43,
-	0x7f, 0xaa, 0x86, 0x29, 0x53, 0x7a, 0x89, 0x33, 0x4e, 0xa0, 0x1c, 0x73, 0x87, 0xa3, 0x56, 0xad,
-	0x2b, 0xcd, 0xbd, 0xf6, 0x41, 0x1e, 0x69, 0x90, 0x1c, 0x58, 0xe9, 0xb9, 0xfe, 0x1f, 0xd4, 0x72,
-	0x8d, 0xbe, 0xc8, 0xa0, 0xdf, 0x15, 0xd8, 0xcf, 0xf8, 0x9a, 0x0f, 0x89, 0x4b, 0xcf, 0xe5, 0x38,
-	0x29, 0x02, 0xf6, 0xcf, 0xcd, 0xd2, 0x88, 0x54, 0x7b, 0x1e, 0xa2, 0x9c, 0xb7, 0x1e, 0xd4, 0xb2,
-	0xbc, 0x85, 0x95, 0xce, 0x9e, 0xa9, 0xcd, 0xed, 0xa5, 0xc0, 0xf9, 0x0b, 0xf4, 0xf7, 0xa0, 0xae,
-	0x26, 0x6c, 0x20, 0x76, 0x96, 0x27, 0x56, 0x6b, 0x1f, 0x6d, 0xc6, 0xcb, 0x13, 0xb6, 0xe1, 0xf0,
-	0x86, 0x51, 0x8f, 0xb3, 0x68, 0xe0, 0xb2, 0x10, 0x07, 0xe8, 0xa3, 0xcb, 0x59, 0xb4, 0x3e, 0x0c,
-	0xca, 0x0b, 0x86, 0xe1, 0xf4, 0x37, 0x28, 0x8b, 0x17, 0x21, 0x15, 0x28, 0xdc, 0xf6, 0xd5, 0x2d,
-	0xf2, 0x13, 0x94, 0xae, 0xde, 0x7d, 0xe8, 0xa9, 0xca, 0x69, 0x17, 0x7e, 0xde, 0xa0, 0x1a, 0xd1,
-	0xe1, 0xa8, 0xdb, 0xeb, 0xda, 0xdd, 0xce, 0xf5, 0x70, 0x60, 0x77, 0x6c, 0x73, 0x68, 0x5b, 0x9d,
-	0xde, 0xe0, 0xad, 0x69, 0xa9, 0x5b, 0x04, 0xa0, 0x72, 0xdb, 0xbf, 0xea, 0xd8, 0xa6, 0xaa, 0x24,
-	0xeb, 0x2b, 0xf3, 0xda, 0xb4, 0x4d, 0xb5, 0xd0, 0xfe, 0x04, 0x07, 0xb2, 0xf7, 0xdc, 0xe0, 0xdd,
-	0x02, 0x59, 0x0b, 0xc6, 0xa4, 0xfe, 0x64, 0x84, 0x36, 0x10, 0xd6, 0x7f, 0x7f, 0xe6, 0x6d, 0xfe,
-	0x56, 0xde, 0xec, 0xdc, 0xe5, 0x7e, 0x08, 0xa3, 0x8a, 0xf8, 0xca, 0x9c, 0xff, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0xc6, 0x90, 0x3d, 0x37, 0x06, 0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x8e, 0xdb, 0x54,
+	0x10, 0xae, 0xed, 0x24, 0xdb, 0x4c, 0xba, 0xbb, 0xee, 0x61, 0x29, 0xc6, 0x08, 0x11, 0x45, 0xad,
+	0x1a, 0x55, 0x95, 0xb7, 0xca, 0x72, 0x01, 0x15, 0x20, 0x85, 0xc6, 0x95, 0x22, 0x6d, 0x43, 0xe4,
+	0x78, 0x41, 0xea, 0x8d, 0xe5, 0x38, 0xd3, 0xc4, 0xc4, 0xf6, 0xb1, 0x7c, 0x4e, 0xc2, 0xe6, 0x82,
+	0x17, 0xe0, 0x11, 0x78, 0x11, 0x1e, 0x80, 0x17, 0x43, 0x3e, 0xb6, 0x63, 0x93, 0x58, 0x5d, 0x96,
+	0xbb, 0x33, 0xff, 0x33, 0xdf, 0x7c, 0x63, 0x83, 0xea, 0xd1, 0x28, 0x42, 0x8f, 0xfb, 0x34, 0x32,
+	0xe2, 0x84, 0x72, 0x4a, 0xa0, 0xd4, 0xe8, 0xdd, 0x98, 0xef, 0x62, 0x64, 0x97, 0xdc, 0x0f, 0x91,
+	0x71, 0x37, 0x8c, 0xcb, 0x57, 0xe6, 0xad, 0xaf, 0x97, 0x3e, 0x5f, 0x6d, 0xe6, 0x86, 0x47, 0xc3,
+	0xcb, 0x08, 0xf9, 0x6f, 0x34, 0x59, 0x33, 0x4c, 0xb6, 0xbe, 0x87, 0x21, 0xb2, 0x55, 0x9d, 0xca,
+	0xa3, 0x11, 0x4f, 0x68, 0x10, 0x07, 0x6e, 0x84, 0x97, 0x6e, 0xec, 0x5f, 0x96, 0xf5, 0x52, 0x13,
+	0xde, 0xf2, 0x63, 0x4d, 0x56, 0xac, 0xf7, 0x97, 0x04, 0xed, 0x77, 0xe8, 0xad, 0xdc, 0xc8, 0x67,
+	0x21, 0x51, 0x41, 0xf1, 0x02, 0xa6, 0x49, 0x5d, 0xa9, 0xdf, 0xb6, 0xd2, 0x27, 0x21, 0xd0, 0x48,
+	0xfb, 0xd5, 0x64, 0xa1, 0x12, 0x6f, 0x62, 0x02, 0xc4, 0x6e, 0xe2, 0x86, 0xc8, 0x31, 0x61, 0x9a,
+	0xd2, 0x55, 0xfa, 0x9d, 0xc1, 0x33, 0xa3, 0x32, 0xf5, 0x3e, 0xa1, 0x31, 0xdd, 0xfb, 0x99, 0x11,
+	0x4f, 0x76, 0x56, 0x25, 0x50, 0xff, 0x1e, 0xce, 0x0f, 0xcc, 0x69, 0xfd, 0x35, 0xee, 0x8a, 0xfa,
+	0x6b, 0xdc, 0x91, 0x0b, 0x68, 0x6e, 0xdd, 0x60, 0x53, 0x34, 0x90, 0x09, 0xaf, 0xe5, 0x6f, 0xa4,
+	0xde, 0xef, 0xd0, 0x99, 0xba, 0x7c, 0x35, 0xc3, 0x65, 0x88, 0x11, 0x4f, 0x1b, 0x8d, 0xdc, 0x10,
+	0xf3, 0x58, 0xf1, 0x26, 0x67, 0x20, 0xfb, 0x8b, 0x3c, 0x52, 0xf6, 0x17, 0x69, 0x32, 0x4e, 0xd7,
+	0x18, 0x69, 0x4a, 0x96, 0x4c, 0x08, 0xe4, 0x6b, 0x38, 0xc1, 0xdb, 0xd8, 0x4f, 0x90, 0x69, 0x8d,
+	0xae, 0xd4, 0xef, 0x0c, 0x74, 0x63, 0x49, 0xe9, 0x32, 0xc0, 0x0c, 0xa2, 0xf9, 0xe6, 0x83, 0x61,
+	0x17, 0x2b, 0xb2, 0x0a, 0xd7, 0xde, 0x7b, 0x68, 0xa4, 0xe5, 0xd3, 0x9c, 0x7e, 0xb4, 0xc0, 0x5b,
+	0x51, 0xf8, 0xd4, 0xca, 0x04, 0xf2, 0x1d, 0x9c, 0xc6, 0x2e, 0x5f, 0x39, 0x2c, 0xeb, 0x8e, 0x69,
+	0xb2, 0x40, 0xe9, 0xb3, 0x2a, 0x4a, 0x95, 0xee, 0xad, 0x47, 0x71, 0x29, 0xb0, 0xde, 0xdf, 0x0a,
+	0xc0, 0x9b, 0xbd, 0x63, 0x3e, 0x86, 0xb4, 0x1f, 0xe3, 0x39, 0x9c, 0xe7, 0x24, 0x70, 0x72, 0x16,
+	0xe4, 0x33, 0x9e, 0xe5, 0xea, 0x59, 0xa6, 0x25, 0x57, 0xd0, 0x0e, 0x8b, 0x55, 0x88, 0x99, 0x3b,
+	0x83, 0x4f, 0x6b, 0xf7, 0x64, 0x95, 0x7e, 0xe4, 0x07, 0x38, 0xc9, 0x29, 0x92, 0xc3, 0xf1, 0xd4,
+	0x38, 0x26, 0x4f, 0xd9, 0xdd, 0x9b, 0x4c, 0x63, 0x15, 0x41, 0xe4, 0x35, 0xb4, 0x02, 0x77, 0x8e,
+	0x01, 0xd3, 0x9a, 0x62, 0xe6, 0x5e, 0xb5, 0x62, 0x19, 0x67, 0x5c, 0x0b, 0xa7, 0x8c, 0x16, 0x79,
+	0x04, 0x79, 0x0a, 0x8d, 0x14, 0x08, 0xad, 0x25, 0x0a, 0xab, 0x87, 0x68, 0x59, 0xc2, 0x4a, 0x86,
+	0xf0, 0xe5, 0xc1, 0xfc, 0x0e, 0x46, 0x8b, 0x98, 0xfa, 0x11, 0x77, 0x04, 0x07, 0x4e, 0x04, 0x1a,
+	0xfa, 0xbf, 0xd1, 0x30, 0x73, 0x97, 0x49, 0xca, 0x8c, 0xe7, 0xd0, 0x64, 0xdc, 0xe5, 0xa8, 0xb5,
+	0xbb, 0x52, 0xff, 0x6c, 0xf0, 0xb8, 0x5a, 0x69, 0x96, 0x1a, 0xac, 0xcc, 0xae, 0x7f, 0x0b, 0x9d,
+	0x4a, 0xa3, 0xf7, 0x22, 0xe8, 0x1f, 0x32, 0x9c, 0x97, 0xf3, 0x9a, 0xdb, 0x94, 0xa5, 0x57, 0xf9,
+	0x39, 0x49, 0xa2, 0xec, 0x57, 0xf5, 0xd0, 0x08, 0x57, 0x7b, 0x17, 0x63, 0x7e, 0x6f, 0x13, 0xe8,
+	0x94, 0x7e, 0x05, 0x95, 0x5e, 0x7e, 0x24, 0xb6, 0x22, 0xe7, 0x00, 0x57, 0x13, 0x10, 0x1d, 0x1e,
+	0x26, 0xb8, 0xf5, 0x99, 0x4f, 0xb3, 0x4b, 0x68, 0x58, 0x7b, 0x59, 0xff, 0x19, 0xd4, 0xc3, 0xe0,
+	0x9a, 0xa1, 0x5f, 0x56, 0x87, 0xee, 0x0c, 0x9e, 0xd4, 0xf7, 0x52, 0x05, 0xe3, 0x4f, 0x05, 0x2e,
+	0xde, 0xd1, 0xc8, 0xe7, 0x34, 0x99, 0x79, 0x34, 0xc6, 0x19, 0x06, 0xe8, 0x71, 0x9a, 0x1c, 0x5f,
+	0x8a, 0x74, 0x8f, 0x4b, 0xf9, 0xef, 0xa7, 0x30, 0xda, 0xb3, 0x52, 0x39, 0x86, 0xaf, 0xae, 0xb1,
+	0x5a, 0x7e, 0x3e, 0x83, 0xb3, 0x32, 0xcc, 0xf1, 0x17, 0xe9, 0x17, 0x43, 0xe9, 0xb7, 0xad, 0xd3,
+	0x52, 0x3b, 0x5e, 0xb0, 0xbb, 0x09, 0xda, 0xbc, 0x93, 0xa0, 0xaf, 0xe0, 0x22, 0x41, 0xb6, 0x09,
+	0xd1, 0xf9, 0x90, 0xd0, 0xd0, 0xd9, 0xef, 0xab, 0x25, 0xf6, 0x45, 0x32, 0xdb, 0xdb, 0x84, 0x86,
+	0x56, 0xb1, 0xb9, 0xff, 0xcf, 0xd4, 0x17, 0x9f, 0x43, 0x53, 0x90, 0x9e, 0xb4, 0x40, 0xbe, 0x99,
+	0xaa, 0x0f, 0xc8, 0x43, 0x68, 0x8c, 0x7e, 0xfa, 0x65, 0xa2, 0x4a, 0x2f, 0xc6, 0xf0, 0x49, 0x0d,
+	0x31, 0x89, 0x0e, 0x4f, 0xc6, 0x93, 0xb1, 0x3d, 0x1e, 0x5e, 0x3b, 0x33, 0x7b, 0x68, 0x9b, 0x8e,
+	0x6d, 0x0d, 0x27, 0xb3, 0xb7, 0xa6, 0xa5, 0x3e, 0x20, 0x00, 0xad, 0x9b, 0xe9, 0x68, 0x68, 0x9b,
+	0xaa, 0x94, 0xbe, 0x47, 0xe6, 0xb5, 0x69, 0x9b, 0xaa, 0x3c, 0xf8, 0x15, 0x1e, 0xe7, 0x40, 0x97,
+	0x19, 0xc9, 0x0d, 0x90, 0x23, 0x25, 0x23, 0xdd, 0xbb, 0xb6, 0xa3, 0x7f, 0xf1, 0x11, 0xfa, 0xbf,
+	0x92, 0x7e, 0x7c, 0xf4, 0xbe, 0xf2, 0xcf, 0x9d, 0xb7, 0xc4, 0x87, 0xfc, 0xea, 0x9f, 0x01, 0x00,
+	0x4f, 0x44, 0xe6, 0x3e, 0x9a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
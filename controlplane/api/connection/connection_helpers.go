@@ -58,6 +58,26 @@ func (c *Connection) Equals(connection *Connection) bool {
 	return proto.Equal(c, connection)
 }
 
+// IsRefreshOf returns if connection is requested again with nothing changed comparing to established one:
+// it has the same id, network service, labels, context and mechanism type, path is not compared
+func (c *Connection) IsRefreshOf(established *Connection) bool {
+	if c == nil || established == nil || c.GetMechanism() == nil {
+		return false
+	}
+	if c.GetId() != established.GetId() ||
+		c.GetNetworkService() != established.GetNetworkService() ||
+		c.GetMechanism().GetType() != established.GetMechanism().GetType() ||
+		len(c.GetLabels()) != len(established.GetLabels()) {
+		return false
+	}
+	for key, value := range c.GetLabels() {
+		if established.GetLabels()[key] != value {
+			return false
+		}
+	}
+	return proto.Equal(c.GetContext(), established.GetContext())
+}
+
 // Clone clones connection
 func (c *Connection) Clone() *Connection {
 	return proto.Clone(c).(*Connection)
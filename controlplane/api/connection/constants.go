@@ -23,4 +23,7 @@ const (
 	PodNameKey = "podName"
 	// NamespaceKey - namespace a container is running in
 	NamespaceKey = "namespace"
+	// RemoteMechanismKey - type of remote mechanism a connection requires, set as a label of client connection or
+	// of endpoint
+	RemoteMechanismKey = "remoteMechanism"
 )
@@ -4,6 +4,11 @@ const (
 	SrcIP = "src_ip"
 	DstIP = "dst_ip"
 
+	// SrcMTU - MTU of the egress interface of source forwarder remote mechanism property key
+	SrcMTU = "src_mtu"
+	// DstMTU - MTU of the egress interface of destination forwarder remote mechanism property key
+	DstMTU = "dst_mtu"
+
 	// NetNsInodeKey - netns inode mechanism property key
 	NetNsInodeKey = "netnsInode"
 	// Workspace - NSM workspace location mechanism property key
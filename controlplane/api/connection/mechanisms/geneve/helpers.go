@@ -0,0 +1,93 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geneve
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+)
+
+// Mechanism - GENEVE mechanism helper
+type Mechanism interface {
+	// SrcIP - src ip
+	SrcIP() (string, error)
+	// DstIP - dst ip
+	DstIP() (string, error)
+	// VNI - vni
+	VNI() (uint32, error)
+}
+
+type mechanism struct {
+	*connection.Mechanism
+}
+
+func init() {
+	connection.AddMechanism(MECHANISM, validate)
+}
+
+// ToMechanism - convert unified mechanism to helper
+func ToMechanism(m *connection.Mechanism) Mechanism {
+	if m.GetType() == MECHANISM {
+		return &mechanism{
+			m,
+		}
+	}
+	return nil
+}
+
+func (m *mechanism) SrcIP() (string, error) {
+	return common.GetSrcIP(m.Mechanism)
+}
+
+func (m *mechanism) DstIP() (string, error) {
+	return common.GetDstIP(m.Mechanism)
+}
+
+func (m *mechanism) VNI() (uint32, error) {
+	if m.GetParameters() == nil {
+		return 0, errors.Errorf("mechanism.Parameters cannot be nil: %v", m)
+	}
+
+	geneveVNI, ok := m.Parameters[VNI]
+	if !ok {
+		return 0, errors.Errorf("mechanism.Type %s requires mechanism.Parameters[%s]", m.GetType(), VNI)
+	}
+
+	vni, err := strconv.ParseUint(geneveVNI, 10, 24)
+	if err != nil || vni == 0 {
+		return 0, errors.Errorf("mechanism.Parameters[%s] must be a valid non zero 24-bit unsigned integer, instead was: %s: %v", VNI, geneveVNI, m)
+	}
+
+	return uint32(vni), nil
+}
+
+// validate - checks that GENEVE mechanism has source, destination IPs and VNI
+func validate(m *connection.Mechanism) error {
+	helper := ToMechanism(m)
+	if _, err := helper.SrcIP(); err != nil {
+		return err
+	}
+	if _, err := helper.DstIP(); err != nil {
+		return err
+	}
+	_, err := helper.VNI()
+	return err
+}
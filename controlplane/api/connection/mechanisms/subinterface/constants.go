@@ -0,0 +1,52 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package subinterface - sub-interface local mechanism, a macvlan or ipvlan sub-interface of a host interface is
+// injected directly into the client namespace
+package subinterface
+
+import (
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+)
+
+const (
+	// MECHANISM string
+	MECHANISM = "SUB_INTERFACE"
+
+	// Mechanism parameters
+
+	// NetNsInodeKey - net ns inode of the client
+	NetNsInodeKey = common.NetNsInodeKey
+	// InterfaceNameKey - name of the interface in the client namespace
+	InterfaceNameKey = common.InterfaceNameKey
+	// Parent - name of the host interface sub-interfaces are created on
+	Parent = "parent"
+	// Kind - kind of the sub-interface, macvlan or ipvlan
+	Kind = "kind"
+	// Mode - mode of the sub-interface, depends on the kind
+	Mode = "mode"
+
+	// KindMacvlan - macvlan sub-interface
+	KindMacvlan = "macvlan"
+	// KindIpvlan - ipvlan sub-interface
+	KindIpvlan = "ipvlan"
+)
+
+// Modes - modes supported by each kind of sub-interfaces, first one is the default
+var Modes = map[string][]string{
+	KindMacvlan: {"bridge", "private", "vepa", "passthru"},
+	KindIpvlan:  {"l2", "l3", "l3s"},
+}
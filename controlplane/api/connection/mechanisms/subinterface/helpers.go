@@ -0,0 +1,101 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subinterface
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+)
+
+// Mechanism - sub-interface mechanism helper
+type Mechanism interface {
+	// GetNetNsInode - return net ns inode
+	GetNetNsInode() string
+	// Parent - name of the host interface
+	Parent() (string, error)
+	// Kind - kind of the sub-interface
+	Kind() (string, error)
+	// Mode - mode of the sub-interface, the default mode of the kind if not set
+	Mode() (string, error)
+}
+
+type mechanism struct {
+	*connection.Mechanism
+}
+
+func init() {
+	connection.AddMechanism(MECHANISM, validate)
+}
+
+// ToMechanism - convert unified mechanism to helper
+func ToMechanism(m *connection.Mechanism) Mechanism {
+	if m.GetType() == MECHANISM {
+		return &mechanism{
+			m,
+		}
+	}
+	return nil
+}
+
+func (m *mechanism) GetNetNsInode() string {
+	return m.GetParameters()[common.NetNsInodeKey]
+}
+
+func (m *mechanism) Parent() (string, error) {
+	parent := m.GetParameters()[Parent]
+	if parent == "" {
+		return "", errors.Errorf("mechanism.Type %s requires mechanism.Parameters[%s]", m.GetType(), Parent)
+	}
+	return parent, nil
+}
+
+func (m *mechanism) Kind() (string, error) {
+	kind := m.GetParameters()[Kind]
+	if _, ok := Modes[kind]; !ok {
+		return "", errors.Errorf("mechanism.Parameters[%s] must be either %s or %s, instead was: %s", Kind, KindMacvlan, KindIpvlan, kind)
+	}
+	return kind, nil
+}
+
+func (m *mechanism) Mode() (string, error) {
+	kind, err := m.Kind()
+	if err != nil {
+		return "", err
+	}
+	mode, ok := m.GetParameters()[Mode]
+	if !ok || mode == "" {
+		return Modes[kind][0], nil
+	}
+	for _, supported := range Modes[kind] {
+		if mode == supported {
+			return mode, nil
+		}
+	}
+	return "", errors.Errorf("mechanism.Parameters[%s] must be one of %v for %s, instead was: %s", Mode, Modes[kind], kind, mode)
+}
+
+// validate - checks that sub-interface mechanism has a parent interface and a mode supported by its kind
+func validate(m *connection.Mechanism) error {
+	helper := ToMechanism(m)
+	if _, err := helper.Parent(); err != nil {
+		return err
+	}
+	_, err := helper.Mode()
+	return err
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wireguard - WireGuard remote mechanism, connections are encrypted with keys of forwarders on both ends
+package wireguard
+
+import (
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+)
+
+const (
+	// MECHANISM string
+	MECHANISM = "WIREGUARD"
+
+	// Mechanism parameters
+
+	// SrcIP - source IP
+	SrcIP = common.SrcIP
+	// DstIP - destination IP
+	DstIP = common.DstIP
+	// SrcPort - UDP port source forwarder listens on for the connection
+	SrcPort = "src_port"
+	// DstPort - UDP port destination forwarder listens on for the connection
+	DstPort = "dst_port"
+	// SrcPublicKey - base64 encoded public key of source forwarder
+	SrcPublicKey = "src_public_key"
+	// DstPublicKey - base64 encoded public key of destination forwarder
+	DstPublicKey = "dst_public_key"
+	// AllowedIPs - comma separated list of CIDRs peers are allowed to send from and to
+	AllowedIPs = "allowed_ips"
+
+	// DefaultAllowedIPs - every connection has its own interface, so any traffic routed to it is allowed
+	DefaultAllowedIPs = "0.0.0.0/0,::/0"
+	// KeyLength - length of WireGuard keys in bytes
+	KeyLength = 32
+)
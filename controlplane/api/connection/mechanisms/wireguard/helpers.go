@@ -0,0 +1,180 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wireguard
+
+import (
+	"encoding/base64"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+)
+
+// Mechanism - WireGuard mechanism helper
+type Mechanism interface {
+	// SrcIP - src ip
+	SrcIP() (string, error)
+	// DstIP - dst ip
+	DstIP() (string, error)
+	// SrcPort - src port
+	SrcPort() (int, error)
+	// DstPort - dst port
+	DstPort() (int, error)
+	// SrcPublicKey - decoded src public key
+	SrcPublicKey() ([]byte, error)
+	// DstPublicKey - decoded dst public key
+	DstPublicKey() ([]byte, error)
+	// AllowedIPs - allowed ips, DefaultAllowedIPs if not set
+	AllowedIPs() ([]*net.IPNet, error)
+}
+
+type mechanism struct {
+	*connection.Mechanism
+}
+
+func init() {
+	connection.AddMechanism(MECHANISM, validate)
+}
+
+// ToMechanism - convert unified mechanism to helper
+func ToMechanism(m *connection.Mechanism) Mechanism {
+	if m.GetType() == MECHANISM {
+		return &mechanism{
+			m,
+		}
+	}
+	return nil
+}
+
+func (m *mechanism) SrcIP() (string, error) {
+	return common.GetSrcIP(m.Mechanism)
+}
+
+func (m *mechanism) DstIP() (string, error) {
+	return common.GetDstIP(m.Mechanism)
+}
+
+func (m *mechanism) SrcPort() (int, error) {
+	return getPortParameter(m.Mechanism, SrcPort)
+}
+
+func (m *mechanism) DstPort() (int, error) {
+	return getPortParameter(m.Mechanism, DstPort)
+}
+
+func (m *mechanism) SrcPublicKey() ([]byte, error) {
+	return getKeyParameter(m.Mechanism, SrcPublicKey)
+}
+
+func (m *mechanism) DstPublicKey() ([]byte, error) {
+	return getKeyParameter(m.Mechanism, DstPublicKey)
+}
+
+func (m *mechanism) AllowedIPs() ([]*net.IPNet, error) {
+	allowedIPs := m.GetParameters()[AllowedIPs]
+	if allowedIPs == "" {
+		allowedIPs = DefaultAllowedIPs
+	}
+	var rv []*net.IPNet
+	for _, cidr := range strings.Split(allowedIPs, ",") {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, errors.Wrapf(err, "mechanism.Parameters[%s] must be a comma separated list of CIDRs, instead was: %s: %v", AllowedIPs, allowedIPs, m)
+		}
+		rv = append(rv, ipNet)
+	}
+	return rv, nil
+}
+
+// EncodeKey - encodes key to be passed as mechanism parameter
+func EncodeKey(key []byte) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func getStringParameter(m *connection.Mechanism, name string) (string, error) {
+	if m == nil {
+		return "", errors.New("mechanism cannot be nil")
+	}
+
+	if m.GetParameters() == nil {
+		return "", errors.Errorf("mechanism.Parameters cannot be nil: %v", m)
+	}
+
+	v, ok := m.Parameters[name]
+	if !ok {
+		return "", errors.Errorf("mechanism.Type %s requires mechanism.Parameters[%s]", m.GetType(), name)
+	}
+
+	return v, nil
+}
+
+func getPortParameter(m *connection.Mechanism, name string) (int, error) {
+	v, err := getStringParameter(m, name)
+	if err != nil {
+		return 0, err
+	}
+
+	port, err := strconv.ParseUint(v, 10, 16)
+	if err != nil || port == 0 {
+		return 0, errors.Errorf("mechanism.Parameters[%s] must be a valid UDP port, instead was: %s: %v", name, v, m)
+	}
+
+	return int(port), nil
+}
+
+func getKeyParameter(m *connection.Mechanism, name string) ([]byte, error) {
+	v, err := getStringParameter(m, name)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || len(key) != KeyLength {
+		return nil, errors.Errorf("mechanism.Parameters[%s] must be a base64 encoded %d bytes key, instead was: %s: %v", name, KeyLength, v, m)
+	}
+
+	return key, nil
+}
+
+// validate - checks that WireGuard mechanism has source, destination IPs, ports, public keys and valid allowed IPs
+func validate(m *connection.Mechanism) error {
+	helper := ToMechanism(m)
+	if _, err := helper.SrcIP(); err != nil {
+		return err
+	}
+	if _, err := helper.DstIP(); err != nil {
+		return err
+	}
+	if _, err := helper.SrcPort(); err != nil {
+		return err
+	}
+	if _, err := helper.DstPort(); err != nil {
+		return err
+	}
+	if _, err := helper.SrcPublicKey(); err != nil {
+		return err
+	}
+	if _, err := helper.DstPublicKey(); err != nil {
+		return err
+	}
+	_, err := helper.AllowedIPs()
+	return err
+}
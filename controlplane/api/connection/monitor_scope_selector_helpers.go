@@ -0,0 +1,61 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connection
+
+// IsEmpty returns if selector has no criteria, so it matches any connection
+func (m *MonitorScopeSelector) IsEmpty() bool {
+	return len(m.GetPathSegments()) == 0 &&
+		m.GetNetworkService() == "" &&
+		len(m.GetLabels()) == 0 &&
+		len(m.GetConnectionIds()) == 0 &&
+		m.GetNetworkServiceEndpointName() == ""
+}
+
+// MatchesConnection returns if connection matches all criteria of selector.
+// Path segments match a connection with a source NSM of the first segment or a destination NSM of the second one.
+func (m *MonitorScopeSelector) MatchesConnection(c *Connection) bool {
+	if c == nil {
+		return false
+	}
+	if segments := m.GetPathSegments(); len(segments) > 0 {
+		srcMatches := c.GetSourceNetworkServiceManagerName() == segments[0].GetName()
+		dstMatches := len(segments) > 1 && c.GetDestinationNetworkServiceManagerName() == segments[1].GetName()
+		if !srcMatches && !dstMatches {
+			return false
+		}
+	}
+	if ns := m.GetNetworkService(); ns != "" && c.GetNetworkService() != ns {
+		return false
+	}
+	if nse := m.GetNetworkServiceEndpointName(); nse != "" && c.GetNetworkServiceEndpointName() != nse {
+		return false
+	}
+	for key, value := range m.GetLabels() {
+		if actual, ok := c.GetLabels()[key]; !ok || actual != value {
+			return false
+		}
+	}
+	if ids := m.GetConnectionIds(); len(ids) > 0 {
+		for _, id := range ids {
+			if c.GetId() == id {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
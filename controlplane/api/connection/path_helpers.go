@@ -18,7 +18,10 @@
 package connection
 
 import (
+	"time"
+
 	proto "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 )
 
@@ -37,3 +40,25 @@ func (m *Path) IsValid() error {
 	}
 	return nil
 }
+
+// GetCurrentPathSegment returns path segment at path index, nil if there is no such segment
+func (m *Path) GetCurrentPathSegment() *PathSegment {
+	if int(m.GetIndex()) >= len(m.GetPathSegments()) {
+		return nil
+	}
+	return m.GetPathSegments()[m.GetIndex()]
+}
+
+// GetExpireTime returns expiration of a lease path segment at path index is granted for,
+// ok is false if lease is not set
+func (m *Path) GetExpireTime() (expires time.Time, ok bool) {
+	expiresProto := m.GetCurrentPathSegment().GetExpires()
+	if expiresProto == nil {
+		return time.Time{}, false
+	}
+	expires, err := ptypes.Timestamp(expiresProto)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expires, true
+}
@@ -0,0 +1,99 @@
+package connectioncontext
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// NetNsState - addresses and routes present in a client network namespace, used to detect connection contexts
+// conflicting with it
+type NetNsState struct {
+	// Addresses - interface addresses in format <address>/<prefix>
+	Addresses []string
+	// Routes - route prefixes in format <address>/<prefix> by routing table id, 0 is the main table
+	Routes map[uint32][]string
+}
+
+// AddClientContext - adds addresses and routes of the client side of the connection context
+func (s *NetNsState) AddClientContext(c *ConnectionContext) {
+	s.Addresses = append(s.Addresses, c.GetIpContext().SrcIPAddresses()...)
+	for _, route := range c.GetIpContext().GetDstRoutes() {
+		s.AddRoute(c.GetPolicyRoutingContext().GetTable(), route.GetPrefix())
+	}
+}
+
+// AddRoute - adds a route prefix of the routing table
+func (s *NetNsState) AddRoute(table uint32, prefix string) {
+	if s.Routes == nil {
+		s.Routes = map[uint32][]string{}
+	}
+	s.Routes[table] = append(s.Routes[table], prefix)
+}
+
+// IsEmpty - returns true if there are no addresses and routes
+func (s *NetNsState) IsEmpty() bool {
+	return len(s.Addresses) == 0 && len(s.Routes) == 0
+}
+
+// ExcludedPrefixes - returns networks of the addresses, which should be excluded from new connections
+func (s *NetNsState) ExcludedPrefixes() []string {
+	var prefixes []string
+	for _, addr := range s.Addresses {
+		if _, ipNet, err := net.ParseCIDR(addr); err == nil {
+			prefixes = append(prefixes, ipNet.String())
+		}
+	}
+	return prefixes
+}
+
+// CheckClientContext - returns an error if the client side of the connection context conflicts with the state:
+// its addresses overlap the state addresses, its routes are already present in the same routing table or
+// they fall within networks of the state addresses
+func (s *NetNsState) CheckClientContext(c *ConnectionContext) error {
+	for _, addr := range c.GetIpContext().SrcIPAddresses() {
+		for _, existing := range s.Addresses {
+			if PrefixesOverlap(addr, existing) {
+				return errors.Errorf("address %s overlaps address %s of the client namespace", addr, existing)
+			}
+		}
+	}
+	table := c.GetPolicyRoutingContext().GetTable()
+	for _, route := range c.GetIpContext().GetDstRoutes() {
+		_, routeNet, err := net.ParseCIDR(route.GetPrefix())
+		if err != nil {
+			return err
+		}
+		for _, existing := range s.Routes[table] {
+			if _, existingNet, err := net.ParseCIDR(existing); err == nil && existingNet.String() == routeNet.String() {
+				return errors.Errorf("route %s is already present in routing table %d of the client namespace", route.GetPrefix(), table)
+			}
+		}
+		for _, existing := range s.Addresses {
+			if _, addrNet, err := net.ParseCIDR(existing); err == nil && PrefixContains(addrNet.String(), routeNet.String()) {
+				return errors.Errorf("route %s falls within network of address %s of the client namespace", route.GetPrefix(), existing)
+			}
+		}
+	}
+	return nil
+}
+
+// PrefixesOverlap - returns true if networks of the prefixes in format <address>/<prefix> overlap
+func PrefixesOverlap(first, second string) bool {
+	return PrefixContains(first, second) || PrefixContains(second, first)
+}
+
+// PrefixContains - returns true if network of the prefix contains the whole network of the other one
+func PrefixContains(prefix, other string) bool {
+	_, prefixNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+	_, otherNet, err := net.ParseCIDR(other)
+	if err != nil {
+		return false
+	}
+	prefixOnes, prefixBits := prefixNet.Mask.Size()
+	otherOnes, otherBits := otherNet.Mask.Size()
+	return prefixBits == otherBits && prefixOnes <= otherOnes && prefixNet.Contains(otherNet.IP)
+}
@@ -244,6 +244,8 @@ type IPContext struct {
 	IpNeighbors          []*IpNeighbor         `protobuf:"bytes,8,rep,name=ip_neighbors,json=ipNeighbors,proto3" json:"ip_neighbors,omitempty"`
 	ExtraPrefixRequest   []*ExtraPrefixRequest `protobuf:"bytes,9,rep,name=extra_prefix_request,json=extraPrefixRequest,proto3" json:"extra_prefix_request,omitempty"`
 	ExtraPrefixes        []string              `protobuf:"bytes,10,rep,name=extra_prefixes,json=extraPrefixes,proto3" json:"extra_prefixes,omitempty"`
+	SrcIpAddrs           []string              `protobuf:"bytes,11,rep,name=src_ip_addrs,json=srcIpAddrs,proto3" json:"src_ip_addrs,omitempty"`
+	DstIpAddrs           []string              `protobuf:"bytes,12,rep,name=dst_ip_addrs,json=dstIpAddrs,proto3" json:"dst_ip_addrs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -344,6 +346,20 @@ func (m *IPContext) GetExtraPrefixes() []string {
 	return nil
 }
 
+func (m *IPContext) GetSrcIpAddrs() []string {
+	if m != nil {
+		return m.SrcIpAddrs
+	}
+	return nil
+}
+
+func (m *IPContext) GetDstIpAddrs() []string {
+	if m != nil {
+		return m.DstIpAddrs
+	}
+	return nil
+}
+
 type DNSConfig struct {
 	// ips of DNS Servers for this DNSConfig.  Any given IP may be IPv4 or IPv6
 	DnsServerIps []string `protobuf:"bytes,1,rep,name=dns_server_ips,json=dnsServerIps,proto3" json:"dns_server_ips,omitempty"`
@@ -479,20 +495,124 @@ func (m *EthernetContext) GetDstMac() string {
 	return ""
 }
 
+type PolicyRule struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Fwmark               uint32   `protobuf:"varint,2,opt,name=fwmark,proto3" json:"fwmark,omitempty"`
+	Priority             uint32   `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyRule) Reset()         { *m = PolicyRule{} }
+func (m *PolicyRule) String() string { return proto.CompactTextString(m) }
+func (*PolicyRule) ProtoMessage()    {}
+func (*PolicyRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c30b3f1555e8b686, []int{8}
+}
+
+func (m *PolicyRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyRule.Unmarshal(m, b)
+}
+func (m *PolicyRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyRule.Marshal(b, m, deterministic)
+}
+func (m *PolicyRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyRule.Merge(m, src)
+}
+func (m *PolicyRule) XXX_Size() int {
+	return xxx_messageInfo_PolicyRule.Size(m)
+}
+func (m *PolicyRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyRule proto.InternalMessageInfo
+
+func (m *PolicyRule) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *PolicyRule) GetFwmark() uint32 {
+	if m != nil {
+		return m.Fwmark
+	}
+	return 0
+}
+
+func (m *PolicyRule) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type PolicyRoutingContext struct {
+	Table                uint32        `protobuf:"varint,1,opt,name=table,proto3" json:"table,omitempty"`
+	Rules                []*PolicyRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PolicyRoutingContext) Reset()         { *m = PolicyRoutingContext{} }
+func (m *PolicyRoutingContext) String() string { return proto.CompactTextString(m) }
+func (*PolicyRoutingContext) ProtoMessage()    {}
+func (*PolicyRoutingContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c30b3f1555e8b686, []int{9}
+}
+
+func (m *PolicyRoutingContext) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyRoutingContext.Unmarshal(m, b)
+}
+func (m *PolicyRoutingContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyRoutingContext.Marshal(b, m, deterministic)
+}
+func (m *PolicyRoutingContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyRoutingContext.Merge(m, src)
+}
+func (m *PolicyRoutingContext) XXX_Size() int {
+	return xxx_messageInfo_PolicyRoutingContext.Size(m)
+}
+func (m *PolicyRoutingContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyRoutingContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyRoutingContext proto.InternalMessageInfo
+
+func (m *PolicyRoutingContext) GetTable() uint32 {
+	if m != nil {
+		return m.Table
+	}
+	return 0
+}
+
+func (m *PolicyRoutingContext) GetRules() []*PolicyRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
 type ConnectionContext struct {
-	IpContext            *IPContext       `protobuf:"bytes,1,opt,name=ip_context,json=ipContext,proto3" json:"ip_context,omitempty"`
-	DnsContext           *DNSContext      `protobuf:"bytes,2,opt,name=dns_context,json=dnsContext,proto3" json:"dns_context,omitempty"`
-	EthernetContext      *EthernetContext `protobuf:"bytes,3,opt,name=ethernet_context,json=ethernetContext,proto3" json:"ethernet_context,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	IpContext            *IPContext            `protobuf:"bytes,1,opt,name=ip_context,json=ipContext,proto3" json:"ip_context,omitempty"`
+	DnsContext           *DNSContext           `protobuf:"bytes,2,opt,name=dns_context,json=dnsContext,proto3" json:"dns_context,omitempty"`
+	EthernetContext      *EthernetContext      `protobuf:"bytes,3,opt,name=ethernet_context,json=ethernetContext,proto3" json:"ethernet_context,omitempty"`
+	Mtu                  uint32                `protobuf:"varint,4,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	PolicyRoutingContext *PolicyRoutingContext `protobuf:"bytes,5,opt,name=policy_routing_context,json=policyRoutingContext,proto3" json:"policy_routing_context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *ConnectionContext) Reset()         { *m = ConnectionContext{} }
 func (m *ConnectionContext) String() string { return proto.CompactTextString(m) }
 func (*ConnectionContext) ProtoMessage()    {}
 func (*ConnectionContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c30b3f1555e8b686, []int{8}
+	return fileDescriptor_c30b3f1555e8b686, []int{10}
 }
 
 func (m *ConnectionContext) XXX_Unmarshal(b []byte) error {
@@ -534,6 +654,20 @@ func (m *ConnectionContext) GetEthernetContext() *EthernetContext {
 	return nil
 }
 
+func (m *ConnectionContext) GetMtu() uint32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+func (m *ConnectionContext) GetPolicyRoutingContext() *PolicyRoutingContext {
+	if m != nil {
+		return m.PolicyRoutingContext
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("connectioncontext.IpFamily_Family", IpFamily_Family_name, IpFamily_Family_value)
 	proto.RegisterType((*IpNeighbor)(nil), "connectioncontext.IpNeighbor")
@@ -544,53 +678,64 @@ func init() {
 	proto.RegisterType((*DNSConfig)(nil), "connectioncontext.DNSConfig")
 	proto.RegisterType((*DNSContext)(nil), "connectioncontext.DNSContext")
 	proto.RegisterType((*EthernetContext)(nil), "connectioncontext.EthernetContext")
+	proto.RegisterType((*PolicyRule)(nil), "connectioncontext.PolicyRule")
+	proto.RegisterType((*PolicyRoutingContext)(nil), "connectioncontext.PolicyRoutingContext")
 	proto.RegisterType((*ConnectionContext)(nil), "connectioncontext.ConnectionContext")
 }
 
 func init() { proto.RegisterFile("connectioncontext.proto", fileDescriptor_c30b3f1555e8b686) }
 
 var fileDescriptor_c30b3f1555e8b686 = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x51, 0x4f, 0x13, 0x41,
-	0x10, 0xb6, 0x2d, 0x94, 0xde, 0x14, 0xda, 0x72, 0x1a, 0xb9, 0x44, 0x50, 0x72, 0x11, 0xc5, 0x98,
-	0xf0, 0x50, 0x0d, 0x26, 0x6a, 0x8c, 0x5a, 0xd0, 0x34, 0x11, 0xd2, 0x2c, 0x89, 0x1a, 0x5f, 0x2e,
-	0xc7, 0xed, 0x40, 0x37, 0xa1, 0x7b, 0xc7, 0xee, 0x55, 0xeb, 0x0f, 0xf4, 0x77, 0xf8, 0x47, 0x7c,
-	0x30, 0x3b, 0xbb, 0x7b, 0xa2, 0xad, 0xfa, 0xd4, 0xed, 0x37, 0xdf, 0xf7, 0xcd, 0xec, 0xcc, 0xec,
-	0xc1, 0x46, 0x96, 0x4b, 0x89, 0x59, 0x29, 0x72, 0x99, 0xe5, 0xb2, 0xc4, 0x59, 0xb9, 0x57, 0xa8,
-	0xbc, 0xcc, 0xc3, 0xf5, 0xb9, 0x40, 0xfc, 0x16, 0x60, 0x58, 0x1c, 0xa3, 0x38, 0x1f, 0x9f, 0xe6,
-	0x2a, 0xec, 0x40, 0x5d, 0x14, 0x51, 0x6d, 0xbb, 0xb6, 0x1b, 0xb0, 0xba, 0x28, 0xc2, 0x07, 0xd0,
-	0x1b, 0xa7, 0x8a, 0x7f, 0x49, 0x15, 0x26, 0x29, 0xe7, 0x0a, 0xb5, 0x8e, 0xea, 0x14, 0xed, 0x7a,
-	0xfc, 0x95, 0x85, 0xe3, 0x3b, 0xb0, 0xcc, 0xf2, 0x69, 0x89, 0xe1, 0x4d, 0x68, 0x16, 0x0a, 0xcf,
-	0xc4, 0xcc, 0xf9, 0xb8, 0x7f, 0x31, 0x87, 0xd6, 0xb0, 0x78, 0x93, 0x4e, 0xc4, 0xc5, 0xd7, 0xf0,
-	0x29, 0x34, 0xcf, 0xe8, 0x44, 0x9c, 0x4e, 0x3f, 0xde, 0x9b, 0x2f, 0xd9, 0x93, 0xf7, 0xec, 0x0f,
-	0x73, 0x8a, 0x78, 0x13, 0x9a, 0xce, 0xa5, 0x05, 0x4b, 0xc3, 0xd1, 0xfb, 0xc7, 0xbd, 0x6b, 0xee,
-	0xb4, 0xdf, 0xab, 0xc5, 0xdf, 0x6a, 0x10, 0x1e, 0xce, 0x4a, 0x95, 0x8e, 0x28, 0x2b, 0xc3, 0xcb,
-	0x29, 0xea, 0x32, 0x7c, 0x0e, 0x6d, 0x53, 0x7f, 0x72, 0x25, 0x6b, 0xbb, 0x7f, 0xeb, 0x1f, 0x59,
-	0x19, 0x18, 0xbe, 0x4b, 0xb4, 0x05, 0x60, 0x2f, 0x91, 0x5c, 0xa0, 0xa4, 0x06, 0xac, 0xb1, 0xc0,
-	0x22, 0xef, 0x50, 0x86, 0xf7, 0xa1, 0xab, 0xf0, 0x72, 0x2a, 0x14, 0xf2, 0x44, 0x4e, 0x27, 0xa7,
-	0xa8, 0xa2, 0x06, 0x71, 0x3a, 0x1e, 0x3e, 0x26, 0xd4, 0xb4, 0x53, 0xd9, 0x82, 0x7e, 0x31, 0x97,
-	0x88, 0xd9, 0xad, 0x70, 0x4b, 0x8d, 0x7f, 0x34, 0x20, 0x18, 0x8e, 0x06, 0xb6, 0xaa, 0xf0, 0x36,
-	0xb4, 0xb5, 0xca, 0x12, 0x51, 0xd0, 0x14, 0x5c, 0x63, 0x03, 0xad, 0xb2, 0x61, 0x61, 0xfa, 0x6f,
-	0xe2, 0x5c, 0x97, 0x55, 0xdc, 0x8e, 0x28, 0xe0, 0xba, 0x74, 0xf1, 0x7b, 0xd0, 0x75, 0x7a, 0x5f,
-	0x11, 0x55, 0xd8, 0x62, 0x6b, 0xe4, 0xc1, 0x1c, 0x68, 0x78, 0xce, 0xa7, 0xe2, 0x2d, 0x59, 0x1e,
-	0x79, 0x55, 0xbc, 0x27, 0x00, 0xc6, 0x4f, 0x99, 0x81, 0xeb, 0x68, 0x79, 0xbb, 0xb1, 0xdb, 0xee,
-	0x47, 0x0b, 0xba, 0x49, 0x1b, 0x41, 0x85, 0xd2, 0x49, 0x1b, 0xa1, 0x49, 0xe0, 0x84, 0xcd, 0xff,
-	0x09, 0xb9, 0x2e, 0x9d, 0xf0, 0x21, 0xac, 0xe3, 0x2c, 0xbb, 0x98, 0x72, 0xe4, 0x89, 0xed, 0x3c,
-	0xea, 0x68, 0x65, 0xbb, 0xb1, 0x1b, 0xb0, 0x9e, 0x0f, 0x8c, 0x1c, 0x1e, 0xbe, 0x84, 0x55, 0x51,
-	0x24, 0xd2, 0x6d, 0xb5, 0x8e, 0x5a, 0x94, 0x67, 0x6b, 0xe1, 0xb8, 0xfd, 0xee, 0xb3, 0xb6, 0xa8,
-	0xce, 0x3a, 0xfc, 0x00, 0x37, 0xd0, 0x6c, 0x91, 0xcb, 0x95, 0xb8, 0xf1, 0x44, 0x01, 0x39, 0xed,
-	0x2c, 0x70, 0x9a, 0x5f, 0x3a, 0x16, 0xe2, 0xfc, 0x22, 0xee, 0x40, 0xe7, 0xaa, 0x31, 0xea, 0x08,
-	0xe8, 0x12, 0x6b, 0x57, 0xb8, 0xa8, 0xe3, 0x8f, 0x10, 0x1c, 0x1c, 0x9f, 0x0c, 0x72, 0x79, 0x26,
-	0xce, 0xc3, 0xbb, 0xd0, 0xe1, 0x52, 0x27, 0x1a, 0xd5, 0x67, 0x54, 0x89, 0x28, 0x74, 0x54, 0x23,
-	0xcd, 0x2a, 0x97, 0xfa, 0x84, 0xc0, 0x61, 0xa1, 0x8d, 0xb3, 0xc6, 0x54, 0x65, 0xe3, 0x84, 0xe7,
-	0x93, 0x54, 0x48, 0xf3, 0x52, 0xc9, 0xd9, 0xa2, 0x07, 0x16, 0x8c, 0x0f, 0x00, 0xac, 0x33, 0x2d,
-	0xd6, 0x3e, 0xac, 0x64, 0x94, 0xc4, 0x7a, 0xb6, 0xfb, 0x9b, 0x0b, 0xae, 0x56, 0x55, 0xc2, 0x3c,
-	0x39, 0x1e, 0x40, 0xf7, 0xb0, 0x1c, 0xa3, 0x92, 0x58, 0x7a, 0xab, 0x0d, 0x58, 0x31, 0x3b, 0x31,
-	0x49, 0x33, 0xff, 0xf0, 0xb5, 0xca, 0x8e, 0xd2, 0xcc, 0x04, 0xcc, 0xcc, 0x4d, 0xc0, 0x2e, 0x66,
-	0x93, 0xeb, 0xf2, 0x28, 0xcd, 0xe2, 0xef, 0x35, 0x58, 0x1f, 0x54, 0xd9, 0xbc, 0xcf, 0x33, 0x00,
-	0x51, 0x24, 0x2e, 0xb7, 0x7b, 0xa9, 0x8b, 0xaa, 0xaa, 0x5e, 0x07, 0x0b, 0x44, 0xe1, 0xc5, 0x2f,
-	0xa0, 0x6d, 0x5a, 0xe5, 0xd5, 0x75, 0x52, 0x6f, 0xfd, 0xf5, 0x4e, 0x24, 0x07, 0x2e, 0xb5, 0xd7,
-	0x1f, 0x41, 0x0f, 0xdd, 0xbd, 0x2a, 0x93, 0x06, 0x99, 0x2c, 0xfa, 0x44, 0xfd, 0xd1, 0x02, 0xd6,
-	0xc5, 0xdf, 0x81, 0xd7, 0xd7, 0x3f, 0xcd, 0x7f, 0x72, 0x4f, 0x9b, 0xf4, 0x31, 0x7e, 0xf4, 0x33,
-	0x00, 0x00, 0xff, 0xff, 0x71, 0xef, 0x57, 0xb7, 0xa7, 0x05, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdf, 0x8f, 0x1b, 0x35,
+	0x10, 0x26, 0x3f, 0x2f, 0x3b, 0x7b, 0x97, 0xe4, 0xcc, 0xa9, 0x5d, 0x41, 0x0b, 0xd1, 0x8a, 0xd2,
+	0x43, 0x48, 0xf7, 0x70, 0x45, 0x45, 0x02, 0x84, 0x80, 0x5c, 0x41, 0x91, 0xb8, 0x53, 0xe4, 0x22,
+	0x40, 0x48, 0x68, 0xb5, 0xd9, 0x75, 0x12, 0x8b, 0xc4, 0xeb, 0xda, 0x5e, 0x9a, 0xfb, 0xef, 0x78,
+	0xe1, 0x9d, 0x3f, 0x09, 0x79, 0xec, 0xdd, 0x0b, 0xcd, 0x1e, 0x7d, 0x8a, 0x3d, 0xf3, 0xcd, 0x37,
+	0xe3, 0xf9, 0x66, 0x27, 0xf0, 0x30, 0x2b, 0x84, 0x60, 0x99, 0xe1, 0x85, 0xc8, 0x0a, 0x61, 0xd8,
+	0xce, 0x5c, 0x48, 0x55, 0x98, 0x82, 0x9c, 0x1e, 0x38, 0xe2, 0x1f, 0x00, 0x66, 0xf2, 0x86, 0xf1,
+	0xd5, 0x7a, 0x51, 0x28, 0x32, 0x84, 0x36, 0x97, 0x51, 0x6b, 0xd2, 0x3a, 0x0f, 0x68, 0x9b, 0x4b,
+	0xf2, 0x09, 0x8c, 0xd7, 0xa9, 0xca, 0x5f, 0xa7, 0x8a, 0x25, 0x69, 0x9e, 0x2b, 0xa6, 0x75, 0xd4,
+	0x46, 0xef, 0xa8, 0xb2, 0x7f, 0xeb, 0xcc, 0xf1, 0x87, 0xd0, 0xa3, 0x45, 0x69, 0x18, 0x79, 0x00,
+	0x7d, 0xa9, 0xd8, 0x92, 0xef, 0x3c, 0x8f, 0xbf, 0xc5, 0x39, 0x0c, 0x66, 0xf2, 0xfb, 0x74, 0xcb,
+	0x37, 0xb7, 0xe4, 0x0b, 0xe8, 0x2f, 0xf1, 0x84, 0x98, 0xe1, 0x65, 0x7c, 0x71, 0x58, 0x72, 0x05,
+	0xbe, 0x70, 0x3f, 0xd4, 0x47, 0xc4, 0x8f, 0xa0, 0xef, 0x59, 0x06, 0xd0, 0x9d, 0xcd, 0x7f, 0xfe,
+	0x6c, 0xfc, 0x8e, 0x3f, 0x3d, 0x1f, 0xb7, 0xe2, 0xbf, 0x5b, 0x40, 0x5e, 0xec, 0x8c, 0x4a, 0xe7,
+	0x98, 0x95, 0xb2, 0x57, 0x25, 0xd3, 0x86, 0x7c, 0x05, 0xa1, 0xad, 0x3f, 0xd9, 0xcb, 0x1a, 0x5e,
+	0xbe, 0xff, 0x3f, 0x59, 0x29, 0x58, 0xbc, 0x4f, 0xf4, 0x18, 0xc0, 0x3d, 0x22, 0xd9, 0x30, 0x81,
+	0x0d, 0x38, 0xa1, 0x81, 0xb3, 0xfc, 0xc8, 0x04, 0x79, 0x0a, 0x23, 0xc5, 0x5e, 0x95, 0x5c, 0xb1,
+	0x3c, 0x11, 0xe5, 0x76, 0xc1, 0x54, 0xd4, 0x41, 0xcc, 0xb0, 0x32, 0xdf, 0xa0, 0xd5, 0xb6, 0x53,
+	0xb9, 0x82, 0xee, 0x90, 0x5d, 0x44, 0x8e, 0x6a, 0xbb, 0x83, 0xc6, 0x7f, 0x75, 0x21, 0x98, 0xcd,
+	0xa7, 0xae, 0x2a, 0xf2, 0x01, 0x84, 0x5a, 0x65, 0x09, 0x97, 0xa8, 0x82, 0x6f, 0x6c, 0xa0, 0x55,
+	0x36, 0x93, 0xb6, 0xff, 0xd6, 0x9f, 0x6b, 0x53, 0xfb, 0x9d, 0x44, 0x41, 0xae, 0x8d, 0xf7, 0x7f,
+	0x0c, 0x23, 0x1f, 0x5f, 0x55, 0x84, 0x15, 0x0e, 0xe8, 0x09, 0x72, 0x50, 0x6f, 0xb4, 0x38, 0xcf,
+	0x53, 0xe3, 0xba, 0x0e, 0x87, 0x5c, 0x35, 0xee, 0x73, 0x00, 0xcb, 0xa7, 0xac, 0xe0, 0x3a, 0xea,
+	0x4d, 0x3a, 0xe7, 0xe1, 0x65, 0xd4, 0xd0, 0x4d, 0x9c, 0x08, 0x2c, 0x14, 0x4f, 0xda, 0x06, 0xda,
+	0x04, 0x3e, 0xb0, 0xff, 0xb6, 0xc0, 0x5c, 0x1b, 0x1f, 0xf8, 0x29, 0x9c, 0xb2, 0x5d, 0xb6, 0x29,
+	0x73, 0x96, 0x27, 0xae, 0xf3, 0x4c, 0x47, 0x47, 0x93, 0xce, 0x79, 0x40, 0xc7, 0x95, 0x63, 0xee,
+	0xed, 0xe4, 0x1b, 0x38, 0xe6, 0x32, 0x11, 0x7e, 0xaa, 0x75, 0x34, 0xc0, 0x3c, 0x8f, 0x1b, 0xe5,
+	0xae, 0x66, 0x9f, 0x86, 0xbc, 0x3e, 0x6b, 0xf2, 0x0b, 0x9c, 0x31, 0x3b, 0x45, 0x3e, 0x57, 0xe2,
+	0xe5, 0x89, 0x02, 0x64, 0x7a, 0xd2, 0xc0, 0x74, 0x38, 0x74, 0x94, 0xb0, 0x03, 0x1b, 0x79, 0x02,
+	0xc3, 0x7d, 0x62, 0xa6, 0x23, 0xc0, 0x47, 0x9c, 0xec, 0x61, 0x99, 0x26, 0x13, 0x38, 0xde, 0x13,
+	0x5c, 0x47, 0x21, 0x82, 0xa0, 0x56, 0x1c, 0x11, 0x7b, 0x92, 0xeb, 0xe8, 0xd8, 0x21, 0x6a, 0xcd,
+	0x75, 0xfc, 0x2b, 0x04, 0x57, 0x37, 0x2f, 0xa7, 0x85, 0x58, 0xf2, 0x15, 0xf9, 0x08, 0x86, 0xb9,
+	0xd0, 0x89, 0x66, 0xea, 0x4f, 0xa6, 0x12, 0x2e, 0x75, 0xd4, 0xc2, 0x80, 0xe3, 0x5c, 0xe8, 0x97,
+	0x68, 0x9c, 0x49, 0x6d, 0xab, 0xd3, 0x2c, 0x55, 0xd9, 0x3a, 0xc9, 0x8b, 0x6d, 0xca, 0x85, 0xfd,
+	0xda, 0xb1, 0x3a, 0x67, 0xbd, 0x72, 0xc6, 0xf8, 0x0a, 0xc0, 0x31, 0xe3, 0x70, 0x3e, 0x87, 0xa3,
+	0x0c, 0x93, 0x38, 0xce, 0xf0, 0xf2, 0x51, 0x43, 0x7b, 0xea, 0x4a, 0x68, 0x05, 0x8e, 0xa7, 0x30,
+	0x7a, 0x61, 0xd6, 0x4c, 0x09, 0x66, 0x2a, 0xaa, 0x87, 0x70, 0x64, 0x9f, 0xbd, 0x4d, 0xb3, 0x6a,
+	0x79, 0x68, 0x95, 0x5d, 0xa7, 0x99, 0x75, 0xd8, 0xd7, 0x5a, 0x87, 0x1b, 0xee, 0x7e, 0xae, 0xcd,
+	0x75, 0x9a, 0xc5, 0x3f, 0x01, 0xcc, 0x8b, 0x0d, 0xcf, 0x6e, 0x69, 0xb9, 0x61, 0x84, 0x40, 0x77,
+	0xa9, 0x8a, 0xad, 0x0f, 0xc6, 0xb3, 0xdd, 0x47, 0xcb, 0xd7, 0xdb, 0x54, 0xfd, 0xe1, 0x3f, 0x5c,
+	0x7f, 0x23, 0xef, 0xc1, 0x40, 0x2a, 0x5e, 0x28, 0x6e, 0x6e, 0xfd, 0xe7, 0x5a, 0xdf, 0xe3, 0x14,
+	0xce, 0x3c, 0x6b, 0x51, 0x1a, 0x2e, 0x56, 0x55, 0x7d, 0x67, 0xd0, 0x33, 0xe9, 0x62, 0xc3, 0x30,
+	0xc1, 0x09, 0x75, 0x17, 0xf2, 0x0c, 0x7a, 0xaa, 0xdc, 0x30, 0xd7, 0xac, 0xe6, 0x39, 0xbb, 0xab,
+	0x91, 0x3a, 0x6c, 0xfc, 0x4f, 0x1b, 0x4e, 0xa7, 0x35, 0xae, 0x4a, 0xf0, 0x25, 0x00, 0x97, 0x89,
+	0x8f, 0xf2, 0x6b, 0xaa, 0xa9, 0x9d, 0xf5, 0x6a, 0xa0, 0x01, 0x97, 0x55, 0xf0, 0xd7, 0x10, 0x5a,
+	0x8d, 0xab, 0xe8, 0xf6, 0xa4, 0x75, 0x4f, 0x35, 0x77, 0xe2, 0x51, 0xc8, 0x85, 0xae, 0xe2, 0xaf,
+	0x61, 0xcc, 0xbc, 0x20, 0x35, 0x49, 0x07, 0x49, 0x9a, 0xf6, 0xf3, 0x1b, 0xda, 0xd1, 0x11, 0x7b,
+	0x43, 0xcc, 0x31, 0x74, 0xb6, 0xa6, 0xf4, 0x0b, 0xce, 0x1e, 0xc9, 0xef, 0xf0, 0x40, 0x62, 0x23,
+	0x70, 0x01, 0x70, 0xb1, 0xaa, 0xd3, 0xf4, 0x30, 0xcd, 0xd3, 0xfb, 0x3b, 0xf7, 0x1f, 0x1d, 0xe8,
+	0x99, 0x6c, 0xb0, 0x7e, 0xf7, 0xee, 0x6f, 0x87, 0x7f, 0x70, 0x8b, 0x3e, 0xfe, 0xf5, 0x3d, 0xfb,
+	0x77, 0x00, 0xd6, 0x52, 0x82, 0xe0, 0x15, 0x07, 0x00, 0x00,
 }
@@ -5,4 +5,10 @@ const (
 	DNSConfigShouldNotBeNil = "dnsConfig should not be nil"
 	//DNSServerIpsShouldHaveRecords -
 	DNSServerIpsShouldHaveRecords = "dnsConfig should have records"
+	// MinMTU - minimal MTU of connection interfaces, the minimal MTU of IPv4
+	MinMTU = 68
+	// MinReservedRoutingTable - id of the first reserved routing table - default, main and local ones
+	MinReservedRoutingTable = 253
+	// MaxReservedRoutingTable - id of the last reserved routing table
+	MaxReservedRoutingTable = 255
 )
@@ -16,6 +16,14 @@ func (c *ConnectionContext) IsValid() error {
 	if c == nil {
 		return errors.New("ConnectionContext should not be nil")
 	}
+	if c.GetMtu() != 0 && c.GetMtu() < MinMTU {
+		return errors.Errorf("ConnectionContext.Mtu should be either 0 or at least %d: %v", MinMTU, c)
+	}
+	if c.GetPolicyRoutingContext() != nil {
+		if err := c.GetPolicyRoutingContext().IsValid(); err != nil {
+			return err
+		}
+	}
 	ip := c.GetIpContext()
 	for _, route := range append(ip.GetSrcRoutes(), ip.GetDstRoutes()...) {
 		if route.GetPrefix() == "" {
@@ -27,6 +35,12 @@ func (c *ConnectionContext) IsValid() error {
 		}
 	}
 
+	for _, addr := range append(ip.GetSrcIpAddrs(), ip.GetDstIpAddrs()...) {
+		if _, _, err := net.ParseCIDR(addr); err != nil {
+			return errors.Errorf("ConnectionContext.IpContext.SrcIpAddrs and DstIpAddrs should be valid CIDR addresses: %v", ip)
+		}
+	}
+
 	for _, neighbor := range ip.GetIpNeighbors() {
 		if neighbor.GetIp() == "" {
 			return errors.Errorf("ConnectionContext.IpNeighbors.Ip is required and cannot be empty/nil: %v", ip)
@@ -58,6 +72,43 @@ func (c *ConnectionContext) MeetsRequirements(original *ConnectionContext) error
 	return nil
 }
 
+// SrcIPAddresses - returns source addresses of all IP families, src_ip_addr goes first
+func (c *IPContext) SrcIPAddresses() []string {
+	return ipAddresses(c.GetSrcIpAddr(), c.GetSrcIpAddrs())
+}
+
+// DstIPAddresses - returns destination addresses of all IP families, dst_ip_addr goes first
+func (c *IPContext) DstIPAddresses() []string {
+	return ipAddresses(c.GetDstIpAddr(), c.GetDstIpAddrs())
+}
+
+func ipAddresses(addr string, addrs []string) []string {
+	result := []string{}
+	if addr != "" {
+		result = append(result, addr)
+	}
+	return append(result, addrs...)
+}
+
+// IsValid - checks PolicyRoutingContext validation
+func (c *PolicyRoutingContext) IsValid() error {
+	if c == nil {
+		return errors.New("PolicyRoutingContext should not be nil")
+	}
+	if c.GetTable() == 0 || (c.GetTable() >= MinReservedRoutingTable && c.GetTable() <= MaxReservedRoutingTable) {
+		return errors.Errorf("PolicyRoutingContext.Table should be a non reserved routing table id: %v", c)
+	}
+	for _, rule := range c.GetRules() {
+		if rule.GetFrom() == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(rule.GetFrom()); err != nil {
+			return errors.Errorf("PolicyRoutingContext.Rules.From should be a valid CIDR address: %v", c)
+		}
+	}
+	return nil
+}
+
 //Validate - checks DNSConfig and returns error if DNSConfig is not valid
 func (c *DNSConfig) Validate() error {
 	if c == nil {
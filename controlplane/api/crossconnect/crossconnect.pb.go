@@ -7,7 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
-	empty "github.com/golang/protobuf/ptypes/empty"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	connection "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -54,18 +55,89 @@ func (CrossConnectEventType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_97acf85fcaabb3f6, []int{0}
 }
 
+// TrafficCounters - cumulative counters of an interface traffic in one direction
+type TrafficCounters struct {
+	Bytes                uint64   `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Packets              uint64   `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Errors               uint64   `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	Drops                uint64   `protobuf:"varint,4,opt,name=drops,proto3" json:"drops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficCounters) Reset()         { *m = TrafficCounters{} }
+func (m *TrafficCounters) String() string { return proto.CompactTextString(m) }
+func (*TrafficCounters) ProtoMessage()    {}
+func (*TrafficCounters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97acf85fcaabb3f6, []int{0}
+}
+
+func (m *TrafficCounters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficCounters.Unmarshal(m, b)
+}
+func (m *TrafficCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficCounters.Marshal(b, m, deterministic)
+}
+func (m *TrafficCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficCounters.Merge(m, src)
+}
+func (m *TrafficCounters) XXX_Size() int {
+	return xxx_messageInfo_TrafficCounters.Size(m)
+}
+func (m *TrafficCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficCounters proto.InternalMessageInfo
+
+func (m *TrafficCounters) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *TrafficCounters) GetPackets() uint64 {
+	if m != nil {
+		return m.Packets
+	}
+	return 0
+}
+
+func (m *TrafficCounters) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *TrafficCounters) GetDrops() uint64 {
+	if m != nil {
+		return m.Drops
+	}
+	return 0
+}
+
 type Metrics struct {
-	Metrics              map[string]string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	// metrics - string counters kept for compatibility, rx and tx should be used instead
+	Metrics map[string]string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Rx      *TrafficCounters  `protobuf:"bytes,2,opt,name=rx,proto3" json:"rx,omitempty"`
+	Tx      *TrafficCounters  `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	// timestamp - when counters are collected, rates are computed between consecutive collections
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// probe - results of datapath probes sent through a cross connect
+	Probe                *DatapathProbe `protobuf:"bytes,5,opt,name=probe,proto3" json:"probe,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *Metrics) Reset()         { *m = Metrics{} }
 func (m *Metrics) String() string { return proto.CompactTextString(m) }
 func (*Metrics) ProtoMessage()    {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97acf85fcaabb3f6, []int{0}
+	return fileDescriptor_97acf85fcaabb3f6, []int{1}
 }
 
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
@@ -93,20 +165,116 @@ func (m *Metrics) GetMetrics() map[string]string {
 	return nil
 }
 
+func (m *Metrics) GetRx() *TrafficCounters {
+	if m != nil {
+		return m.Rx
+	}
+	return nil
+}
+
+func (m *Metrics) GetTx() *TrafficCounters {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *Metrics) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Metrics) GetProbe() *DatapathProbe {
+	if m != nil {
+		return m.Probe
+	}
+	return nil
+}
+
+// DatapathProbe - results of probes sent from a source to a destination IP of a cross connect
+type DatapathProbe struct {
+	Sent uint64 `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
+	Lost uint64 `protobuf:"varint,2,opt,name=lost,proto3" json:"lost,omitempty"`
+	// consecutive_failures - probes lost in a row, a destination is marked DOWN after too many of them
+	ConsecutiveFailures uint32 `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// rtt - round trip time of the last successful probe
+	Rtt                  *duration.Duration `protobuf:"bytes,4,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DatapathProbe) Reset()         { *m = DatapathProbe{} }
+func (m *DatapathProbe) String() string { return proto.CompactTextString(m) }
+func (*DatapathProbe) ProtoMessage()    {}
+func (*DatapathProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97acf85fcaabb3f6, []int{2}
+}
+
+func (m *DatapathProbe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatapathProbe.Unmarshal(m, b)
+}
+func (m *DatapathProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatapathProbe.Marshal(b, m, deterministic)
+}
+func (m *DatapathProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatapathProbe.Merge(m, src)
+}
+func (m *DatapathProbe) XXX_Size() int {
+	return xxx_messageInfo_DatapathProbe.Size(m)
+}
+func (m *DatapathProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatapathProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatapathProbe proto.InternalMessageInfo
+
+func (m *DatapathProbe) GetSent() uint64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *DatapathProbe) GetLost() uint64 {
+	if m != nil {
+		return m.Lost
+	}
+	return 0
+}
+
+func (m *DatapathProbe) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *DatapathProbe) GetRtt() *duration.Duration {
+	if m != nil {
+		return m.Rtt
+	}
+	return nil
+}
+
 type CrossConnectEvent struct {
-	Type                 CrossConnectEventType    `protobuf:"varint,1,opt,name=type,proto3,enum=crossconnect.CrossConnectEventType" json:"type,omitempty"`
-	CrossConnects        map[string]*CrossConnect `protobuf:"bytes,2,rep,name=cross_connects,json=crossConnects,proto3" json:"cross_connects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Metrics              map[string]*Metrics      `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Type          CrossConnectEventType    `protobuf:"varint,1,opt,name=type,proto3,enum=crossconnect.CrossConnectEventType" json:"type,omitempty"`
+	CrossConnects map[string]*CrossConnect `protobuf:"bytes,2,rep,name=cross_connects,json=crossConnects,proto3" json:"cross_connects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Metrics       map[string]*Metrics      `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// revision - monotonically increasing revision of a monitor server state after the event, 0 if the event can't be resumed from
+	Revision             uint64   `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CrossConnectEvent) Reset()         { *m = CrossConnectEvent{} }
 func (m *CrossConnectEvent) String() string { return proto.CompactTextString(m) }
 func (*CrossConnectEvent) ProtoMessage()    {}
 func (*CrossConnectEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97acf85fcaabb3f6, []int{1}
+	return fileDescriptor_97acf85fcaabb3f6, []int{3}
 }
 
 func (m *CrossConnectEvent) XXX_Unmarshal(b []byte) error {
@@ -148,6 +316,13 @@ func (m *CrossConnectEvent) GetMetrics() map[string]*Metrics {
 	return nil
 }
 
+func (m *CrossConnectEvent) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 type CrossConnect struct {
 	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Payload              string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -162,7 +337,7 @@ func (m *CrossConnect) Reset()         { *m = CrossConnect{} }
 func (m *CrossConnect) String() string { return proto.CompactTextString(m) }
 func (*CrossConnect) ProtoMessage()    {}
 func (*CrossConnect) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97acf85fcaabb3f6, []int{2}
+	return fileDescriptor_97acf85fcaabb3f6, []int{4}
 }
 
 func (m *CrossConnect) XXX_Unmarshal(b []byte) error {
@@ -213,8 +388,10 @@ func (m *CrossConnect) GetDestination() *connection.Connection {
 
 func init() {
 	proto.RegisterEnum("crossconnect.CrossConnectEventType", CrossConnectEventType_name, CrossConnectEventType_value)
+	proto.RegisterType((*TrafficCounters)(nil), "crossconnect.TrafficCounters")
 	proto.RegisterType((*Metrics)(nil), "crossconnect.Metrics")
 	proto.RegisterMapType((map[string]string)(nil), "crossconnect.Metrics.MetricsEntry")
+	proto.RegisterType((*DatapathProbe)(nil), "crossconnect.DatapathProbe")
 	proto.RegisterType((*CrossConnectEvent)(nil), "crossconnect.CrossConnectEvent")
 	proto.RegisterMapType((map[string]*CrossConnect)(nil), "crossconnect.CrossConnectEvent.CrossConnectsEntry")
 	proto.RegisterMapType((map[string]*Metrics)(nil), "crossconnect.CrossConnectEvent.MetricsEntry")
@@ -224,37 +401,52 @@ func init() {
 func init() { proto.RegisterFile("crossconnect.proto", fileDescriptor_97acf85fcaabb3f6) }
 
 var fileDescriptor_97acf85fcaabb3f6 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x26, 0xe9, 0x68, 0xc5, 0x6b, 0xa9, 0x8a, 0xd9, 0xaa, 0x28, 0x17, 0xa6, 0x72, 0x99, 0x00,
-	0xa5, 0x53, 0x38, 0x30, 0x4d, 0x5c, 0xaa, 0xce, 0x93, 0x2a, 0xb6, 0x89, 0x65, 0xe1, 0x80, 0x04,
-	0xaa, 0x52, 0xd7, 0xb4, 0xd6, 0x52, 0x3b, 0xb2, 0xdd, 0xa2, 0x9c, 0xf9, 0x47, 0x10, 0x7f, 0x29,
-	0xca, 0x8f, 0x82, 0xa3, 0x05, 0x72, 0x49, 0x9e, 0x3f, 0x7f, 0xef, 0xfb, 0xec, 0xf7, 0x9e, 0x01,
-	0x11, 0x29, 0x94, 0x22, 0x82, 0x73, 0x4a, 0xb4, 0x97, 0x48, 0xa1, 0x05, 0xea, 0x99, 0x98, 0xfb,
-	0x75, 0xc5, 0xf4, 0x7a, 0xbb, 0xf0, 0x88, 0xd8, 0x8c, 0x39, 0xd5, 0xdf, 0x85, 0xbc, 0x57, 0x54,
-	0xee, 0x18, 0xa1, 0x1b, 0xaa, 0xd6, 0x75, 0x10, 0x11, 0x5c, 0x4b, 0x11, 0x27, 0x71, 0xc4, 0xe9,
-	0x38, 0x4a, 0xd8, 0xb8, 0x94, 0x62, 0x82, 0x1b, 0x61, 0x61, 0xe6, 0x3a, 0x89, 0x4e, 0x13, 0xaa,
-	0xc6, 0x74, 0x93, 0xe8, 0xb4, 0xf8, 0x16, 0x3b, 0xa3, 0x1f, 0x16, 0x74, 0xae, 0xa9, 0x96, 0x8c,
-	0x28, 0xf4, 0x1e, 0x3a, 0x9b, 0x22, 0x74, 0xac, 0xe3, 0xd6, 0x49, 0xd7, 0x1f, 0x79, 0x95, 0x83,
-	0x97, 0xbc, 0xfd, 0x1f, 0x73, 0x2d, 0xd3, 0x60, 0x9f, 0xe2, 0x9e, 0x43, 0xcf, 0xdc, 0x40, 0x03,
-	0x68, 0xdd, 0xd3, 0xd4, 0xb1, 0x8e, 0xad, 0x93, 0x27, 0x41, 0x16, 0xa2, 0x43, 0x78, 0xbc, 0x8b,
-	0xe2, 0x2d, 0x75, 0xec, 0x1c, 0x2b, 0x16, 0xe7, 0xf6, 0x99, 0x35, 0xfa, 0xd9, 0x82, 0x67, 0xd3,
-	0xcc, 0x6a, 0x5a, 0x58, 0xe1, 0x1d, 0xe5, 0x1a, 0xbd, 0x83, 0x83, 0xec, 0xd8, 0xb9, 0x44, 0xdf,
-	0x7f, 0x59, 0x3d, 0xcc, 0x03, 0x7a, 0x98, 0x26, 0x34, 0xc8, 0x13, 0xd0, 0x67, 0xe8, 0xe7, 0xdc,
-	0x79, 0x49, 0x56, 0x8e, 0x9d, 0xdf, 0xc7, 0x6f, 0x90, 0xa8, 0x20, 0xe5, 0xfd, 0x9e, 0x12, 0x13,
-	0x43, 0x97, 0x7f, 0x6b, 0xd4, 0xca, 0x35, 0xdf, 0x34, 0x69, 0xd6, 0x57, 0xeb, 0x0b, 0xa0, 0x87,
-	0x66, 0x35, 0x35, 0x3b, 0x35, 0x6b, 0xd6, 0xf5, 0xdd, 0x7f, 0xbb, 0x19, 0xf5, 0x74, 0x6f, 0x1b,
-	0x7b, 0xf1, 0xba, 0xaa, 0x7b, 0x54, 0xdb, 0x69, 0xb3, 0x45, 0xbf, 0x2c, 0xe8, 0x99, 0x76, 0xa8,
-	0x0f, 0x36, 0x5b, 0x96, 0x92, 0x36, 0x5b, 0x22, 0x07, 0x3a, 0x49, 0x94, 0xc6, 0x22, 0x5a, 0x96,
-	0xfd, 0xdd, 0x2f, 0x91, 0x07, 0x6d, 0x25, 0xb6, 0x92, 0x50, 0xe7, 0x20, 0x37, 0x1b, 0x7a, 0xc6,
-	0x80, 0x4e, 0xff, 0x84, 0x41, 0xc9, 0x42, 0x67, 0xd0, 0x5d, 0x52, 0xa5, 0x19, 0x8f, 0x32, 0xd8,
-	0xe9, 0xfc, 0x37, 0xc9, 0xa4, 0xbe, 0xfa, 0x00, 0x47, 0xb5, 0x73, 0x81, 0x5c, 0x18, 0xce, 0x6e,
-	0x66, 0xe1, 0x6c, 0x72, 0x35, 0xbf, 0x0b, 0x27, 0x21, 0x9e, 0x87, 0xc1, 0xe4, 0xe6, 0xee, 0x12,
-	0x07, 0x83, 0x47, 0x08, 0xa0, 0xfd, 0xe9, 0xe3, 0xc5, 0x24, 0xc4, 0x03, 0x2b, 0x8b, 0x2f, 0xf0,
-	0x15, 0x0e, 0xf1, 0xc0, 0xf6, 0xd7, 0xf0, 0xfc, 0x5a, 0x70, 0xa6, 0x85, 0xac, 0xdc, 0xfb, 0x16,
-	0x0e, 0x6b, 0x60, 0x85, 0x86, 0xde, 0x4a, 0x88, 0x55, 0x4c, 0x8b, 0x87, 0xb5, 0xd8, 0x7e, 0xf3,
-	0x70, 0xf6, 0xce, 0xdc, 0x17, 0x0d, 0x03, 0x72, 0x6a, 0x2d, 0xda, 0x79, 0xca, 0xdb, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0x66, 0xae, 0xa0, 0x28, 0x04, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
+	0x14, 0xbe, 0x36, 0x04, 0x6e, 0x0e, 0x49, 0x2e, 0x77, 0xf2, 0x23, 0x5f, 0x5f, 0xb5, 0x45, 0x74,
+	0x13, 0x35, 0x2d, 0x24, 0x74, 0xd1, 0x28, 0xea, 0x06, 0x81, 0x23, 0x45, 0x4d, 0xa2, 0xd4, 0xb8,
+	0x8b, 0xaa, 0xad, 0x90, 0x31, 0x43, 0x32, 0x8a, 0xf1, 0x58, 0x33, 0x63, 0x0a, 0x4f, 0xd2, 0x7d,
+	0x9f, 0xa3, 0xcf, 0xd0, 0x67, 0xaa, 0x66, 0x3c, 0x80, 0xdd, 0xd0, 0xd2, 0x95, 0xbf, 0x73, 0xe6,
+	0x9b, 0xcf, 0xe7, 0xe7, 0xb3, 0x01, 0x05, 0x8c, 0x72, 0x1e, 0xd0, 0x28, 0xc2, 0x81, 0x68, 0xc4,
+	0x8c, 0x0a, 0x8a, 0xb6, 0xb2, 0x39, 0xbb, 0x16, 0x8b, 0x59, 0x8c, 0x79, 0x53, 0x90, 0x31, 0xe6,
+	0xc2, 0x1f, 0xc7, 0x4b, 0x94, 0xf2, 0xed, 0xc7, 0x9a, 0x31, 0x4c, 0x98, 0x2f, 0x08, 0x8d, 0x16,
+	0x40, 0x9f, 0x7f, 0xba, 0x25, 0xe2, 0x2e, 0x19, 0x34, 0x02, 0x3a, 0x6e, 0x46, 0x58, 0x7c, 0xa6,
+	0xec, 0x9e, 0x63, 0x36, 0x21, 0x01, 0x1e, 0x63, 0x7e, 0xb7, 0x2a, 0x15, 0xd0, 0x48, 0x30, 0x1a,
+	0xc6, 0xa1, 0x1f, 0xe1, 0xa6, 0x1f, 0x93, 0xa6, 0x2e, 0x46, 0x8a, 0x2f, 0x61, 0x2a, 0x5f, 0xa7,
+	0xf0, 0x8f, 0xc7, 0xfc, 0xd1, 0x88, 0x04, 0x1d, 0x9a, 0x44, 0x02, 0x33, 0x8e, 0xf6, 0x60, 0x63,
+	0x30, 0x13, 0x98, 0x5b, 0x46, 0xcd, 0x38, 0x2c, 0xba, 0x69, 0x80, 0x2c, 0x28, 0xc7, 0x7e, 0x70,
+	0x8f, 0x05, 0xb7, 0x4c, 0x95, 0x9f, 0x87, 0xe8, 0x00, 0x4a, 0x98, 0x31, 0xca, 0xb8, 0x55, 0x50,
+	0x07, 0x3a, 0x92, 0x3a, 0x43, 0x46, 0x63, 0x6e, 0x15, 0x53, 0x1d, 0x15, 0xd4, 0xbf, 0x9b, 0x50,
+	0xbe, 0xc2, 0x82, 0x91, 0x80, 0xa3, 0xd7, 0x50, 0x1e, 0xa7, 0xd0, 0x32, 0x6a, 0x85, 0xc3, 0x4a,
+	0xab, 0xde, 0xc8, 0x4d, 0x54, 0xf3, 0xe6, 0x4f, 0x27, 0x12, 0x6c, 0xe6, 0xce, 0xaf, 0xa0, 0x17,
+	0x60, 0xb2, 0xa9, 0x2a, 0xa6, 0xd2, 0x7a, 0x94, 0xbf, 0xf8, 0x53, 0x4b, 0xae, 0xc9, 0xa6, 0x92,
+	0x2e, 0xa6, 0x56, 0xe1, 0x8f, 0xe8, 0x62, 0x8a, 0x4e, 0x61, 0x73, 0xb1, 0x2a, 0xd5, 0x41, 0xa5,
+	0x65, 0x37, 0x6e, 0x29, 0xbd, 0x0d, 0x71, 0x3a, 0xba, 0x41, 0x32, 0x6a, 0x78, 0x73, 0x86, 0xbb,
+	0x24, 0xa3, 0x13, 0xd8, 0x88, 0x19, 0x1d, 0x60, 0x6b, 0x43, 0xdd, 0xfa, 0x3f, 0xff, 0xae, 0xae,
+	0x2f, 0xfc, 0xd8, 0x17, 0x77, 0x37, 0x92, 0xe2, 0xa6, 0x4c, 0xfb, 0x0c, 0xb6, 0xb2, 0x3d, 0xa2,
+	0x2a, 0x14, 0xee, 0xf1, 0x4c, 0x2d, 0x60, 0xd3, 0x95, 0x50, 0x0e, 0x73, 0xe2, 0x87, 0x09, 0x56,
+	0xfd, 0x6e, 0xba, 0x69, 0x70, 0x66, 0x9e, 0x1a, 0xf5, 0x2f, 0x06, 0x6c, 0xe7, 0x44, 0x11, 0x82,
+	0x22, 0xc7, 0x91, 0xd0, 0xfb, 0x53, 0x58, 0xe6, 0x42, 0xca, 0x85, 0xde, 0x9d, 0xc2, 0xe8, 0x04,
+	0xf6, 0x02, 0x1a, 0x71, 0x1c, 0x24, 0x82, 0x4c, 0x70, 0x7f, 0xe4, 0x93, 0x30, 0x61, 0x38, 0x5d,
+	0xe3, 0xb6, 0xbb, 0x9b, 0x39, 0x3b, 0xd7, 0x47, 0xe8, 0x08, 0x0a, 0x4c, 0x08, 0x3d, 0x8f, 0xff,
+	0x1e, 0xcc, 0xa3, 0xab, 0xbd, 0xeb, 0x4a, 0x56, 0xfd, 0x5b, 0x01, 0xfe, 0xed, 0xc8, 0xde, 0x3b,
+	0x69, 0xef, 0xce, 0x44, 0x56, 0xf2, 0x0a, 0x8a, 0xd2, 0xf1, 0xaa, 0xba, 0x9d, 0xd6, 0xd3, 0xfc,
+	0x74, 0x1e, 0xd0, 0xbd, 0x59, 0x8c, 0x5d, 0x75, 0x01, 0xbd, 0x87, 0x1d, 0xc5, 0xed, 0x6b, 0xb2,
+	0x34, 0xa2, 0x34, 0x4d, 0x6b, 0x8d, 0x44, 0x2e, 0xa3, 0x4d, 0xb4, 0x1d, 0x64, 0x73, 0xe8, 0x7c,
+	0x69, 0xc4, 0x82, 0xd2, 0x7c, 0xbe, 0x4e, 0x73, 0xb5, 0x25, 0x6d, 0xf8, 0x9b, 0xe1, 0x09, 0xe1,
+	0x84, 0x46, 0xda, 0xf5, 0x8b, 0xd8, 0xfe, 0x08, 0xe8, 0x61, 0x21, 0x2b, 0x36, 0x7d, 0x9c, 0xdd,
+	0xb4, 0x34, 0xdd, 0x2f, 0x2b, 0xc9, 0xb8, 0xc0, 0x7e, 0xbb, 0xd6, 0x41, 0x47, 0x79, 0xdd, 0xfd,
+	0x95, 0x9f, 0x5a, 0xd6, 0x58, 0x5f, 0x0d, 0xd8, 0xca, 0xbe, 0x0e, 0xed, 0x80, 0x49, 0x86, 0x5a,
+	0xd2, 0x24, 0xc3, 0xf4, 0x97, 0x30, 0x0b, 0xa9, 0x3f, 0xd4, 0xae, 0x9c, 0x87, 0xa8, 0x01, 0x25,
+	0x4e, 0x13, 0x16, 0x60, 0xed, 0x94, 0x83, 0x46, 0xe6, 0xc7, 0xd3, 0x59, 0x40, 0x57, 0xb3, 0xd0,
+	0x29, 0x54, 0x86, 0x98, 0x0b, 0x12, 0x29, 0xf7, 0x58, 0xe5, 0xdf, 0x5e, 0xca, 0x52, 0x9f, 0xbd,
+	0x81, 0xfd, 0x95, 0x9e, 0x41, 0x36, 0x1c, 0x5c, 0x5c, 0x5f, 0x78, 0x17, 0xed, 0xcb, 0x7e, 0xcf,
+	0x6b, 0x7b, 0x4e, 0xdf, 0x73, 0xdb, 0xd7, 0xbd, 0x73, 0xc7, 0xad, 0xfe, 0x85, 0x00, 0x4a, 0xef,
+	0x6e, 0xba, 0x6d, 0xcf, 0xa9, 0x1a, 0x12, 0x77, 0x9d, 0x4b, 0xc7, 0x73, 0xaa, 0x66, 0x8b, 0xc1,
+	0xee, 0x15, 0x8d, 0x88, 0xa0, 0x2c, 0xd7, 0xf7, 0x07, 0xd8, 0x5b, 0x91, 0xe6, 0xa8, 0x96, 0x2d,
+	0x50, 0x33, 0x7a, 0x01, 0x8d, 0x71, 0x0f, 0x87, 0x38, 0x10, 0x94, 0xd9, 0x4f, 0xd6, 0xd8, 0xe8,
+	0xd8, 0x18, 0x94, 0xd4, 0xc7, 0xf3, 0xf2, 0xc7, 0x00, 0xa4, 0x8f, 0xea, 0xe3, 0x4c, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -269,7 +461,8 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MonitorCrossConnectClient interface {
-	MonitorCrossConnects(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MonitorCrossConnect_MonitorCrossConnectsClient, error)
+	// MonitorCrossConnects - streams cross connects with source or destination matching the selector
+	MonitorCrossConnects(ctx context.Context, in *connection.MonitorScopeSelector, opts ...grpc.CallOption) (MonitorCrossConnect_MonitorCrossConnectsClient, error)
 }
 
 type monitorCrossConnectClient struct {
@@ -280,7 +473,7 @@ func NewMonitorCrossConnectClient(cc grpc.ClientConnInterface) MonitorCrossConne
 	return &monitorCrossConnectClient{cc}
 }
 
-func (c *monitorCrossConnectClient) MonitorCrossConnects(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MonitorCrossConnect_MonitorCrossConnectsClient, error) {
+func (c *monitorCrossConnectClient) MonitorCrossConnects(ctx context.Context, in *connection.MonitorScopeSelector, opts ...grpc.CallOption) (MonitorCrossConnect_MonitorCrossConnectsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_MonitorCrossConnect_serviceDesc.Streams[0], "/crossconnect.MonitorCrossConnect/MonitorCrossConnects", opts...)
 	if err != nil {
 		return nil, err
@@ -314,14 +507,15 @@ func (x *monitorCrossConnectMonitorCrossConnectsClient) Recv() (*CrossConnectEve
 
 // MonitorCrossConnectServer is the server API for MonitorCrossConnect service.
 type MonitorCrossConnectServer interface {
-	MonitorCrossConnects(*empty.Empty, MonitorCrossConnect_MonitorCrossConnectsServer) error
+	// MonitorCrossConnects - streams cross connects with source or destination matching the selector
+	MonitorCrossConnects(*connection.MonitorScopeSelector, MonitorCrossConnect_MonitorCrossConnectsServer) error
 }
 
 // UnimplementedMonitorCrossConnectServer can be embedded to have forward compatible implementations.
 type UnimplementedMonitorCrossConnectServer struct {
 }
 
-func (*UnimplementedMonitorCrossConnectServer) MonitorCrossConnects(req *empty.Empty, srv MonitorCrossConnect_MonitorCrossConnectsServer) error {
+func (*UnimplementedMonitorCrossConnectServer) MonitorCrossConnects(req *connection.MonitorScopeSelector, srv MonitorCrossConnect_MonitorCrossConnectsServer) error {
 	return status.Errorf(codes.Unimplemented, "method MonitorCrossConnects not implemented")
 }
 
@@ -330,7 +524,7 @@ func RegisterMonitorCrossConnectServer(s *grpc.Server, srv MonitorCrossConnectSe
 }
 
 func _MonitorCrossConnect_MonitorCrossConnects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(connection.MonitorScopeSelector)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
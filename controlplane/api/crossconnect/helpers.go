@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
 )
 
 // NewCrossConnect creates a new crossConnect
@@ -112,3 +113,22 @@ func (c *CrossConnect) GetRemoteDestination() *connection.Connection {
 	}
 	return c.Destination
 }
+
+// MatchesMonitorScopeSelector - returns if source or destination of crossConnect matches selector,
+// a local endpoint is matched by its workspace name
+func (c *CrossConnect) MatchesMonitorScopeSelector(selector *connection.MonitorScopeSelector) bool {
+	if c == nil {
+		return false
+	}
+	if selector.IsEmpty() || selector.MatchesConnection(c.GetSource()) {
+		return true
+	}
+	dst := c.GetDestination()
+	if nse := selector.GetNetworkServiceEndpointName(); nse != "" && dst.GetNetworkServiceEndpointName() == "" {
+		if workspaceNSEName := dst.GetMechanism().GetParameters()[kernel.WorkspaceNSEName]; workspaceNSEName != "" {
+			dst = dst.Clone()
+			dst.NetworkServiceEndpointName = workspaceNSEName
+		}
+	}
+	return selector.MatchesConnection(dst)
+}
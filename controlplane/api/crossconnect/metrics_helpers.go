@@ -0,0 +1,86 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crossconnect
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// NewMetrics creates metrics of an interface collected at timestamp, string counters are filled for compatibility
+func NewMetrics(rx, tx *TrafficCounters, timestamp time.Time) *Metrics {
+	rv := &Metrics{
+		Metrics: map[string]string{
+			"rx_bytes":         fmt.Sprint(rx.GetBytes()),
+			"tx_bytes":         fmt.Sprint(tx.GetBytes()),
+			"rx_packets":       fmt.Sprint(rx.GetPackets()),
+			"tx_packets":       fmt.Sprint(tx.GetPackets()),
+			"rx_error_packets": fmt.Sprint(rx.GetErrors()),
+			"tx_error_packets": fmt.Sprint(tx.GetErrors()),
+		},
+		Rx: rx,
+		Tx: tx,
+	}
+	if ts, err := ptypes.TimestampProto(timestamp); err == nil {
+		rv.Timestamp = ts
+	}
+	return rv
+}
+
+// IsTyped returns if metrics have typed counters
+func (m *Metrics) IsTyped() bool {
+	return m.GetRx() != nil || m.GetTx() != nil
+}
+
+// GetTime returns when metrics are collected, false if it is not known
+func (m *Metrics) GetTime() (time.Time, bool) {
+	if m.GetTimestamp() == nil {
+		return time.Time{}, false
+	}
+	t, err := ptypes.Timestamp(m.GetTimestamp())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Sub returns counters increase since previous ones, counters reset to lower values are treated as increase from 0
+func (m *TrafficCounters) Sub(previous *TrafficCounters) *TrafficCounters {
+	return &TrafficCounters{
+		Bytes:   counterIncrease(m.GetBytes(), previous.GetBytes()),
+		Packets: counterIncrease(m.GetPackets(), previous.GetPackets()),
+		Errors:  counterIncrease(m.GetErrors(), previous.GetErrors()),
+		Drops:   counterIncrease(m.GetDrops(), previous.GetDrops()),
+	}
+}
+
+func counterIncrease(current, previous uint64) uint64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
+
+// Loss returns a ratio of lost probes
+func (m *DatapathProbe) Loss() float64 {
+	if m.GetSent() == 0 {
+		return 0
+	}
+	return float64(m.GetLost()) / float64(m.GetSent())
+}
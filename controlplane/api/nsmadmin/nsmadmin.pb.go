@@ -0,0 +1,819 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: nsmadmin.proto
+
+package nsmadmin
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	connection "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	crossconnect "github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	registry "github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConnectionState mirrors a state of client connection in nsmd model.
+type ConnectionState int32
+
+const (
+	ConnectionState_CONNECTION_READY         ConnectionState = 0
+	ConnectionState_CONNECTION_REQUESTING    ConnectionState = 1
+	ConnectionState_CONNECTION_BROKEN        ConnectionState = 2
+	ConnectionState_CONNECTION_HEALING_BEGIN ConnectionState = 3
+	ConnectionState_CONNECTION_HEALING       ConnectionState = 4
+	ConnectionState_CONNECTION_CLOSING       ConnectionState = 5
+)
+
+var ConnectionState_name = map[int32]string{
+	0: "CONNECTION_READY",
+	1: "CONNECTION_REQUESTING",
+	2: "CONNECTION_BROKEN",
+	3: "CONNECTION_HEALING_BEGIN",
+	4: "CONNECTION_HEALING",
+	5: "CONNECTION_CLOSING",
+}
+
+var ConnectionState_value = map[string]int32{
+	"CONNECTION_READY":         0,
+	"CONNECTION_REQUESTING":    1,
+	"CONNECTION_BROKEN":        2,
+	"CONNECTION_HEALING_BEGIN": 3,
+	"CONNECTION_HEALING":       4,
+	"CONNECTION_CLOSING":       5,
+}
+
+func (x ConnectionState) String() string {
+	return proto.EnumName(ConnectionState_name, int32(x))
+}
+
+func (ConnectionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{0}
+}
+
+// ForwarderState mirrors a state of forwarder programming for a client connection.
+type ForwarderState int32
+
+const (
+	ForwarderState_FORWARDER_NONE  ForwarderState = 0
+	ForwarderState_FORWARDER_READY ForwarderState = 1
+)
+
+var ForwarderState_name = map[int32]string{
+	0: "FORWARDER_NONE",
+	1: "FORWARDER_READY",
+}
+
+var ForwarderState_value = map[string]int32{
+	"FORWARDER_NONE":  0,
+	"FORWARDER_READY": 1,
+}
+
+func (x ForwarderState) String() string {
+	return proto.EnumName(ForwarderState_name, int32(x))
+}
+
+func (ForwarderState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{1}
+}
+
+// HealState mirrors a cause of healing a connection is healed with.
+type HealState int32
+
+const (
+	HealState_HEAL_UNKNOWN        HealState = 0
+	HealState_HEAL_DST_DOWN       HealState = 1
+	HealState_HEAL_SRC_DOWN       HealState = 2
+	HealState_HEAL_FORWARDER_DOWN HealState = 3
+	HealState_HEAL_DST_UPDATE     HealState = 4
+	HealState_HEAL_DST_NSMGR_DOWN HealState = 5
+)
+
+var HealState_name = map[int32]string{
+	0: "HEAL_UNKNOWN",
+	1: "HEAL_DST_DOWN",
+	2: "HEAL_SRC_DOWN",
+	3: "HEAL_FORWARDER_DOWN",
+	4: "HEAL_DST_UPDATE",
+	5: "HEAL_DST_NSMGR_DOWN",
+}
+
+var HealState_value = map[string]int32{
+	"HEAL_UNKNOWN":        0,
+	"HEAL_DST_DOWN":       1,
+	"HEAL_SRC_DOWN":       2,
+	"HEAL_FORWARDER_DOWN": 3,
+	"HEAL_DST_UPDATE":     4,
+	"HEAL_DST_NSMGR_DOWN": 5,
+}
+
+func (x HealState) String() string {
+	return proto.EnumName(HealState_name, int32(x))
+}
+
+func (HealState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{2}
+}
+
+// ClientConnection describes a client connection with a full state nsmd keeps for it.
+type ClientConnection struct {
+	ConnectionId         string                          `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ConnectionState      ConnectionState                 `protobuf:"varint,2,opt,name=connection_state,json=connectionState,proto3,enum=nsmadmin.ConnectionState" json:"connection_state,omitempty"`
+	ForwarderState       ForwarderState                  `protobuf:"varint,3,opt,name=forwarder_state,json=forwarderState,proto3,enum=nsmadmin.ForwarderState" json:"forwarder_state,omitempty"`
+	NetworkService       string                          `protobuf:"bytes,4,opt,name=network_service,json=networkService,proto3" json:"network_service,omitempty"`
+	ForwarderName        string                          `protobuf:"bytes,5,opt,name=forwarder_name,json=forwarderName,proto3" json:"forwarder_name,omitempty"`
+	Xcon                 *crossconnect.CrossConnect      `protobuf:"bytes,6,opt,name=xcon,proto3" json:"xcon,omitempty"`
+	RemoteNsm            *registry.NetworkServiceManager `protobuf:"bytes,7,opt,name=remote_nsm,json=remoteNsm,proto3" json:"remote_nsm,omitempty"`
+	Endpoint             *registry.NSERegistration       `protobuf:"bytes,8,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ClientConnection) Reset()         { *m = ClientConnection{} }
+func (m *ClientConnection) String() string { return proto.CompactTextString(m) }
+func (*ClientConnection) ProtoMessage()    {}
+func (*ClientConnection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{0}
+}
+
+func (m *ClientConnection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientConnection.Unmarshal(m, b)
+}
+func (m *ClientConnection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientConnection.Marshal(b, m, deterministic)
+}
+func (m *ClientConnection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientConnection.Merge(m, src)
+}
+func (m *ClientConnection) XXX_Size() int {
+	return xxx_messageInfo_ClientConnection.Size(m)
+}
+func (m *ClientConnection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientConnection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientConnection proto.InternalMessageInfo
+
+func (m *ClientConnection) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ClientConnection) GetConnectionState() ConnectionState {
+	if m != nil {
+		return m.ConnectionState
+	}
+	return ConnectionState_CONNECTION_READY
+}
+
+func (m *ClientConnection) GetForwarderState() ForwarderState {
+	if m != nil {
+		return m.ForwarderState
+	}
+	return ForwarderState_FORWARDER_NONE
+}
+
+func (m *ClientConnection) GetNetworkService() string {
+	if m != nil {
+		return m.NetworkService
+	}
+	return ""
+}
+
+func (m *ClientConnection) GetForwarderName() string {
+	if m != nil {
+		return m.ForwarderName
+	}
+	return ""
+}
+
+func (m *ClientConnection) GetXcon() *crossconnect.CrossConnect {
+	if m != nil {
+		return m.Xcon
+	}
+	return nil
+}
+
+func (m *ClientConnection) GetRemoteNsm() *registry.NetworkServiceManager {
+	if m != nil {
+		return m.RemoteNsm
+	}
+	return nil
+}
+
+func (m *ClientConnection) GetEndpoint() *registry.NSERegistration {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+type ConnectionList struct {
+	Connections          []*ClientConnection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ConnectionList) Reset()         { *m = ConnectionList{} }
+func (m *ConnectionList) String() string { return proto.CompactTextString(m) }
+func (*ConnectionList) ProtoMessage()    {}
+func (*ConnectionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{1}
+}
+
+func (m *ConnectionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionList.Unmarshal(m, b)
+}
+func (m *ConnectionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionList.Marshal(b, m, deterministic)
+}
+func (m *ConnectionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionList.Merge(m, src)
+}
+func (m *ConnectionList) XXX_Size() int {
+	return xxx_messageInfo_ConnectionList.Size(m)
+}
+func (m *ConnectionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionList proto.InternalMessageInfo
+
+func (m *ConnectionList) GetConnections() []*ClientConnection {
+	if m != nil {
+		return m.Connections
+	}
+	return nil
+}
+
+type HealRequest struct {
+	ConnectionId         string    `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	HealState            HealState `protobuf:"varint,2,opt,name=heal_state,json=healState,proto3,enum=nsmadmin.HealState" json:"heal_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *HealRequest) Reset()         { *m = HealRequest{} }
+func (m *HealRequest) String() string { return proto.CompactTextString(m) }
+func (*HealRequest) ProtoMessage()    {}
+func (*HealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{2}
+}
+
+func (m *HealRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealRequest.Unmarshal(m, b)
+}
+func (m *HealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealRequest.Marshal(b, m, deterministic)
+}
+func (m *HealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealRequest.Merge(m, src)
+}
+func (m *HealRequest) XXX_Size() int {
+	return xxx_messageInfo_HealRequest.Size(m)
+}
+func (m *HealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealRequest proto.InternalMessageInfo
+
+func (m *HealRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *HealRequest) GetHealState() HealState {
+	if m != nil {
+		return m.HealState
+	}
+	return HealState_HEAL_UNKNOWN
+}
+
+type CloseRequest struct {
+	ConnectionId         string   `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseRequest) Reset()         { *m = CloseRequest{} }
+func (m *CloseRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseRequest) ProtoMessage()    {}
+func (*CloseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{3}
+}
+
+func (m *CloseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseRequest.Unmarshal(m, b)
+}
+func (m *CloseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseRequest.Merge(m, src)
+}
+func (m *CloseRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseRequest.Size(m)
+}
+func (m *CloseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseRequest proto.InternalMessageInfo
+
+func (m *CloseRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// CordonRequest marks endpoint as unschedulable for new connections, existing connections are kept.
+type CordonRequest struct {
+	EndpointName         string   `protobuf:"bytes,1,opt,name=endpoint_name,json=endpointName,proto3" json:"endpoint_name,omitempty"`
+	Cordon               bool     `protobuf:"varint,2,opt,name=cordon,proto3" json:"cordon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CordonRequest) Reset()         { *m = CordonRequest{} }
+func (m *CordonRequest) String() string { return proto.CompactTextString(m) }
+func (*CordonRequest) ProtoMessage()    {}
+func (*CordonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{4}
+}
+
+func (m *CordonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CordonRequest.Unmarshal(m, b)
+}
+func (m *CordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CordonRequest.Marshal(b, m, deterministic)
+}
+func (m *CordonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonRequest.Merge(m, src)
+}
+func (m *CordonRequest) XXX_Size() int {
+	return xxx_messageInfo_CordonRequest.Size(m)
+}
+func (m *CordonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonRequest proto.InternalMessageInfo
+
+func (m *CordonRequest) GetEndpointName() string {
+	if m != nil {
+		return m.EndpointName
+	}
+	return ""
+}
+
+func (m *CordonRequest) GetCordon() bool {
+	if m != nil {
+		return m.Cordon
+	}
+	return false
+}
+
+// Forwarder describes a forwarder registered to nsmd and cross connects programmed on it.
+type Forwarder struct {
+	Name                 string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SocketLocation       string                       `protobuf:"bytes,2,opt,name=socket_location,json=socketLocation,proto3" json:"socket_location,omitempty"`
+	LocalMechanisms      []*connection.Mechanism      `protobuf:"bytes,3,rep,name=local_mechanisms,json=localMechanisms,proto3" json:"local_mechanisms,omitempty"`
+	RemoteMechanisms     []*connection.Mechanism      `protobuf:"bytes,4,rep,name=remote_mechanisms,json=remoteMechanisms,proto3" json:"remote_mechanisms,omitempty"`
+	MechanismsConfigured bool                         `protobuf:"varint,5,opt,name=mechanisms_configured,json=mechanismsConfigured,proto3" json:"mechanisms_configured,omitempty"`
+	CrossConnects        []*crossconnect.CrossConnect `protobuf:"bytes,6,rep,name=cross_connects,json=crossConnects,proto3" json:"cross_connects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *Forwarder) Reset()         { *m = Forwarder{} }
+func (m *Forwarder) String() string { return proto.CompactTextString(m) }
+func (*Forwarder) ProtoMessage()    {}
+func (*Forwarder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{5}
+}
+
+func (m *Forwarder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Forwarder.Unmarshal(m, b)
+}
+func (m *Forwarder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Forwarder.Marshal(b, m, deterministic)
+}
+func (m *Forwarder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Forwarder.Merge(m, src)
+}
+func (m *Forwarder) XXX_Size() int {
+	return xxx_messageInfo_Forwarder.Size(m)
+}
+func (m *Forwarder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Forwarder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Forwarder proto.InternalMessageInfo
+
+func (m *Forwarder) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Forwarder) GetSocketLocation() string {
+	if m != nil {
+		return m.SocketLocation
+	}
+	return ""
+}
+
+func (m *Forwarder) GetLocalMechanisms() []*connection.Mechanism {
+	if m != nil {
+		return m.LocalMechanisms
+	}
+	return nil
+}
+
+func (m *Forwarder) GetRemoteMechanisms() []*connection.Mechanism {
+	if m != nil {
+		return m.RemoteMechanisms
+	}
+	return nil
+}
+
+func (m *Forwarder) GetMechanismsConfigured() bool {
+	if m != nil {
+		return m.MechanismsConfigured
+	}
+	return false
+}
+
+func (m *Forwarder) GetCrossConnects() []*crossconnect.CrossConnect {
+	if m != nil {
+		return m.CrossConnects
+	}
+	return nil
+}
+
+type ForwarderList struct {
+	Forwarders           []*Forwarder `protobuf:"bytes,1,rep,name=forwarders,proto3" json:"forwarders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ForwarderList) Reset()         { *m = ForwarderList{} }
+func (m *ForwarderList) String() string { return proto.CompactTextString(m) }
+func (*ForwarderList) ProtoMessage()    {}
+func (*ForwarderList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c754b5a862ef73, []int{6}
+}
+
+func (m *ForwarderList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwarderList.Unmarshal(m, b)
+}
+func (m *ForwarderList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwarderList.Marshal(b, m, deterministic)
+}
+func (m *ForwarderList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwarderList.Merge(m, src)
+}
+func (m *ForwarderList) XXX_Size() int {
+	return xxx_messageInfo_ForwarderList.Size(m)
+}
+func (m *ForwarderList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwarderList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwarderList proto.InternalMessageInfo
+
+func (m *ForwarderList) GetForwarders() []*Forwarder {
+	if m != nil {
+		return m.Forwarders
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("nsmadmin.ConnectionState", ConnectionState_name, ConnectionState_value)
+	proto.RegisterEnum("nsmadmin.ForwarderState", ForwarderState_name, ForwarderState_value)
+	proto.RegisterEnum("nsmadmin.HealState", HealState_name, HealState_value)
+	proto.RegisterType((*ClientConnection)(nil), "nsmadmin.ClientConnection")
+	proto.RegisterType((*ConnectionList)(nil), "nsmadmin.ConnectionList")
+	proto.RegisterType((*HealRequest)(nil), "nsmadmin.HealRequest")
+	proto.RegisterType((*CloseRequest)(nil), "nsmadmin.CloseRequest")
+	proto.RegisterType((*CordonRequest)(nil), "nsmadmin.CordonRequest")
+	proto.RegisterType((*Forwarder)(nil), "nsmadmin.Forwarder")
+	proto.RegisterType((*ForwarderList)(nil), "nsmadmin.ForwarderList")
+}
+
+func init() { proto.RegisterFile("nsmadmin.proto", fileDescriptor_78c754b5a862ef73) }
+
+var fileDescriptor_78c754b5a862ef73 = []byte{
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0xb4, 0x24, 0xa7, 0x8d, 0xe3, 0x4e, 0x37, 0xad, 0x1b, 0x21, 0x51, 0x05, 0x21,
+	0xaa, 0x5e, 0xb8, 0x52, 0x2b, 0x2e, 0x90, 0x10, 0x90, 0x3a, 0xde, 0x6e, 0xb4, 0xe9, 0x04, 0xc6,
+	0xad, 0x56, 0x7b, 0x81, 0x2c, 0xd7, 0x99, 0x24, 0xd6, 0xda, 0x9e, 0xe0, 0x71, 0x28, 0x7d, 0x05,
+	0x5e, 0x84, 0x47, 0xe0, 0x0d, 0x78, 0x07, 0xde, 0x06, 0xcd, 0xd8, 0xf1, 0x4f, 0x49, 0x2b, 0x10,
+	0x7b, 0x13, 0xcd, 0x7c, 0xf3, 0x9d, 0xef, 0x4c, 0xce, 0x77, 0xe6, 0x18, 0xd4, 0x88, 0x87, 0xee,
+	0x34, 0xf4, 0x23, 0x63, 0x19, 0xb3, 0x84, 0xa1, 0xe6, 0x7a, 0xdf, 0xfb, 0x69, 0xee, 0x27, 0x8b,
+	0xd5, 0xbd, 0xe1, 0xb1, 0xf0, 0x3c, 0xa2, 0xc9, 0x03, 0x8b, 0x3f, 0x70, 0x1a, 0xff, 0xe2, 0x7b,
+	0x34, 0xa4, 0x7c, 0xb1, 0x09, 0xf2, 0x58, 0x94, 0xc4, 0x2c, 0x58, 0x06, 0x6e, 0x44, 0xcf, 0xdd,
+	0xa5, 0x2f, 0x80, 0x88, 0x7a, 0x89, 0xcf, 0xa2, 0xd2, 0x32, 0x4d, 0xd4, 0x73, 0x3f, 0x82, 0x7c,
+	0xcc, 0x38, 0xcf, 0x84, 0x2b, 0x9b, 0x2c, 0xc5, 0xfb, 0xff, 0x9f, 0x22, 0xa6, 0x73, 0x9f, 0x27,
+	0xf1, 0x63, 0xbe, 0xc8, 0xa4, 0xf5, 0x65, 0xf2, 0xb8, 0xa4, 0xfc, 0x9c, 0x86, 0xcb, 0xe4, 0x31,
+	0xfd, 0x4d, 0x4f, 0xfa, 0x7f, 0xd4, 0x41, 0x33, 0x03, 0x9f, 0x46, 0x89, 0x99, 0xff, 0x65, 0xf4,
+	0x39, 0xb4, 0x8b, 0x02, 0x38, 0xfe, 0x54, 0x57, 0x4e, 0x94, 0xd3, 0x16, 0xd9, 0x2b, 0xc0, 0xd1,
+	0x14, 0x0d, 0x41, 0x2b, 0x91, 0x78, 0xe2, 0x26, 0x54, 0xaf, 0x9d, 0x28, 0xa7, 0xea, 0xc5, 0xb1,
+	0x91, 0xbb, 0x54, 0x88, 0xda, 0x82, 0x40, 0x3a, 0x5e, 0x15, 0x40, 0x03, 0xe8, 0xcc, 0x58, 0xfc,
+	0xe0, 0xc6, 0x53, 0x1a, 0x67, 0x22, 0x75, 0x29, 0xa2, 0x17, 0x22, 0xaf, 0xd7, 0x84, 0x54, 0x43,
+	0x9d, 0x55, 0xf6, 0xe8, 0x4b, 0xe8, 0x64, 0xb5, 0x71, 0xb2, 0xe2, 0xe8, 0x0d, 0x79, 0x5f, 0x35,
+	0x83, 0xed, 0x14, 0x45, 0x5f, 0x40, 0x11, 0xea, 0x44, 0x6e, 0x48, 0xf5, 0x6d, 0xc9, 0x6b, 0xe7,
+	0x28, 0x76, 0x43, 0x8a, 0x0c, 0x68, 0xfc, 0xea, 0xb1, 0x48, 0xdf, 0x39, 0x51, 0x4e, 0x77, 0x2f,
+	0x7a, 0x46, 0xc5, 0x2a, 0x53, 0x6c, 0xb2, 0x7f, 0x45, 0x24, 0x0f, 0x7d, 0x0b, 0x10, 0xd3, 0x90,
+	0x25, 0xd4, 0x89, 0x78, 0xa8, 0x7f, 0x22, 0xa3, 0x3e, 0x33, 0x72, 0x07, 0x70, 0xe5, 0x12, 0x37,
+	0x6e, 0xe4, 0xce, 0x69, 0x4c, 0x5a, 0x69, 0x08, 0xe6, 0x21, 0xfa, 0x0a, 0x9a, 0x34, 0x9a, 0x2e,
+	0x99, 0x1f, 0x25, 0x7a, 0x53, 0x46, 0x1f, 0x97, 0xa2, 0x6d, 0x8b, 0xa4, 0x6b, 0x57, 0x14, 0x8d,
+	0xe4, 0xd4, 0x3e, 0x06, 0xb5, 0xa8, 0xee, 0xd8, 0xe7, 0x09, 0xfa, 0x06, 0x76, 0x8b, 0xf2, 0x72,
+	0x5d, 0x39, 0xa9, 0xcb, 0xfb, 0x17, 0x66, 0x3c, 0xf1, 0x99, 0x94, 0xe9, 0xfd, 0x19, 0xec, 0xbe,
+	0xa1, 0x6e, 0x40, 0xe8, 0xcf, 0x2b, 0xca, 0x93, 0x7f, 0xd7, 0x03, 0x17, 0x00, 0x0b, 0xea, 0x06,
+	0x15, 0xf7, 0x0f, 0x8a, 0x84, 0x42, 0x2f, 0xf5, 0xac, 0xb5, 0x58, 0x2f, 0xfb, 0x97, 0xb0, 0x67,
+	0x06, 0x8c, 0xd3, 0xff, 0x92, 0xa8, 0x3f, 0x86, 0xb6, 0xc9, 0xe2, 0x29, 0x8b, 0x4a, 0x51, 0xeb,
+	0x4a, 0xa4, 0x56, 0x66, 0x51, 0x6b, 0x50, 0x3a, 0x79, 0x08, 0x3b, 0x9e, 0x8c, 0x92, 0x57, 0x6b,
+	0x92, 0x6c, 0xd7, 0xff, 0xb3, 0x06, 0xad, 0xbc, 0xa9, 0x10, 0x82, 0x46, 0x49, 0x41, 0xae, 0x45,
+	0x4f, 0x71, 0xe6, 0x7d, 0xa0, 0x89, 0x13, 0x30, 0x4f, 0x56, 0x5e, 0x4a, 0xb4, 0x88, 0x9a, 0xc2,
+	0xe3, 0x0c, 0x45, 0xdf, 0x83, 0x26, 0x18, 0x81, 0x13, 0x52, 0x6f, 0xe1, 0x46, 0x3e, 0x0f, 0xb9,
+	0x5e, 0x97, 0x85, 0xef, 0x1a, 0xa5, 0x21, 0x72, 0xb3, 0x3e, 0x25, 0x1d, 0x49, 0xcf, 0xf7, 0x1c,
+	0x5d, 0xc1, 0x7e, 0xd6, 0x3e, 0x25, 0x89, 0xc6, 0x4b, 0x12, 0x5a, 0xca, 0x2f, 0x69, 0x5c, 0x42,
+	0xb7, 0x08, 0x76, 0x3c, 0x16, 0xcd, 0xfc, 0xf9, 0x2a, 0xa6, 0x53, 0xd9, 0xe0, 0x4d, 0xf2, 0xaa,
+	0x38, 0x34, 0xf3, 0x33, 0x34, 0x00, 0x55, 0xb6, 0xb6, 0x93, 0x25, 0xe1, 0xfa, 0x4e, 0xd6, 0x31,
+	0xcf, 0x77, 0x7c, 0xdb, 0x2b, 0xed, 0x78, 0x7f, 0x08, 0xed, 0xbc, 0x8e, 0xb2, 0x05, 0x2f, 0x01,
+	0xf2, 0xc7, 0xb4, 0xee, 0xc0, 0x83, 0x0d, 0x2f, 0x99, 0x94, 0x68, 0x67, 0xbf, 0x2b, 0xd0, 0x79,
+	0x32, 0x28, 0xd0, 0x2b, 0xd0, 0xcc, 0x09, 0xc6, 0x96, 0x79, 0x3b, 0x9a, 0x60, 0x87, 0x58, 0x83,
+	0xe1, 0x7b, 0x6d, 0x0b, 0x1d, 0x43, 0xb7, 0x82, 0xfe, 0x78, 0x67, 0xd9, 0xb7, 0x23, 0x7c, 0xad,
+	0x29, 0xa8, 0x0b, 0xfb, 0xa5, 0xa3, 0x2b, 0x32, 0x79, 0x6b, 0x61, 0xad, 0x86, 0x3e, 0x05, 0xbd,
+	0x04, 0xbf, 0xb1, 0x06, 0xe3, 0x11, 0xbe, 0x76, 0xae, 0xac, 0xeb, 0x11, 0xd6, 0xea, 0xe8, 0x10,
+	0xd0, 0x3f, 0x4f, 0xb5, 0xc6, 0x13, 0xdc, 0x1c, 0x4f, 0x6c, 0x81, 0x6f, 0x9f, 0x7d, 0x0d, 0x6a,
+	0x75, 0x18, 0x21, 0x04, 0xea, 0xeb, 0x09, 0x79, 0x37, 0x20, 0x43, 0x8b, 0x38, 0x78, 0x82, 0x2d,
+	0x6d, 0x0b, 0x1d, 0x40, 0xa7, 0xc0, 0xd2, 0xab, 0x2b, 0x67, 0xbf, 0x29, 0xd0, 0xca, 0xdf, 0x03,
+	0xd2, 0x60, 0x4f, 0x64, 0x73, 0xee, 0xf0, 0x5b, 0x3c, 0x79, 0x87, 0xb5, 0x2d, 0xb4, 0x0f, 0x6d,
+	0x89, 0x0c, 0xed, 0x5b, 0x67, 0x28, 0x20, 0x25, 0x87, 0x6c, 0x62, 0xa6, 0x50, 0x0d, 0x1d, 0xc1,
+	0x81, 0x84, 0x0a, 0x7d, 0x79, 0x50, 0x17, 0x39, 0xf3, 0xf0, 0xbb, 0x1f, 0x86, 0x83, 0x5b, 0x4b,
+	0x6b, 0xe4, 0x6c, 0x01, 0x62, 0xfb, 0xe6, 0x3a, 0x63, 0x6f, 0x5f, 0xfc, 0x55, 0x83, 0x26, 0xb6,
+	0x6f, 0x06, 0xc2, 0x14, 0x64, 0x42, 0x47, 0x78, 0x57, 0x38, 0xc0, 0xd1, 0xa1, 0x31, 0x67, 0x6c,
+	0x1e, 0xd0, 0xf4, 0x23, 0x71, 0xbf, 0x9a, 0x19, 0x96, 0xf8, 0x66, 0xf4, 0xf4, 0x4d, 0x93, 0x5d,
+	0x1a, 0xff, 0x1d, 0xa8, 0xe2, 0xdf, 0x15, 0x28, 0xea, 0x56, 0xe7, 0x40, 0xf6, 0x70, 0x7b, 0xcf,
+	0x48, 0x8b, 0x0f, 0x81, 0x1c, 0x0b, 0x25, 0x85, 0xc3, 0xf2, 0xe8, 0x2a, 0x26, 0xc6, 0x0b, 0x12,
+	0x6a, 0x3a, 0x24, 0xac, 0x6c, 0x08, 0xa0, 0xa3, 0xf2, 0x7d, 0x4b, 0xe3, 0xe3, 0x25, 0x89, 0xe1,
+	0x2a, 0x5c, 0xe6, 0x26, 0x3f, 0x5f, 0x8a, 0xa3, 0x0d, 0x5d, 0x2d, 0x2a, 0x71, 0xbf, 0x23, 0x89,
+	0x97, 0x7f, 0x0f, 0x00, 0xfa, 0x56, 0x98, 0x07, 0xab, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// NSMAdminClient is the client API for NSMAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NSMAdminClient interface {
+	ListConnections(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ConnectionList, error)
+	HealConnection(ctx context.Context, in *HealRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CloseConnection(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CordonEndpoint(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DumpForwarders(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ForwarderList, error)
+}
+
+type nSMAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNSMAdminClient(cc grpc.ClientConnInterface) NSMAdminClient {
+	return &nSMAdminClient{cc}
+}
+
+func (c *nSMAdminClient) ListConnections(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ConnectionList, error) {
+	out := new(ConnectionList)
+	err := c.cc.Invoke(ctx, "/nsmadmin.NSMAdmin/ListConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nSMAdminClient) HealConnection(ctx context.Context, in *HealRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nsmadmin.NSMAdmin/HealConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nSMAdminClient) CloseConnection(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nsmadmin.NSMAdmin/CloseConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nSMAdminClient) CordonEndpoint(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nsmadmin.NSMAdmin/CordonEndpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nSMAdminClient) DumpForwarders(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ForwarderList, error) {
+	out := new(ForwarderList)
+	err := c.cc.Invoke(ctx, "/nsmadmin.NSMAdmin/DumpForwarders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NSMAdminServer is the server API for NSMAdmin service.
+type NSMAdminServer interface {
+	ListConnections(context.Context, *empty.Empty) (*ConnectionList, error)
+	HealConnection(context.Context, *HealRequest) (*empty.Empty, error)
+	CloseConnection(context.Context, *CloseRequest) (*empty.Empty, error)
+	CordonEndpoint(context.Context, *CordonRequest) (*empty.Empty, error)
+	DumpForwarders(context.Context, *empty.Empty) (*ForwarderList, error)
+}
+
+// UnimplementedNSMAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedNSMAdminServer struct {
+}
+
+func (*UnimplementedNSMAdminServer) ListConnections(ctx context.Context, req *empty.Empty) (*ConnectionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (*UnimplementedNSMAdminServer) HealConnection(ctx context.Context, req *HealRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealConnection not implemented")
+}
+func (*UnimplementedNSMAdminServer) CloseConnection(ctx context.Context, req *CloseRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseConnection not implemented")
+}
+func (*UnimplementedNSMAdminServer) CordonEndpoint(ctx context.Context, req *CordonRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonEndpoint not implemented")
+}
+func (*UnimplementedNSMAdminServer) DumpForwarders(ctx context.Context, req *empty.Empty) (*ForwarderList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpForwarders not implemented")
+}
+
+func RegisterNSMAdminServer(s *grpc.Server, srv NSMAdminServer) {
+	s.RegisterService(&_NSMAdmin_serviceDesc, srv)
+}
+
+func _NSMAdmin_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NSMAdminServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nsmadmin.NSMAdmin/ListConnections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NSMAdminServer).ListConnections(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NSMAdmin_HealConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NSMAdminServer).HealConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nsmadmin.NSMAdmin/HealConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NSMAdminServer).HealConnection(ctx, req.(*HealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NSMAdmin_CloseConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NSMAdminServer).CloseConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nsmadmin.NSMAdmin/CloseConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NSMAdminServer).CloseConnection(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NSMAdmin_CordonEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NSMAdminServer).CordonEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nsmadmin.NSMAdmin/CordonEndpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NSMAdminServer).CordonEndpoint(ctx, req.(*CordonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NSMAdmin_DumpForwarders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NSMAdminServer).DumpForwarders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nsmadmin.NSMAdmin/DumpForwarders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NSMAdminServer).DumpForwarders(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NSMAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nsmadmin.NSMAdmin",
+	HandlerType: (*NSMAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListConnections",
+			Handler:    _NSMAdmin_ListConnections_Handler,
+		},
+		{
+			MethodName: "HealConnection",
+			Handler:    _NSMAdmin_HealConnection_Handler,
+		},
+		{
+			MethodName: "CloseConnection",
+			Handler:    _NSMAdmin_CloseConnection_Handler,
+		},
+		{
+			MethodName: "CordonEndpoint",
+			Handler:    _NSMAdmin_CordonEndpoint_Handler,
+		},
+		{
+			MethodName: "DumpForwarders",
+			Handler:    _NSMAdmin_DumpForwarders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nsmadmin.proto",
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -24,19 +25,132 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-// ConnectionRequest is sent by a NSM client to build a connection with NSM.
-type ClientConnectionRequest struct {
-	Workspace            string   `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+// ContainerInfo describes a container workspace is allocated for.
+type ContainerInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DeviceIds            []string `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *ContainerInfo) Reset()         { *m = ContainerInfo{} }
+func (m *ContainerInfo) String() string { return proto.CompactTextString(m) }
+func (*ContainerInfo) ProtoMessage()    {}
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084cb5dcc765b124, []int{0}
+}
+
+func (m *ContainerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerInfo.Unmarshal(m, b)
+}
+func (m *ContainerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerInfo.Marshal(b, m, deterministic)
+}
+func (m *ContainerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerInfo.Merge(m, src)
+}
+func (m *ContainerInfo) XXX_Size() int {
+	return xxx_messageInfo_ContainerInfo.Size(m)
+}
+func (m *ContainerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerInfo proto.InternalMessageInfo
+
+func (m *ContainerInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetDeviceIds() []string {
+	if m != nil {
+		return m.DeviceIds
+	}
+	return nil
+}
+
+// WorkspaceMetadata describes a workload workspace is allocated for.
+type WorkspaceMetadata struct {
+	PodName              string           `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	Namespace            string           `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodUid               string           `protobuf:"bytes,3,opt,name=pod_uid,json=podUid,proto3" json:"pod_uid,omitempty"`
+	Containers           []*ContainerInfo `protobuf:"bytes,4,rep,name=containers,proto3" json:"containers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WorkspaceMetadata) Reset()         { *m = WorkspaceMetadata{} }
+func (m *WorkspaceMetadata) String() string { return proto.CompactTextString(m) }
+func (*WorkspaceMetadata) ProtoMessage()    {}
+func (*WorkspaceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084cb5dcc765b124, []int{1}
+}
+
+func (m *WorkspaceMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkspaceMetadata.Unmarshal(m, b)
+}
+func (m *WorkspaceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkspaceMetadata.Marshal(b, m, deterministic)
+}
+func (m *WorkspaceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkspaceMetadata.Merge(m, src)
+}
+func (m *WorkspaceMetadata) XXX_Size() int {
+	return xxx_messageInfo_WorkspaceMetadata.Size(m)
+}
+func (m *WorkspaceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkspaceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkspaceMetadata proto.InternalMessageInfo
+
+func (m *WorkspaceMetadata) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *WorkspaceMetadata) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkspaceMetadata) GetPodUid() string {
+	if m != nil {
+		return m.PodUid
+	}
+	return ""
+}
+
+func (m *WorkspaceMetadata) GetContainers() []*ContainerInfo {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+// ConnectionRequest is sent by a NSM client to build a connection with NSM.
+type ClientConnectionRequest struct {
+	Workspace            string             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Metadata             *WorkspaceMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
 func (m *ClientConnectionRequest) Reset()         { *m = ClientConnectionRequest{} }
 func (m *ClientConnectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ClientConnectionRequest) ProtoMessage()    {}
 func (*ClientConnectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_084cb5dcc765b124, []int{0}
+	return fileDescriptor_084cb5dcc765b124, []int{2}
 }
 
 func (m *ClientConnectionRequest) XXX_Unmarshal(b []byte) error {
@@ -64,6 +178,13 @@ func (m *ClientConnectionRequest) GetWorkspace() string {
 	return ""
 }
 
+func (m *ClientConnectionRequest) GetMetadata() *WorkspaceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // ClientConnectionReply is sent back by NSM as a reply to ClientConnectionRequest
 // accepted true will indicate that the connection is accepted, otherwise false
 // indicates that connection was refused and admission_error will provide details
@@ -83,7 +204,7 @@ func (m *ClientConnectionReply) Reset()         { *m = ClientConnectionReply{} }
 func (m *ClientConnectionReply) String() string { return proto.CompactTextString(m) }
 func (*ClientConnectionReply) ProtoMessage()    {}
 func (*ClientConnectionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_084cb5dcc765b124, []int{1}
+	return fileDescriptor_084cb5dcc765b124, []int{3}
 }
 
 func (m *ClientConnectionReply) XXX_Unmarshal(b []byte) error {
@@ -151,7 +272,7 @@ func (m *DeleteConnectionRequest) Reset()         { *m = DeleteConnectionRequest
 func (m *DeleteConnectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteConnectionRequest) ProtoMessage()    {}
 func (*DeleteConnectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_084cb5dcc765b124, []int{2}
+	return fileDescriptor_084cb5dcc765b124, []int{4}
 }
 
 func (m *DeleteConnectionRequest) XXX_Unmarshal(b []byte) error {
@@ -189,7 +310,7 @@ func (m *DeleteConnectionReply) Reset()         { *m = DeleteConnectionReply{} }
 func (m *DeleteConnectionReply) String() string { return proto.CompactTextString(m) }
 func (*DeleteConnectionReply) ProtoMessage()    {}
 func (*DeleteConnectionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_084cb5dcc765b124, []int{3}
+	return fileDescriptor_084cb5dcc765b124, []int{5}
 }
 
 func (m *DeleteConnectionReply) XXX_Unmarshal(b []byte) error {
@@ -220,7 +341,7 @@ func (m *EnumConnectionRequest) Reset()         { *m = EnumConnectionRequest{} }
 func (m *EnumConnectionRequest) String() string { return proto.CompactTextString(m) }
 func (*EnumConnectionRequest) ProtoMessage()    {}
 func (*EnumConnectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_084cb5dcc765b124, []int{4}
+	return fileDescriptor_084cb5dcc765b124, []int{6}
 }
 
 func (m *EnumConnectionRequest) XXX_Unmarshal(b []byte) error {
@@ -241,18 +362,92 @@ func (m *EnumConnectionRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EnumConnectionRequest proto.InternalMessageInfo
 
+// WorkspaceInfo describes a workspace with a workload it is allocated for and its current usage.
+type WorkspaceInfo struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata             *WorkspaceMetadata   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreationTime         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	ActiveConnections    uint32               `protobuf:"varint,4,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	RegisteredEndpoints  uint32               `protobuf:"varint,5,opt,name=registered_endpoints,json=registeredEndpoints,proto3" json:"registered_endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WorkspaceInfo) Reset()         { *m = WorkspaceInfo{} }
+func (m *WorkspaceInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkspaceInfo) ProtoMessage()    {}
+func (*WorkspaceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084cb5dcc765b124, []int{7}
+}
+
+func (m *WorkspaceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkspaceInfo.Unmarshal(m, b)
+}
+func (m *WorkspaceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkspaceInfo.Marshal(b, m, deterministic)
+}
+func (m *WorkspaceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkspaceInfo.Merge(m, src)
+}
+func (m *WorkspaceInfo) XXX_Size() int {
+	return xxx_messageInfo_WorkspaceInfo.Size(m)
+}
+func (m *WorkspaceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkspaceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkspaceInfo proto.InternalMessageInfo
+
+func (m *WorkspaceInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkspaceInfo) GetMetadata() *WorkspaceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *WorkspaceInfo) GetCreationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreationTime
+	}
+	return nil
+}
+
+func (m *WorkspaceInfo) GetActiveConnections() uint32 {
+	if m != nil {
+		return m.ActiveConnections
+	}
+	return 0
+}
+
+func (m *WorkspaceInfo) GetRegisteredEndpoints() uint32 {
+	if m != nil {
+		return m.RegisteredEndpoints
+	}
+	return 0
+}
+
+// EnumConnectionReply contains names of all workspaces in workspace and full workspace descriptors in workspaces.
 type EnumConnectionReply struct {
-	Workspace            []string `protobuf:"bytes,1,rep,name=workspace,proto3" json:"workspace,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Workspace            []string         `protobuf:"bytes,1,rep,name=workspace,proto3" json:"workspace,omitempty"`
+	Workspaces           []*WorkspaceInfo `protobuf:"bytes,2,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *EnumConnectionReply) Reset()         { *m = EnumConnectionReply{} }
 func (m *EnumConnectionReply) String() string { return proto.CompactTextString(m) }
 func (*EnumConnectionReply) ProtoMessage()    {}
 func (*EnumConnectionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_084cb5dcc765b124, []int{5}
+	return fileDescriptor_084cb5dcc765b124, []int{8}
 }
 
 func (m *EnumConnectionReply) XXX_Unmarshal(b []byte) error {
@@ -280,37 +475,147 @@ func (m *EnumConnectionReply) GetWorkspace() []string {
 	return nil
 }
 
+func (m *EnumConnectionReply) GetWorkspaces() []*WorkspaceInfo {
+	if m != nil {
+		return m.Workspaces
+	}
+	return nil
+}
+
+// UpdateConnectionRequest is sent by a nsm-k8s to update metadata of existing workspace.
+type UpdateConnectionRequest struct {
+	Workspace            string             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Metadata             *WorkspaceMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *UpdateConnectionRequest) Reset()         { *m = UpdateConnectionRequest{} }
+func (m *UpdateConnectionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateConnectionRequest) ProtoMessage()    {}
+func (*UpdateConnectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084cb5dcc765b124, []int{9}
+}
+
+func (m *UpdateConnectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateConnectionRequest.Unmarshal(m, b)
+}
+func (m *UpdateConnectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateConnectionRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateConnectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConnectionRequest.Merge(m, src)
+}
+func (m *UpdateConnectionRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateConnectionRequest.Size(m)
+}
+func (m *UpdateConnectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConnectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConnectionRequest proto.InternalMessageInfo
+
+func (m *UpdateConnectionRequest) GetWorkspace() string {
+	if m != nil {
+		return m.Workspace
+	}
+	return ""
+}
+
+func (m *UpdateConnectionRequest) GetMetadata() *WorkspaceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type UpdateConnectionReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateConnectionReply) Reset()         { *m = UpdateConnectionReply{} }
+func (m *UpdateConnectionReply) String() string { return proto.CompactTextString(m) }
+func (*UpdateConnectionReply) ProtoMessage()    {}
+func (*UpdateConnectionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084cb5dcc765b124, []int{10}
+}
+
+func (m *UpdateConnectionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateConnectionReply.Unmarshal(m, b)
+}
+func (m *UpdateConnectionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateConnectionReply.Marshal(b, m, deterministic)
+}
+func (m *UpdateConnectionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConnectionReply.Merge(m, src)
+}
+func (m *UpdateConnectionReply) XXX_Size() int {
+	return xxx_messageInfo_UpdateConnectionReply.Size(m)
+}
+func (m *UpdateConnectionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConnectionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConnectionReply proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*ContainerInfo)(nil), "nsmdapi.ContainerInfo")
+	proto.RegisterType((*WorkspaceMetadata)(nil), "nsmdapi.WorkspaceMetadata")
 	proto.RegisterType((*ClientConnectionRequest)(nil), "nsmdapi.ClientConnectionRequest")
 	proto.RegisterType((*ClientConnectionReply)(nil), "nsmdapi.ClientConnectionReply")
 	proto.RegisterType((*DeleteConnectionRequest)(nil), "nsmdapi.DeleteConnectionRequest")
 	proto.RegisterType((*DeleteConnectionReply)(nil), "nsmdapi.DeleteConnectionReply")
 	proto.RegisterType((*EnumConnectionRequest)(nil), "nsmdapi.EnumConnectionRequest")
+	proto.RegisterType((*WorkspaceInfo)(nil), "nsmdapi.WorkspaceInfo")
 	proto.RegisterType((*EnumConnectionReply)(nil), "nsmdapi.EnumConnectionReply")
+	proto.RegisterType((*UpdateConnectionRequest)(nil), "nsmdapi.UpdateConnectionRequest")
+	proto.RegisterType((*UpdateConnectionReply)(nil), "nsmdapi.UpdateConnectionReply")
 }
 
 func init() { proto.RegisterFile("nsmd.proto", fileDescriptor_084cb5dcc765b124) }
 
 var fileDescriptor_084cb5dcc765b124 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4a, 0xf3, 0x40,
-	0x14, 0x25, 0x6d, 0xbf, 0x4f, 0x7a, 0x45, 0x85, 0x91, 0x9a, 0x50, 0x8a, 0x84, 0xe0, 0xa2, 0xab,
-	0x2c, 0xec, 0xc2, 0xbd, 0x8d, 0x4b, 0xbb, 0x68, 0x76, 0xba, 0x8a, 0xe9, 0x05, 0x87, 0x26, 0x33,
-	0xe3, 0xcc, 0x54, 0xe9, 0x53, 0xf8, 0x6c, 0xbe, 0x91, 0xcc, 0x38, 0xd8, 0xfc, 0x16, 0xdc, 0x85,
-	0xf3, 0x97, 0x93, 0x73, 0x03, 0xc0, 0x54, 0xb9, 0x89, 0x85, 0xe4, 0x9a, 0x93, 0x13, 0xf3, 0x9c,
-	0x09, 0x1a, 0xdd, 0x81, 0xbf, 0x2c, 0x28, 0x32, 0xbd, 0xe4, 0x8c, 0x61, 0xae, 0x29, 0x67, 0x6b,
-	0x7c, 0xdb, 0xa1, 0xd2, 0x64, 0x06, 0xe3, 0x0f, 0x2e, 0xb7, 0x4a, 0x64, 0x39, 0x06, 0x5e, 0xe8,
-	0xcd, 0xc7, 0xeb, 0x03, 0x10, 0x7d, 0x79, 0x30, 0x69, 0x3b, 0x45, 0xb1, 0x3f, 0xee, 0x23, 0x21,
-	0x9c, 0xbe, 0x72, 0xa5, 0xef, 0x33, 0x85, 0x1b, 0x2a, 0x83, 0x81, 0xe5, 0xab, 0x10, 0xb9, 0x81,
-	0xb3, 0xdc, 0x06, 0x1b, 0x20, 0xa1, 0x32, 0x18, 0x5a, 0x4d, 0x1d, 0x24, 0x73, 0xb8, 0x60, 0xaa,
-	0x4c, 0x51, 0xbe, 0xa3, 0x4c, 0x79, 0xbe, 0x45, 0x1d, 0x8c, 0xac, 0xae, 0x09, 0x3b, 0xe5, 0x4f,
-	0x57, 0xa7, 0xfc, 0xf7, 0xab, 0xac, 0xc2, 0x66, 0x8c, 0x04, 0x0b, 0xd4, 0xf8, 0xd7, 0x31, 0x7c,
-	0x98, 0xb4, 0x8d, 0xa2, 0xd8, 0x1b, 0xe2, 0x81, 0xed, 0xca, 0x56, 0x5e, 0xb4, 0x80, 0xcb, 0x26,
-	0xd1, 0xb1, 0xdd, 0xb0, 0xf6, 0x9a, 0xdb, 0xcf, 0x01, 0x8c, 0x56, 0xe9, 0x63, 0x42, 0x9e, 0xc1,
-	0x77, 0x41, 0xcd, 0x13, 0x90, 0x30, 0x76, 0xa7, 0x8d, 0x7b, 0xee, 0x3a, 0xbd, 0x3e, 0xa2, 0x30,
-	0x1d, 0x56, 0x70, 0x5e, 0xaf, 0x46, 0x0e, 0x8e, 0xce, 0x8f, 0x99, 0xce, 0x7a, 0x79, 0x93, 0xf7,
-	0x04, 0x57, 0x6e, 0x9c, 0xfe, 0xae, 0x3d, 0xb3, 0x57, 0xba, 0x76, 0xee, 0xfb, 0xf2, 0xdf, 0xfe,
-	0xce, 0x8b, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xad, 0x32, 0x4d, 0xdc, 0x02, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x55, 0x48, 0x3e, 0x20, 0x97, 0x2f, 0xad, 0x18, 0x0a, 0x71, 0x2d, 0x8a, 0x2c, 0xab, 0x8b,
+	0x6c, 0x9a, 0xaa, 0x54, 0xa2, 0xcb, 0x4a, 0xfc, 0x2c, 0x58, 0xc0, 0xc2, 0x14, 0x55, 0x6a, 0x17,
+	0xd6, 0xe0, 0xb9, 0xd0, 0x11, 0xf6, 0xcc, 0xd4, 0x33, 0xa1, 0xca, 0xc3, 0xf4, 0xa1, 0xfa, 0x1c,
+	0x7d, 0x87, 0xaa, 0x1a, 0x7b, 0x62, 0x3b, 0x09, 0xa6, 0x82, 0x9d, 0x7d, 0xee, 0x99, 0x3b, 0xe7,
+	0x9e, 0xb9, 0x07, 0x40, 0xe8, 0x8c, 0x8d, 0x55, 0x2e, 0x8d, 0x24, 0x6b, 0xf6, 0x9b, 0x2a, 0xee,
+	0x07, 0xca, 0x4c, 0x15, 0xea, 0xb7, 0x86, 0x67, 0xa8, 0x0d, 0xcd, 0x54, 0xfd, 0x55, 0x52, 0xc3,
+	0x43, 0x18, 0x1c, 0x49, 0x61, 0x28, 0x17, 0x98, 0x9f, 0x8a, 0x6b, 0x49, 0x08, 0xf4, 0x04, 0xcd,
+	0xd0, 0xeb, 0x04, 0x9d, 0x51, 0x3f, 0x2a, 0xbe, 0xc9, 0x2b, 0x00, 0x86, 0x77, 0x3c, 0xc1, 0x98,
+	0x33, 0xed, 0xad, 0x04, 0xdd, 0x51, 0x3f, 0xea, 0x97, 0xc8, 0x29, 0xd3, 0xe1, 0xcf, 0x0e, 0x6c,
+	0x7e, 0x96, 0xf9, 0xad, 0x56, 0x34, 0xc1, 0x33, 0x34, 0x94, 0x51, 0x43, 0xc9, 0x4b, 0x58, 0x57,
+	0x92, 0xc5, 0x8d, 0x66, 0x6b, 0x4a, 0xb2, 0x73, 0xdb, 0x6f, 0x17, 0xfa, 0x16, 0x2e, 0xf8, 0xde,
+	0x4a, 0x51, 0xab, 0x01, 0x32, 0x04, 0x4b, 0x8c, 0x27, 0x9c, 0x79, 0xdd, 0xa2, 0xb6, 0xaa, 0x24,
+	0xbb, 0xe4, 0x8c, 0x1c, 0x00, 0x24, 0x33, 0xad, 0xda, 0xeb, 0x05, 0xdd, 0xd1, 0xc6, 0xfe, 0xce,
+	0xd8, 0xcd, 0x3a, 0x9e, 0x1b, 0x23, 0x6a, 0x30, 0x43, 0x09, 0xc3, 0xa3, 0x94, 0xa3, 0x30, 0x47,
+	0x52, 0x08, 0x4c, 0x0c, 0x97, 0x22, 0xc2, 0xef, 0x13, 0xd4, 0xc6, 0x2a, 0xf9, 0x31, 0x53, 0xee,
+	0x54, 0xd6, 0x00, 0x39, 0x80, 0xf5, 0xcc, 0x8d, 0x53, 0xc8, 0xdc, 0xd8, 0xf7, 0xab, 0xeb, 0x96,
+	0x06, 0x8e, 0x2a, 0x6e, 0xf8, 0xab, 0x03, 0xdb, 0xcb, 0x37, 0xaa, 0x74, 0xfa, 0x8f, 0xfb, 0x02,
+	0xd8, 0xf8, 0x26, 0xb5, 0x39, 0xa4, 0x1a, 0x19, 0xcf, 0x9d, 0x33, 0x4d, 0x88, 0xbc, 0x86, 0x41,
+	0x52, 0x34, 0xb6, 0xc0, 0x31, 0xcf, 0x9d, 0x43, 0xf3, 0x20, 0x19, 0xc1, 0x73, 0xa1, 0xb3, 0x0b,
+	0xcc, 0xef, 0x30, 0xbf, 0x90, 0xc9, 0x2d, 0x1a, 0xaf, 0x57, 0xf0, 0x16, 0x61, 0xc7, 0x2c, 0xb5,
+	0x3a, 0xe6, 0x7f, 0x15, 0xb3, 0x09, 0x87, 0x1f, 0x60, 0x78, 0x8c, 0x29, 0x1a, 0x7c, 0xa4, 0x89,
+	0xe1, 0x10, 0xb6, 0x97, 0x0f, 0xaa, 0x74, 0x6a, 0x0b, 0x27, 0x62, 0x92, 0x2d, 0xf5, 0x0b, 0xff,
+	0x74, 0x60, 0x50, 0xd9, 0xdb, 0xba, 0x94, 0x4f, 0x7c, 0x1c, 0xf2, 0x11, 0x06, 0x49, 0x8e, 0xd4,
+	0x5e, 0x18, 0xdb, 0x34, 0x78, 0x5d, 0x77, 0xf8, 0x46, 0xca, 0x9b, 0x14, 0xcb, 0x5c, 0x5c, 0x4d,
+	0xae, 0xc7, 0x9f, 0x66, 0x51, 0x89, 0xfe, 0x9f, 0x1d, 0xb0, 0x10, 0x79, 0x03, 0x84, 0x26, 0x86,
+	0xdf, 0x61, 0x9c, 0x54, 0xd2, 0x75, 0x61, 0xf0, 0x20, 0xda, 0x2c, 0x2b, 0xf5, 0x4c, 0x9a, 0xbc,
+	0x83, 0x17, 0x39, 0xde, 0x70, 0x6d, 0x30, 0x47, 0x16, 0xa3, 0x60, 0x4a, 0x72, 0x61, 0x74, 0xe1,
+	0xf3, 0x20, 0xda, 0xaa, 0x6b, 0x27, 0xb3, 0x52, 0x78, 0x0b, 0x5b, 0x8b, 0xce, 0xdc, 0xb3, 0x3c,
+	0xdd, 0xc5, 0x65, 0x85, 0xea, 0xa7, 0x0c, 0x69, 0x33, 0x1d, 0x73, 0x7e, 0x46, 0x0d, 0xa6, 0x4d,
+	0xc7, 0xa5, 0x62, 0xf4, 0xd1, 0x0f, 0xfb, 0xe4, 0x74, 0x0c, 0x61, 0x7b, 0xf9, 0x42, 0x95, 0x4e,
+	0xf7, 0x7f, 0xaf, 0x40, 0xef, 0xfc, 0xe2, 0xec, 0x98, 0x7c, 0x85, 0xa1, 0x93, 0xb0, 0x98, 0x22,
+	0x12, 0xd4, 0x79, 0xbf, 0x3f, 0xd2, 0xfe, 0xde, 0x03, 0x0c, 0xeb, 0xe2, 0x39, 0x3c, 0x9b, 0x37,
+	0x97, 0xd4, 0x27, 0xee, 0xdd, 0x47, 0x7f, 0xb7, 0xb5, 0x6e, 0xfb, 0x7d, 0x81, 0x1d, 0xb7, 0xdf,
+	0xed, 0x5a, 0x5b, 0x92, 0xe3, 0xef, 0x3d, 0xc0, 0x70, 0xbd, 0x9d, 0x55, 0xed, 0xbd, 0x5b, 0x1e,
+	0xcf, 0xdf, 0x7b, 0x80, 0xa1, 0xd2, 0xe9, 0xd5, 0x6a, 0xb1, 0xe8, 0xef, 0xff, 0x0e, 0x00, 0x1d,
+	0x38, 0xa0, 0x74, 0x39, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -328,6 +633,7 @@ type NSMDClient interface {
 	RequestClientConnection(ctx context.Context, in *ClientConnectionRequest, opts ...grpc.CallOption) (*ClientConnectionReply, error)
 	EnumConnection(ctx context.Context, in *EnumConnectionRequest, opts ...grpc.CallOption) (*EnumConnectionReply, error)
 	DeleteClientConnection(ctx context.Context, in *DeleteConnectionRequest, opts ...grpc.CallOption) (*DeleteConnectionReply, error)
+	UpdateClientConnection(ctx context.Context, in *UpdateConnectionRequest, opts ...grpc.CallOption) (*UpdateConnectionReply, error)
 }
 
 type nSMDClient struct {
@@ -365,11 +671,21 @@ func (c *nSMDClient) DeleteClientConnection(ctx context.Context, in *DeleteConne
 	return out, nil
 }
 
+func (c *nSMDClient) UpdateClientConnection(ctx context.Context, in *UpdateConnectionRequest, opts ...grpc.CallOption) (*UpdateConnectionReply, error) {
+	out := new(UpdateConnectionReply)
+	err := c.cc.Invoke(ctx, "/nsmdapi.NSMD/UpdateClientConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NSMDServer is the server API for NSMD service.
 type NSMDServer interface {
 	RequestClientConnection(context.Context, *ClientConnectionRequest) (*ClientConnectionReply, error)
 	EnumConnection(context.Context, *EnumConnectionRequest) (*EnumConnectionReply, error)
 	DeleteClientConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionReply, error)
+	UpdateClientConnection(context.Context, *UpdateConnectionRequest) (*UpdateConnectionReply, error)
 }
 
 // UnimplementedNSMDServer can be embedded to have forward compatible implementations.
@@ -385,6 +701,9 @@ func (*UnimplementedNSMDServer) EnumConnection(ctx context.Context, req *EnumCon
 func (*UnimplementedNSMDServer) DeleteClientConnection(ctx context.Context, req *DeleteConnectionRequest) (*DeleteConnectionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientConnection not implemented")
 }
+func (*UnimplementedNSMDServer) UpdateClientConnection(ctx context.Context, req *UpdateConnectionRequest) (*UpdateConnectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientConnection not implemented")
+}
 
 func RegisterNSMDServer(s *grpc.Server, srv NSMDServer) {
 	s.RegisterService(&_NSMD_serviceDesc, srv)
@@ -444,6 +763,24 @@ func _NSMD_DeleteClientConnection_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NSMD_UpdateClientConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NSMDServer).UpdateClientConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nsmdapi.NSMD/UpdateClientConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NSMDServer).UpdateClientConnection(ctx, req.(*UpdateConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NSMD_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nsmdapi.NSMD",
 	HandlerType: (*NSMDServer)(nil),
@@ -460,6 +797,10 @@ var _NSMD_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteClientConnection",
 			Handler:    _NSMD_DeleteClientConnection_Handler,
 		},
+		{
+			MethodName: "UpdateClientConnection",
+			Handler:    _NSMD_UpdateClientConnection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nsmd.proto",
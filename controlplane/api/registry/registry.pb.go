@@ -552,6 +552,77 @@ func (m *NetworkServiceEndpointList) GetNetworkServiceEndpoints() []*NetworkServ
 	return nil
 }
 
+type PodEvent struct {
+	PodName              string   `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodUid               string   `protobuf:"bytes,3,opt,name=pod_uid,json=podUid,proto3" json:"pod_uid,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PodEvent) Reset()         { *m = PodEvent{} }
+func (m *PodEvent) String() string { return proto.CompactTextString(m) }
+func (*PodEvent) ProtoMessage()    {}
+func (*PodEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41af05d40a615591, []int{10}
+}
+
+func (m *PodEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PodEvent.Unmarshal(m, b)
+}
+func (m *PodEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PodEvent.Marshal(b, m, deterministic)
+}
+func (m *PodEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodEvent.Merge(m, src)
+}
+func (m *PodEvent) XXX_Size() int {
+	return xxx_messageInfo_PodEvent.Size(m)
+}
+func (m *PodEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodEvent proto.InternalMessageInfo
+
+func (m *PodEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *PodEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PodEvent) GetPodUid() string {
+	if m != nil {
+		return m.PodUid
+	}
+	return ""
+}
+
+func (m *PodEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PodEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NetworkService)(nil), "registry.NetworkService")
 	proto.RegisterType((*Match)(nil), "registry.Match")
@@ -567,63 +638,71 @@ func init() {
 	proto.RegisterType((*NSERegistration)(nil), "registry.NSERegistration")
 	proto.RegisterType((*RemoveNSERequest)(nil), "registry.RemoveNSERequest")
 	proto.RegisterType((*NetworkServiceEndpointList)(nil), "registry.NetworkServiceEndpointList")
+	proto.RegisterType((*PodEvent)(nil), "registry.PodEvent")
 }
 
 func init() { proto.RegisterFile("registry.proto", fileDescriptor_41af05d40a615591) }
 
 var fileDescriptor_41af05d40a615591 = []byte{
-	// 811 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xd6, 0x24, 0x6d, 0x4a, 0x9f, 0x21, 0xa9, 0xa6, 0x6d, 0xea, 0x18, 0x10, 0x51, 0xca, 0x22,
-	0x48, 0x60, 0xaa, 0x20, 0x24, 0x60, 0x53, 0x4a, 0x9b, 0xb2, 0x20, 0x09, 0x92, 0x03, 0x42, 0x42,
-	0x48, 0x91, 0x9b, 0x0c, 0xa9, 0xa9, 0xff, 0xf0, 0x4c, 0x52, 0xdc, 0x1b, 0x70, 0x03, 0x0e, 0xc1,
-	0x1d, 0x58, 0xb2, 0xed, 0x3d, 0xb8, 0x04, 0xf2, 0x8c, 0x13, 0xdb, 0x89, 0xdd, 0x34, 0xea, 0xa6,
-	0x9a, 0x9f, 0xf7, 0xbe, 0xf7, 0xe6, 0xfb, 0x3e, 0xbf, 0x06, 0x8a, 0x1e, 0x19, 0x1a, 0x94, 0x79,
-	0xbe, 0xea, 0x7a, 0x0e, 0x73, 0xf0, 0xad, 0xc9, 0x5e, 0x91, 0x5d, 0xe6, 0xbb, 0x84, 0x3e, 0x25,
-	0x96, 0xcb, 0x7c, 0xf1, 0x57, 0xc4, 0x28, 0xd5, 0xf0, 0x86, 0x19, 0x16, 0xa1, 0x4c, 0xb7, 0xdc,
-	0x68, 0x25, 0x22, 0x6a, 0x06, 0x14, 0x3b, 0x84, 0x9d, 0x3b, 0xde, 0x59, 0x97, 0x78, 0x63, 0xa3,
-	0x4f, 0x30, 0x86, 0x15, 0x5b, 0xb7, 0x88, 0x8c, 0xaa, 0xa8, 0xbe, 0xae, 0xf1, 0x35, 0x96, 0x61,
-	0xcd, 0xd5, 0x7d, 0xd3, 0xd1, 0x07, 0x72, 0x8e, 0x1f, 0x4f, 0xb6, 0xf8, 0x11, 0xac, 0x59, 0x3a,
-	0xeb, 0x9f, 0x12, 0x2a, 0xe7, 0xab, 0xf9, 0xba, 0xd4, 0x28, 0xa9, 0xd3, 0x3e, 0xdb, 0xc1, 0x85,
-	0x36, 0xb9, 0xaf, 0xfd, 0x45, 0xb0, 0xca, 0x8f, 0x70, 0x0b, 0x4a, 0xd4, 0x19, 0x79, 0x7d, 0xd2,
-	0xa3, 0xc4, 0x24, 0x7d, 0xe6, 0x78, 0x32, 0xe2, 0xc9, 0xbb, 0x33, 0xc9, 0x6a, 0x97, 0x87, 0x75,
-	0xc3, 0xa8, 0xa6, 0xcd, 0x3c, 0x5f, 0x2b, 0xd2, 0xc4, 0x21, 0x7e, 0x02, 0x05, 0xcf, 0x19, 0x31,
-	0x42, 0xe5, 0x1c, 0x07, 0xd9, 0x8e, 0x40, 0x8e, 0x08, 0x65, 0x86, 0xad, 0x33, 0xc3, 0xb1, 0xb5,
-	0x30, 0x48, 0x39, 0x80, 0xcd, 0x14, 0x54, 0xbc, 0x01, 0xf9, 0x33, 0xe2, 0x87, 0xaf, 0x0e, 0x96,
-	0x78, 0x0b, 0x56, 0xc7, 0xba, 0x39, 0x22, 0xe1, 0x93, 0xc5, 0xe6, 0x55, 0xee, 0x05, 0xaa, 0x5d,
-	0x22, 0x90, 0x62, 0xd0, 0x58, 0x87, 0xad, 0x41, 0xb4, 0x9d, 0x7d, 0x94, 0x9a, 0xda, 0x4f, 0x7c,
-	0x9d, 0x7c, 0xdf, 0xe6, 0x60, 0xfe, 0x06, 0x97, 0xa1, 0x70, 0x4e, 0x8c, 0xe1, 0x29, 0xe3, 0xdd,
-	0xdc, 0xd1, 0xc2, 0x9d, 0x72, 0x0c, 0x72, 0x16, 0xd0, 0x52, 0x4f, 0xfa, 0x85, 0x60, 0x3b, 0x69,
-	0x84, 0xb6, 0x6e, 0xeb, 0x43, 0xe2, 0xa5, 0xfa, 0x61, 0x03, 0xf2, 0x23, 0xcf, 0x0c, 0x51, 0x82,
-	0x25, 0x3e, 0x84, 0x12, 0xf9, 0xe1, 0x1a, 0x9e, 0x60, 0x20, 0x70, 0x99, 0x9c, 0xaf, 0xa2, 0xba,
-	0xd4, 0x50, 0xd4, 0xa1, 0xe3, 0x0c, 0x4d, 0x22, 0xfc, 0x76, 0x32, 0xfa, 0xaa, 0x7e, 0x98, 0x58,
-	0x50, 0x2b, 0x46, 0x29, 0xc1, 0x61, 0xd0, 0x1e, 0x65, 0x3a, 0x23, 0xf2, 0x8a, 0x68, 0x8f, 0x6f,
-	0x6a, 0x97, 0x39, 0x28, 0x27, 0x5b, 0x6b, 0xda, 0x03, 0xd7, 0x31, 0x6c, 0xb6, 0xa4, 0x57, 0xf7,
-	0x60, 0xcb, 0x16, 0x38, 0x3d, 0x2a, 0x80, 0x7a, 0x3c, 0x3b, 0xcf, 0xc3, 0xb0, 0x9d, 0xa8, 0xd1,
-	0x09, 0xb0, 0xf6, 0xe1, 0xde, 0x6c, 0x86, 0x25, 0x68, 0x11, 0x99, 0xa2, 0xcf, 0x8a, 0x9d, 0x46,
-	0x1c, 0x07, 0x38, 0x82, 0x82, 0xa9, 0x9f, 0x10, 0x93, 0xca, 0xab, 0xdc, 0x0b, 0x8f, 0x23, 0x2f,
-	0xa4, 0x3f, 0x49, 0x6d, 0xf1, 0x70, 0xe1, 0x84, 0x30, 0x37, 0xe2, 0xa5, 0x10, 0xe3, 0x45, 0x79,
-	0x09, 0x52, 0x2c, 0x78, 0x29, 0xb5, 0xdb, 0x50, 0x39, 0x36, 0xec, 0x41, 0xb2, 0x05, 0x8d, 0x7c,
-	0x1f, 0x11, 0xca, 0x32, 0x69, 0x42, 0x59, 0x34, 0xd5, 0xfe, 0xe4, 0x41, 0x49, 0xc3, 0xa3, 0xae,
-	0x63, 0xd3, 0x84, 0x22, 0x28, 0xa9, 0xc8, 0x01, 0x94, 0x66, 0x4a, 0xf1, 0x5e, 0xa5, 0x86, 0x9c,
-	0xc5, 0x93, 0x56, 0x4c, 0xd6, 0xc7, 0x17, 0x20, 0x67, 0x48, 0x34, 0x99, 0x48, 0xaf, 0x23, 0xac,
-	0xec, 0x26, 0xd5, 0x54, 0xf3, 0x87, 0x3a, 0x94, 0x53, 0x05, 0xa6, 0xf8, 0x0b, 0x54, 0x66, 0x6b,
-	0x93, 0x50, 0x47, 0x2a, 0xaf, 0xf0, 0xe2, 0xd5, 0x45, 0x82, 0x6b, 0x3b, 0x76, 0xea, 0x39, 0x55,
-	0xbe, 0xc1, 0xdd, 0x2b, 0x9a, 0x4a, 0xd1, 0xfb, 0x79, 0x5c, 0x6f, 0xa9, 0xf1, 0x20, 0xab, 0x74,
-	0x88, 0x13, 0x37, 0xc4, 0xcf, 0x1c, 0x94, 0x3a, 0xdd, 0xa6, 0x26, 0x12, 0xc4, 0x54, 0x4b, 0x11,
-	0x07, 0x2d, 0x29, 0xce, 0x27, 0xd8, 0xc9, 0x10, 0xe7, 0xba, 0x3d, 0x6e, 0xa7, 0x52, 0x8f, 0x3f,
-	0xcf, 0xab, 0x3e, 0x61, 0x3e, 0x9c, 0x3b, 0x8b, 0x89, 0x2f, 0xa7, 0x13, 0x5f, 0xfb, 0x08, 0x1b,
-	0x1a, 0xb1, 0x9c, 0x31, 0xe1, 0x84, 0x88, 0x6f, 0xe2, 0x00, 0xee, 0x67, 0xd5, 0x8b, 0x7f, 0x1c,
-	0x4a, 0x3a, 0x24, 0xff, 0x48, 0x2e, 0x40, 0x49, 0x6f, 0xa4, 0x65, 0x50, 0x76, 0xb5, 0x95, 0xd0,
-	0x0d, 0xad, 0xd4, 0xf8, 0x87, 0x66, 0x47, 0x68, 0xa8, 0xb4, 0x8f, 0x0f, 0x41, 0x12, 0x6b, 0xe2,
-	0x75, 0xba, 0x4d, 0x5c, 0x89, 0x15, 0x49, 0xfa, 0x41, 0xc9, 0xbe, 0xc2, 0xef, 0xa0, 0xf4, 0x66,
-	0x64, 0x9e, 0xdd, 0x18, 0xa8, 0x8e, 0xf6, 0x10, 0xde, 0x87, 0xf5, 0x29, 0xff, 0x58, 0x89, 0x62,
-	0x67, 0x45, 0x51, 0xca, 0x73, 0xff, 0x5a, 0x9a, 0xc1, 0x6f, 0x9f, 0xc6, 0x05, 0xec, 0x24, 0x1f,
-	0x7b, 0x64, 0xd0, 0xbe, 0x33, 0x26, 0x9e, 0x8f, 0x7b, 0x80, 0xe7, 0x67, 0x00, 0xde, 0xbd, 0x7a,
-	0x42, 0x88, 0x6a, 0x0f, 0xaf, 0x33, 0x46, 0x1a, 0xbf, 0x11, 0x48, 0x1d, 0x6a, 0x4d, 0xe9, 0x7d,
-	0x1f, 0xa7, 0xb7, 0x8d, 0x17, 0xf9, 0x5d, 0x59, 0x14, 0x80, 0x5b, 0x70, 0xfb, 0x2d, 0x61, 0x53,
-	0x69, 0x71, 0x06, 0x09, 0xf1, 0x76, 0xb3, 0x6d, 0x77, 0x52, 0xe0, 0x59, 0xcf, 0xfe, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x10, 0xd8, 0x69, 0xfe, 0x5d, 0x0a, 0x00, 0x00,
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x51, 0x6f, 0xe3, 0x44,
+	0x10, 0xd6, 0x26, 0x6d, 0xda, 0x4e, 0x20, 0xa9, 0xf6, 0xda, 0xd4, 0x31, 0x87, 0x88, 0x72, 0xf7,
+	0x50, 0x24, 0x08, 0xa7, 0x20, 0x24, 0x40, 0x48, 0x47, 0xb9, 0xe6, 0x78, 0xa0, 0x0d, 0x27, 0x87,
+	0x13, 0x12, 0x42, 0x8a, 0xdc, 0x78, 0xc8, 0x99, 0xc6, 0xbb, 0x66, 0x77, 0x93, 0x23, 0xfd, 0x07,
+	0xbc, 0xf0, 0xcc, 0x8f, 0xe0, 0x3f, 0xf0, 0xc8, 0xeb, 0xfd, 0x0f, 0xfe, 0x04, 0xf2, 0xee, 0x26,
+	0xb6, 0x53, 0xbb, 0xbd, 0xaa, 0x2f, 0xd1, 0xce, 0xee, 0xec, 0x37, 0x33, 0xdf, 0x7c, 0x3b, 0x0e,
+	0x34, 0x04, 0x4e, 0x43, 0xa9, 0xc4, 0xb2, 0x17, 0x0b, 0xae, 0x38, 0xdd, 0x5d, 0xd9, 0xae, 0x13,
+	0xab, 0x65, 0x8c, 0xf2, 0x13, 0x8c, 0x62, 0xb5, 0x34, 0xbf, 0xc6, 0xc7, 0xed, 0xd8, 0x13, 0x15,
+	0x46, 0x28, 0x95, 0x1f, 0xc5, 0xe9, 0xca, 0x78, 0x74, 0x43, 0x68, 0x0c, 0x51, 0xbd, 0xe6, 0xe2,
+	0x72, 0x84, 0x62, 0x11, 0x4e, 0x90, 0x52, 0xd8, 0x62, 0x7e, 0x84, 0x0e, 0xe9, 0x90, 0xe3, 0x3d,
+	0x4f, 0xaf, 0xa9, 0x03, 0x3b, 0xb1, 0xbf, 0x9c, 0x71, 0x3f, 0x70, 0x2a, 0x7a, 0x7b, 0x65, 0xd2,
+	0x0f, 0x61, 0x27, 0xf2, 0xd5, 0xe4, 0x15, 0x4a, 0xa7, 0xda, 0xa9, 0x1e, 0xd7, 0xfb, 0xcd, 0xde,
+	0x3a, 0xcf, 0xf3, 0xe4, 0xc0, 0x5b, 0x9d, 0x77, 0xff, 0x25, 0xb0, 0xad, 0xb7, 0xe8, 0x19, 0x34,
+	0x25, 0x9f, 0x8b, 0x09, 0x8e, 0x25, 0xce, 0x70, 0xa2, 0xb8, 0x70, 0x88, 0xbe, 0xfc, 0x68, 0xe3,
+	0x72, 0x6f, 0xa4, 0xdd, 0x46, 0xd6, 0x6b, 0xc0, 0x94, 0x58, 0x7a, 0x0d, 0x99, 0xdb, 0xa4, 0x1f,
+	0x43, 0x4d, 0xf0, 0xb9, 0x42, 0xe9, 0x54, 0x34, 0xc8, 0x61, 0x0a, 0x72, 0x8a, 0x52, 0x85, 0xcc,
+	0x57, 0x21, 0x67, 0x9e, 0x75, 0x72, 0x4f, 0xe0, 0x41, 0x01, 0x2a, 0xdd, 0x87, 0xea, 0x25, 0x2e,
+	0x6d, 0xd5, 0xc9, 0x92, 0x1e, 0xc0, 0xf6, 0xc2, 0x9f, 0xcd, 0xd1, 0x96, 0x6c, 0x8c, 0x2f, 0x2b,
+	0x9f, 0x93, 0xee, 0x1b, 0x02, 0xf5, 0x0c, 0x34, 0xf5, 0xe1, 0x20, 0x48, 0xcd, 0xcd, 0xa2, 0x7a,
+	0x85, 0xf9, 0x64, 0xd7, 0xf9, 0xfa, 0x1e, 0x04, 0xd7, 0x4f, 0x68, 0x0b, 0x6a, 0xaf, 0x31, 0x9c,
+	0xbe, 0x52, 0x3a, 0x9b, 0x77, 0x3d, 0x6b, 0xb9, 0xcf, 0xc1, 0x29, 0x03, 0xba, 0x53, 0x49, 0x7f,
+	0x11, 0x38, 0xcc, 0x0b, 0xe1, 0xdc, 0x67, 0xfe, 0x14, 0x45, 0xa1, 0x1e, 0xf6, 0xa1, 0x3a, 0x17,
+	0x33, 0x8b, 0x92, 0x2c, 0xe9, 0x33, 0x68, 0xe2, 0xef, 0x71, 0x28, 0x0c, 0x03, 0x89, 0xca, 0x9c,
+	0x6a, 0x87, 0x1c, 0xd7, 0xfb, 0x6e, 0x6f, 0xca, 0xf9, 0x74, 0x86, 0x46, 0x6f, 0x17, 0xf3, 0x5f,
+	0x7a, 0x3f, 0xac, 0x24, 0xe8, 0x35, 0xd2, 0x2b, 0xc9, 0x66, 0x92, 0x9e, 0x54, 0xbe, 0x42, 0x67,
+	0xcb, 0xa4, 0xa7, 0x8d, 0xee, 0x9b, 0x0a, 0xb4, 0xf2, 0xa9, 0x0d, 0x58, 0x10, 0xf3, 0x90, 0xa9,
+	0x3b, 0x6a, 0xf5, 0x09, 0x1c, 0x30, 0x83, 0x33, 0x96, 0x06, 0x68, 0xcc, 0x7c, 0x9b, 0xe8, 0x9e,
+	0x47, 0x59, 0x2e, 0xc6, 0x30, 0xc1, 0x7a, 0x0a, 0x0f, 0x37, 0x6f, 0x44, 0x86, 0x16, 0x73, 0xd3,
+	0xe4, 0xd9, 0x66, 0x45, 0xc4, 0x69, 0x80, 0x53, 0xa8, 0xcd, 0xfc, 0x0b, 0x9c, 0x49, 0x67, 0x5b,
+	0x6b, 0xe1, 0xa3, 0x54, 0x0b, 0xc5, 0x25, 0xf5, 0xce, 0xb4, 0xbb, 0x51, 0x82, 0xbd, 0x9b, 0xf2,
+	0x52, 0xcb, 0xf0, 0xe2, 0x7e, 0x01, 0xf5, 0x8c, 0xf3, 0x9d, 0xba, 0x7d, 0x0e, 0xed, 0xe7, 0x21,
+	0x0b, 0xf2, 0x29, 0x78, 0xf8, 0xdb, 0x1c, 0xa5, 0x2a, 0xa5, 0x89, 0x94, 0xd1, 0xd4, 0xfd, 0xa7,
+	0x0a, 0x6e, 0x11, 0x9e, 0x8c, 0x39, 0x93, 0xb9, 0x8e, 0x90, 0x7c, 0x47, 0x4e, 0xa0, 0xb9, 0x11,
+	0x4a, 0xe7, 0x5a, 0xef, 0x3b, 0x65, 0x3c, 0x79, 0x8d, 0x7c, 0x7c, 0x7a, 0x05, 0x4e, 0x49, 0x8b,
+	0x56, 0x13, 0xe9, 0xeb, 0x14, 0xab, 0x3c, 0xc9, 0x5e, 0xa1, 0xf8, 0x6d, 0x1f, 0x5a, 0x85, 0x0d,
+	0x96, 0xf4, 0x67, 0x68, 0x6f, 0xc6, 0x46, 0xdb, 0x47, 0xe9, 0x6c, 0xe9, 0xe0, 0x9d, 0xdb, 0x1a,
+	0xee, 0x1d, 0xb1, 0xc2, 0x7d, 0xe9, 0xfe, 0x0a, 0xef, 0xdd, 0x90, 0x54, 0x41, 0xbf, 0x3f, 0xcb,
+	0xf6, 0xbb, 0xde, 0xff, 0xa0, 0x2c, 0xb4, 0xc5, 0xc9, 0x0a, 0xe2, 0x8f, 0x0a, 0x34, 0x87, 0xa3,
+	0x81, 0x67, 0x2e, 0x98, 0xa9, 0x56, 0xd0, 0x1c, 0x72, 0xc7, 0xe6, 0xfc, 0x08, 0x47, 0x25, 0xcd,
+	0x79, 0xdb, 0x1c, 0x0f, 0x0b, 0xa9, 0xa7, 0x3f, 0x81, 0x53, 0xc6, 0xbc, 0x9d, 0x3b, 0xb7, 0x13,
+	0xdf, 0x2a, 0x26, 0xbe, 0xfb, 0x12, 0xf6, 0x3d, 0x8c, 0xf8, 0x02, 0x35, 0x21, 0xe6, 0x4d, 0x9c,
+	0xc0, 0xfb, 0x65, 0xf1, 0xb2, 0x8f, 0xc3, 0x2d, 0x86, 0xd4, 0x8f, 0xe4, 0x0a, 0xdc, 0xe2, 0x44,
+	0xce, 0x42, 0xa9, 0x6e, 0x96, 0x12, 0xb9, 0xa7, 0x94, 0xba, 0x7f, 0x12, 0xd8, 0x7d, 0xc1, 0x83,
+	0xc1, 0x02, 0x99, 0xa2, 0x6d, 0xd8, 0x8d, 0x79, 0x90, 0x4d, 0x7b, 0x27, 0xe6, 0x81, 0x1e, 0x57,
+	0x0f, 0x61, 0x2f, 0xd9, 0x96, 0xb1, 0x3f, 0x59, 0x4d, 0x8d, 0x74, 0x83, 0x1e, 0x41, 0xe2, 0x38,
+	0x9e, 0x87, 0x81, 0x1d, 0x99, 0xb5, 0x98, 0x07, 0x2f, 0xc3, 0x20, 0xf9, 0x38, 0x09, 0xf4, 0x25,
+	0x67, 0x76, 0x20, 0x5a, 0x2b, 0x79, 0xf8, 0x11, 0x4a, 0xe9, 0x4f, 0xd1, 0xd9, 0x36, 0x81, 0xac,
+	0xd9, 0xff, 0x8f, 0x6c, 0xce, 0x74, 0x2b, 0xbd, 0x25, 0x7d, 0x06, 0x75, 0xb3, 0x46, 0x31, 0x1c,
+	0x0d, 0x68, 0x3b, 0x53, 0x75, 0x5e, 0xa0, 0x6e, 0xf9, 0x11, 0xfd, 0x0e, 0x9a, 0xdf, 0xcc, 0x67,
+	0x97, 0xf7, 0x06, 0x3a, 0x26, 0x4f, 0x08, 0x7d, 0x0a, 0x7b, 0x6b, 0x41, 0x50, 0x37, 0xf5, 0xdd,
+	0x54, 0x89, 0xdb, 0xba, 0xf6, 0xad, 0x1b, 0x24, 0x7f, 0xc6, 0xfa, 0x57, 0x70, 0x94, 0x2f, 0xf6,
+	0x34, 0x94, 0x13, 0xbe, 0x40, 0xb1, 0xa4, 0x63, 0xa0, 0xd7, 0x87, 0x12, 0x7d, 0x74, 0xf3, 0xc8,
+	0x32, 0xd1, 0x1e, 0xbf, 0xcd, 0x5c, 0xeb, 0xff, 0x4d, 0xa0, 0x3e, 0x94, 0xd1, 0x9a, 0xde, 0xef,
+	0xb3, 0xf4, 0x9e, 0xd3, 0xdb, 0x1e, 0xa0, 0x7b, 0x9b, 0x03, 0x3d, 0x83, 0x77, 0xbe, 0x45, 0xb5,
+	0xd6, 0x1a, 0x2d, 0x21, 0xc1, 0x7d, 0x5c, 0x06, 0x94, 0x7d, 0x07, 0xfd, 0x17, 0xb0, 0xbf, 0x12,
+	0xaa, 0x87, 0x13, 0x2e, 0x02, 0x14, 0xf4, 0x2b, 0x68, 0x98, 0xf5, 0x5a, 0xc2, 0x34, 0xc5, 0x5a,
+	0xed, 0x95, 0x91, 0x7f, 0x51, 0xd3, 0xf6, 0xa7, 0xff, 0x0f, 0x00, 0x85, 0x36, 0x27, 0x91, 0x40,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -990,3 +1069,75 @@ var _NsmRegistry_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "registry.proto",
 }
+
+// PodEventRecorderClient is the client API for PodEventRecorder service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PodEventRecorderClient interface {
+	RecordPodEvent(ctx context.Context, in *PodEvent, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type podEventRecorderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPodEventRecorderClient(cc grpc.ClientConnInterface) PodEventRecorderClient {
+	return &podEventRecorderClient{cc}
+}
+
+func (c *podEventRecorderClient) RecordPodEvent(ctx context.Context, in *PodEvent, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/registry.PodEventRecorder/RecordPodEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PodEventRecorderServer is the server API for PodEventRecorder service.
+type PodEventRecorderServer interface {
+	RecordPodEvent(context.Context, *PodEvent) (*empty.Empty, error)
+}
+
+// UnimplementedPodEventRecorderServer can be embedded to have forward compatible implementations.
+type UnimplementedPodEventRecorderServer struct {
+}
+
+func (*UnimplementedPodEventRecorderServer) RecordPodEvent(ctx context.Context, req *PodEvent) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPodEvent not implemented")
+}
+
+func RegisterPodEventRecorderServer(s *grpc.Server, srv PodEventRecorderServer) {
+	s.RegisterService(&_PodEventRecorder_serviceDesc, srv)
+}
+
+func _PodEventRecorder_RecordPodEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodEventRecorderServer).RecordPodEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/registry.PodEventRecorder/RecordPodEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodEventRecorderServer).RecordPodEvent(ctx, req.(*PodEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PodEventRecorder_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "registry.PodEventRecorder",
+	HandlerType: (*PodEventRecorderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordPodEvent",
+			Handler:    _PodEventRecorder_RecordPodEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "registry.proto",
+}
@@ -15,3 +15,11 @@ func (nse *NSERegistration) GetEndpointNSMName() EndpointNSMName {
 func NewEndpointNSMName(endpoint *NetworkServiceEndpoint, manager *NetworkServiceManager) EndpointNSMName {
 	return EndpointNSMName(endpoint.Name + ":" + manager.Url)
 }
+
+// NSMStateDraining - state of NetworkServiceManager which is going to shutdown and does not accept new connections
+const NSMStateDraining = "DRAINING"
+
+// IsDraining - return true if NetworkServiceManager is going to shutdown and its endpoints should not be selected
+func (nsm *NetworkServiceManager) IsDraining() bool {
+	return nsm.GetState() == NSMStateDraining
+}
@@ -3,11 +3,13 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools/jaeger"
 	"github.com/networkservicemesh/networkservicemesh/utils"
@@ -18,10 +20,13 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/metrics"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nsm"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nsmd"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/webhook"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+	"github.com/networkservicemesh/networkservicemesh/sdk/monitor"
 )
 
 var version string
@@ -49,10 +54,20 @@ func main() {
 	span.LogValue("tracing.init-complete", fmt.Sprintf("%v", time.Since(start)))
 	defer span.Finish() // Mark it as finished, since it will be used as root.
 
+	if prom, err := tools.ReadEnvBool(metrics.PrometheusEnv, metrics.PrometheusDefault); err == nil && prom {
+		promServer := startPrometheusServer()
+		defer func() { _ = promServer.Close() }()
+	}
+
 	apiRegistry := nsmd.NewApiRegistry()
 	serviceRegistry := nsmd.NewServiceRegistry()
 
 	model := model.NewModel() // This is TCP gRPC server uri to access this NSMD via network.
+	model.AddListener(metrics.NewModelListener())
+	if notifier := webhook.NewNotifier(webhook.NewConfigFromEnv()); notifier != nil {
+		model.AddListener(notifier)
+		defer notifier.Stop()
+	}
 	defer serviceRegistry.Stop()
 	manager := nsm.NewNetworkServiceManager(span.Context(), model, serviceRegistry)
 
@@ -66,6 +81,16 @@ func main() {
 	}
 	defer server.Stop()
 
+	adminSock, err := apiRegistry.NewAdminListener()
+	if err != nil {
+		span.LogError(errors.Wrap(err, "failed to start admin API server"))
+		return
+	}
+	if err := server.StartAdminServerAt(span.Context(), adminSock); err != nil {
+		span.LogError(errors.Wrap(err, "failed to start admin API server"))
+		return
+	}
+
 	nsmdGoals := &nsmdProbeGoals{}
 	nsmdProbes := probes.New("NSMD liveness/readiness healthcheck", nsmdGoals)
 	nsmdProbes.BeginHealthCheck()
@@ -111,6 +136,27 @@ func main() {
 	span.LogValue("start-time", fmt.Sprintf("%v", time.Since(start)))
 	span.Finish()
 	<-c
+
+	// Refuse new requests and let remote peers heal their connections before we stop.
+	logrus.Info("Draining NSM server...")
+	server.Drain(context.Background(), nsmd.DrainTimeoutEnv.GetOrDefaultDuration(nsmd.DrainTimeoutDefault))
+}
+
+func startPrometheusServer() *http.Server {
+	logrus.Info("Starting Prometheus server")
+	if err := metrics.RegisterControlPlaneMetrics(prometheus.DefaultRegisterer); err != nil {
+		logrus.Errorf("failed to register control plane metrics: %v", err)
+	}
+	if err := monitor.RegisterMetrics(prometheus.DefaultRegisterer); err != nil {
+		logrus.Errorf("failed to register monitor metrics: %v", err)
+	}
+	promServer := metrics.GetPrometheusMetricsServer()
+	go func() {
+		if err := promServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logrus.Errorf("failed to listen and serve prometheus server: %v", err)
+		}
+	}()
+	return promServer
 }
 
 func getNsmdAPIAddress() string {
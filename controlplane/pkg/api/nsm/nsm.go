@@ -15,6 +15,7 @@
 package nsm
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/properties"
@@ -65,10 +66,41 @@ const (
 	HealStateDstNmgrDown HealState = 5
 )
 
+func (s HealState) String() string {
+	switch s {
+	case HealStateDstDown:
+		return "DstDown"
+	case HealStateSrcDown:
+		return "SrcDown"
+	case HealStateForwarderDown:
+		return "ForwarderDown"
+	case HealStateDstUpdate:
+		return "DstUpdate"
+	case HealStateDstNmgrDown:
+		return "DstNmgrDown"
+	}
+	return fmt.Sprintf("HealState(%d)", int32(s))
+}
+
 // NetworkServiceRequestManager - allow to provide local and remote service interfaces.
 type NetworkServiceRequestManager interface {
 	LocalManager(clientConnection ClientConnection) networkservice.NetworkServiceServer
 	RemoteManager() networkservice.NetworkServiceServer
+	PodEventRecorderProvider
+}
+
+// PodEventRecorderProvider - provides a recorder of pod events
+type PodEventRecorderProvider interface {
+	PodEventRecorder() PodEventRecorder
+}
+
+// PodEventRecorder - records warning events against pods of clients and endpoints, so connection failures are visible
+// from Kubernetes
+type PodEventRecorder interface {
+	// RecordWorkspaceEvent - records an event against a pod using a local workspace
+	RecordWorkspaceEvent(workspace, reason, message string)
+	// RecordEndpointEvent - records an event against a pod of an endpoint
+	RecordEndpointEvent(endpoint *registry.NSERegistration, reason, message string)
 }
 
 // NetworkServiceHealProcessor - perform Healing operations
@@ -83,6 +115,12 @@ type MonitorManager interface {
 	LocalConnectionMonitor(workspace string) connectionmonitor.MonitorServer
 }
 
+// DrainManager - tracks if NSM is draining before shutdown, draining NSM does not accept new connections and does not heal
+type DrainManager interface {
+	SetDraining(draining bool)
+	IsDraining() bool
+}
+
 //NetworkServiceManager - hold useful nsm structures
 type NetworkServiceManager interface {
 	GetHealProperties() *properties.Properties
@@ -92,10 +130,13 @@ type NetworkServiceManager interface {
 	// Getters
 	NseManager() NetworkServiceEndpointManager
 	SetRemoteServer(server networkservice.NetworkServiceServer)
+	SetPodEventRecorder(recorder PodEventRecorder)
+	PodEventRecorderProvider
 
 	Model() model.Model
 
 	NetworkServiceHealProcessor
+	DrainManager
 	ServiceRegistry() serviceregistry.ServiceRegistry
 	RestoreConnections(xcons []*crossconnect.CrossConnect, forwarder string, manager MonitorManager)
 }
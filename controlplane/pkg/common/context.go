@@ -142,28 +142,31 @@ func ModelConnection(ctx context.Context) *model.ClientConnection {
 	return conn.(*model.ClientConnection)
 }
 
+// RemoteMechanismsFunc - prepares remote mechanisms of a connection, called only if an endpoint is remote
+type RemoteMechanismsFunc func() ([]*connection.Mechanism, error)
+
 // WithRemoteMechanisms -
-//   Wraps 'parent' in a new Context that has the remote mechanisms
+//   Wraps 'parent' in a new Context that has the function preparing remote mechanisms
 //   using Context.Value(...) and returns the result.
 //   Note: any previously existing value will be overwritten.
 //
-func WithRemoteMechanisms(parent context.Context, mechanisms []*connection.Mechanism) context.Context {
+func WithRemoteMechanisms(parent context.Context, prepare RemoteMechanismsFunc) context.Context {
 	if parent == nil {
 		parent = context.Background()
 	}
-	return context.WithValue(parent, remoteMechanisms, mechanisms)
+	return context.WithValue(parent, remoteMechanisms, prepare)
 }
 
 // RemoteMechanisms -
-//    Returns a Mechanism from:
+//    Returns a Mechanisms prepared by a function from:
 //      ctx context.Context
 //    If any is present, otherwise nil
-func RemoteMechanisms(ctx context.Context) []*connection.Mechanism {
+func RemoteMechanisms(ctx context.Context) ([]*connection.Mechanism, error) {
 	value := ctx.Value(remoteMechanisms)
 	if value == nil {
-		return nil
+		return nil, nil
 	}
-	return value.([]*connection.Mechanism)
+	return value.(RemoteMechanismsFunc)()
 }
 
 // WithForwarder -
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/api/nsm"
+)
+
+// drainService - refuses new requests while NSM is draining, closes are passed as is
+type drainService struct {
+	drainManager nsm.DrainManager
+}
+
+func (srv *drainService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	if srv.drainManager.IsDraining() {
+		err := errors.Errorf("NSM is draining, request for %s is refused", request.GetConnection().GetNetworkService())
+		Log(ctx).Error(err)
+		return nil, err
+	}
+	return ProcessNext(ctx, request)
+}
+
+func (srv *drainService) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return ProcessClose(ctx, connection)
+}
+
+// NewDrainService -  creates a service to refuse requests while NSM is draining
+func NewDrainService(drainManager nsm.DrainManager) networkservice.NetworkServiceServer {
+	return &drainService{
+		drainManager: drainManager,
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const defaultMaxLease = time.Hour
+
+var (
+	// MaxLeaseEnv - environment variable name - the longest lease NSMD grants to a connection
+	MaxLeaseEnv = utils.EnvVar("NSMD_MAX_LEASE")
+	// DefaultLeaseEnv - environment variable name - whether connections requested with no lease are granted a default
+	// one, clients not aware of leases never refresh them, so it is off until such clients are gone
+	DefaultLeaseEnv = utils.EnvVar("NSMD_DEFAULT_LEASE")
+)
+
+// DefaultLease - returns true if connections requested with no lease are granted a default one
+func DefaultLease() bool {
+	return DefaultLeaseEnv.GetBooleanOrDefault(false)
+}
+
+// leaseService - grants a lease to a requested connection and refreshes a lease of established connection requested
+// again with nothing changed, forwarder and endpoint are not requested for such a refresh.
+type leaseService struct {
+	model model.Model
+}
+
+// NewLeaseService - creates a service to refresh leases of established connections
+func NewLeaseService(model model.Model) networkservice.NetworkServiceServer {
+	return &leaseService{
+		model: model,
+	}
+}
+
+func (srv *leaseService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	if ModelConnection(ctx) != nil {
+		// Heal is always a full request
+		return ProcessNext(ctx, request)
+	}
+	if err := grantLease(ctx, request.GetConnection()); err != nil {
+		return nil, err
+	}
+	requested := request.GetConnection()
+	cc := srv.model.GetClientConnection(requested.GetId())
+	if !srv.isRefresh(ctx, cc, requested) {
+		return ProcessNext(ctx, request)
+	}
+
+	refreshed := false
+	cc = srv.model.ApplyClientConnectionChanges(ctx, cc.GetID(), func(modelCC *model.ClientConnection) {
+		if refreshed = srv.isRefresh(ctx, modelCC, requested); refreshed {
+			modelCC.Request.Connection.Path = requested.GetPath().Clone()
+			modelCC.Xcon.GetSource().Path = requested.GetPath().Clone()
+		}
+	})
+	if cc == nil || !refreshed {
+		return ProcessNext(ctx, request)
+	}
+	Log(ctx).Infof("Lease of connection %s is refreshed", cc.GetID())
+	return cc.Xcon.GetSource().Clone(), nil
+}
+
+func (srv *leaseService) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return ProcessClose(ctx, connection)
+}
+
+func (srv *leaseService) isRefresh(ctx context.Context, cc *model.ClientConnection, requested *connection.Connection) bool {
+	if cc == nil || cc.ConnectionState != model.ClientConnectionReady ||
+		cc.Request.GetConnection() == nil || cc.Xcon.GetSource() == nil {
+		return false
+	}
+	source := cc.Xcon.GetSource()
+	if requested.IsRemote() != source.IsRemote() {
+		return false
+	}
+	if requested.IsRemote() {
+		// Only source NSM refreshes a remote connection
+		if requested.GetSourceNetworkServiceManagerName() != source.GetSourceNetworkServiceManagerName() {
+			return false
+		}
+	} else if cc.GetWorkspace() != WorkspaceName(ctx) {
+		return false
+	}
+	return requested.IsRefreshOf(source)
+}
+
+// grantLease - limits a lease requested for a connection to the maximum one, connections requested with no lease are
+// granted a default one if DefaultLease is on
+func grantLease(ctx context.Context, requested *connection.Connection) error {
+	if requested == nil {
+		return nil
+	}
+	if requested.GetPath().GetCurrentPathSegment() == nil {
+		name := WorkspaceName(ctx)
+		if requested.IsRemote() {
+			name = requested.GetSourceNetworkServiceManagerName()
+		}
+		requested.Path = Strings2Path(name)
+	}
+	now := time.Now()
+	expires, ok := requested.GetPath().GetExpireTime()
+	if !ok {
+		if !DefaultLease() {
+			Log(ctx).Warnf("Connection %s is requested with no lease, clients not refreshing leases are deprecated", requested.GetId())
+			return nil
+		}
+		expires = now.Add(pathtoken.Expire())
+	}
+	if maxExpires := now.Add(MaxLeaseEnv.GetOrDefaultDuration(defaultMaxLease)); expires.After(maxExpires) {
+		expires = maxExpires
+	}
+	expiresProto, err := ptypes.TimestampProto(expires)
+	if err != nil {
+		return err
+	}
+	requested.GetPath().GetCurrentPathSegment().Expires = expiresProto
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/metrics"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+// unknownNetworkService - label used for network services no endpoint is known for, so clients can't grow label cardinality
+const unknownNetworkService = "unknown"
+
+// metricsService - records latencies of requests and closes processed by the rest of the chain
+type metricsService struct {
+	model model.Model
+	scope string
+}
+
+func (srv *metricsService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	start := time.Now()
+	conn, err := ProcessNext(ctx, request)
+	metrics.ObserveRequest(srv.networkService(request.GetConnection().GetNetworkService(), err), srv.scope, start, err)
+	return conn, err
+}
+
+func (srv *metricsService) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	start := time.Now()
+	rv, err := ProcessClose(ctx, connection)
+	metrics.ObserveClose(srv.networkService(connection.GetNetworkService(), err), srv.scope, start, err)
+	return rv, err
+}
+
+// networkService - returns the label for nsName, known only if the chain succeeded with it or it has local endpoints
+func (srv *metricsService) networkService(nsName string, err error) string {
+	if err == nil || len(srv.model.GetEndpointsByNetworkService(nsName)) > 0 {
+		return nsName
+	}
+	return unknownNetworkService
+}
+
+// NewMetricsService -  creates a service recording Prometheus metrics of requests and closes in scope
+func NewMetricsService(model model.Model, scope string) networkservice.NetworkServiceServer {
+	return &metricsService{
+		model: model,
+		scope: scope,
+	}
+}
@@ -16,7 +16,14 @@
 
 package common
 
-import "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+import (
+	"context"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
+)
 
 // These functions are intentionally not put in api path_helper because they are particular to adapting the existing
 // code to using Path and so intentionally overly simplified.
@@ -33,3 +40,15 @@ func AppendStrings2Path(path *connection.Path, strs ...string) *connection.Path
 	}
 	return path
 }
+
+// SignOutgoingRequest sets a lease of path segment of NSM in a request to a next hop and signs it on behalf of
+// a previous hop, lease is set but nothing is signed if NSM is running in insecure mode
+func SignOutgoingRequest(ctx context.Context, incoming, outgoing *networkservice.NetworkServiceRequest) error {
+	provider := tools.GetConfig().SecurityProvider
+	obo := pathtoken.ChainToken(ctx)
+	if obo == "" {
+		// Heal and restore requests are not verified, so sign on behalf of a stored request
+		obo = pathtoken.Token(incoming.GetConnection().GetPath())
+	}
+	return pathtoken.Sign(ctx, provider, outgoing.GetConnection().GetPath(), obo)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/pkg/security"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
+)
+
+// pathVerifierService - verifies tokens of a path a request is received with.
+// Requests from remote NSMs must be signed, local clients not signing their requests are passed as is.
+type pathVerifierService struct {
+	provider security.Provider
+}
+
+func (srv *pathVerifierService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	if srv.provider == nil {
+		return ProcessNext(ctx, request)
+	}
+	if ModelConnection(ctx) != nil {
+		// Heal requests a stored connection which was verified when requested, its lease may have lapsed since
+		return ProcessNext(ctx, request)
+	}
+	path := request.GetConnection().GetPath()
+	if !request.GetConnection().IsRemote() && pathtoken.Token(path) == "" {
+		Log(ctx).Infof("Request from local client is not signed")
+		return ProcessNext(ctx, request)
+	}
+
+	chain, err := pathtoken.Verify(ctx, srv.provider, path)
+	if err != nil {
+		err = status.Errorf(codes.PermissionDenied, "request path is not verified: %v", err)
+		Log(ctx).Error(err)
+		return nil, err
+	}
+	Log(ctx).Infof("Request is verified for client %s", chain[0].Subject)
+	return ProcessNext(pathtoken.WithChain(ctx, pathtoken.Token(path), chain), request)
+}
+
+func (srv *pathVerifierService) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return ProcessClose(ctx, connection)
+}
+
+// NewPathVerifierService - creates a service to verify path tokens of requests with trust bundle of provider,
+// nothing is verified if provider is nil
+func NewPathVerifierService(provider security.Provider) networkservice.NetworkServiceServer {
+	return &pathVerifierService{
+		provider: provider,
+	}
+}
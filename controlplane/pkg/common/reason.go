@@ -0,0 +1,82 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+)
+
+// Reasons of connection failures reported as pod events
+const (
+	// ReasonNoMatchingEndpoint - there is no endpoint able to serve a request
+	ReasonNoMatchingEndpoint = "NoMatchingEndpoint"
+	// ReasonEndpointRequestFailed - endpoints failed to serve a request
+	ReasonEndpointRequestFailed = "EndpointRequestFailed"
+	// ReasonForwarderTimeout - no forwarder became available in time
+	ReasonForwarderTimeout = "ForwarderTimeout"
+	// ReasonNoMatchingForwarder - there is no forwarder supporting requested mechanisms
+	ReasonNoMatchingForwarder = "NoMatchingForwarder"
+	// ReasonRequestFailed - request failed for any other reason
+	ReasonRequestFailed = "RequestFailed"
+	// ReasonHealFailed - connection is closed since healing gave up
+	ReasonHealFailed = "HealFailed"
+)
+
+// reasonError - error with a reason of failure and an endpoint caused it if any
+type reasonError struct {
+	error
+	reason   string
+	endpoint *registry.NSERegistration
+}
+
+// Cause returns wrapped error
+func (e *reasonError) Cause() error {
+	return e.error
+}
+
+// WithReason - returns err annotated with a reason of failure
+func WithReason(err error, reason string) error {
+	return WithEndpointReason(err, reason, nil)
+}
+
+// WithEndpointReason - returns err annotated with a reason of failure caused by endpoint
+func WithEndpointReason(err error, reason string, endpoint *registry.NSERegistration) error {
+	if err == nil {
+		return nil
+	}
+	return &reasonError{
+		error:    err,
+		reason:   reason,
+		endpoint: endpoint,
+	}
+}
+
+// Reason - returns a reason of failure err is annotated with and an endpoint caused it, ReasonRequestFailed if err
+// is not annotated
+func Reason(err error) (string, *registry.NSERegistration) {
+	for err != nil {
+		if r, ok := err.(*reasonError); ok {
+			return r.reason, r.endpoint
+		}
+		cause, ok := err.(interface{ Cause() error })
+		if !ok {
+			break
+		}
+		err = cause.Cause()
+	}
+	return ReasonRequestFailed, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limits
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limiter - checks a workspace does not exceed its limits
+type Limiter struct {
+	limits   Limits
+	requests *rate.Limiter
+
+	lock sync.Mutex
+	// reserved - amount of new connections admitted, but not established yet
+	reserved int
+}
+
+// NewLimiter - creates a limiter for a workspace with limits
+func NewLimiter(limits Limits) *Limiter {
+	l := &Limiter{
+		limits: limits,
+	}
+	if limits.RequestRate > 0 {
+		burst := limits.RequestBurst
+		if burst <= 0 {
+			burst = 1
+		}
+		l.requests = rate.NewLimiter(rate.Limit(limits.RequestRate), burst)
+	}
+	return l
+}
+
+// Limits - returns limits of a workspace
+func (l *Limiter) Limits() Limits {
+	if l == nil {
+		return Limits{}
+	}
+	return l.limits
+}
+
+// AllowRequest - returns ResourceExhausted error if workspace exceeds Request rate
+func (l *Limiter) AllowRequest(workspace string) error {
+	if l == nil || l.requests == nil || l.requests.Allow() {
+		return nil
+	}
+	return status.Errorf(codes.ResourceExhausted, "workspace %s exceeds request rate %v/s", workspace, l.limits.RequestRate)
+}
+
+// ReserveConnection - reserves a slot for one more connection of workspace with established connections, returns
+// ResourceExhausted error if there is no slot left. Slot has to be released once the connection is established or
+// failed, an established connection holds its slot until it is closed.
+func (l *Limiter) ReserveConnection(workspace string, established int) (release func(), err error) {
+	if l == nil || l.limits.MaxConnections <= 0 {
+		return func() {}, nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if established+l.reserved >= l.limits.MaxConnections {
+		return nil, status.Errorf(codes.ResourceExhausted, "workspace %s exceeds max connections %d", workspace, l.limits.MaxConnections)
+	}
+	l.reserved++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.lock.Lock()
+			defer l.lock.Unlock()
+			l.reserved--
+		})
+	}, nil
+}
+
+// AllowEndpoint - returns ResourceExhausted error if workspace with registered endpoints could not register one more
+func (l *Limiter) AllowEndpoint(workspace string, registered int) error {
+	if l == nil || l.limits.MaxEndpoints <= 0 || registered < l.limits.MaxEndpoints {
+		return nil
+	}
+	return status.Errorf(codes.ResourceExhausted, "workspace %s exceeds max registered endpoints %d", workspace, l.limits.MaxEndpoints)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package limits - define per workspace admission limits of NSMD
+package limits
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const (
+	// MaxConnectionsEnv - environment variable name - max amount of concurrent connections of a workspace
+	MaxConnectionsEnv = utils.EnvVar("NSMD_WORKSPACE_MAX_CONNECTIONS")
+	// RequestRateEnv - environment variable name - rate of Requests per second allowed for a workspace
+	RequestRateEnv = utils.EnvVar("NSMD_WORKSPACE_REQUEST_RATE")
+	// RequestBurstEnv - environment variable name - amount of Requests allowed for a workspace at once
+	RequestBurstEnv = utils.EnvVar("NSMD_WORKSPACE_REQUEST_BURST")
+	// MaxEndpointsEnv - environment variable name - max amount of NSEs registered from a workspace
+	MaxEndpointsEnv = utils.EnvVar("NSMD_WORKSPACE_MAX_ENDPOINTS")
+	// ConfigFileEnv - environment variable name - path to JSON file with global and per namespace limits
+	ConfigFileEnv = utils.EnvVar("NSMD_WORKSPACE_LIMITS_CONFIG")
+)
+
+// Limits - admission limits of a workspace, zero value means no limit
+type Limits struct {
+	MaxConnections int     `json:"maxConnections"`
+	RequestRate    float64 `json:"requestRate"`
+	RequestBurst   int     `json:"requestBurst"`
+	MaxEndpoints   int     `json:"maxEndpoints"`
+}
+
+// Config - global limits and limits for workspaces of particular namespaces, fields not set for a namespace in
+// a config file are taken from global limits
+type Config struct {
+	Global     Limits            `json:"global"`
+	Namespaces map[string]Limits `json:"namespaces"`
+}
+
+// UnmarshalJSON - decodes global limits over the current ones and namespace limits over the global ones
+func (c *Config) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Global     json.RawMessage            `json:"global"`
+		Namespaces map[string]json.RawMessage `json:"namespaces"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw.Global) > 0 {
+		if err := json.Unmarshal(raw.Global, &c.Global); err != nil {
+			return err
+		}
+	}
+	for namespace, value := range raw.Namespaces {
+		limits := c.Global
+		if err := json.Unmarshal(value, &limits); err != nil {
+			return errors.Wrapf(err, "invalid limits of namespace %s", namespace)
+		}
+		if c.Namespaces == nil {
+			c.Namespaces = map[string]Limits{}
+		}
+		c.Namespaces[namespace] = limits
+	}
+	return nil
+}
+
+// ForNamespace - returns limits for workspaces of namespace
+func (c *Config) ForNamespace(namespace string) Limits {
+	if c == nil {
+		return Limits{}
+	}
+	if l, ok := c.Namespaces[namespace]; ok {
+		return l
+	}
+	return c.Global
+}
+
+// NewConfigFromEnv - reads global limits from environment variables, global limits could be overridden and
+// namespace limits are defined by config file
+func NewConfigFromEnv() (*Config, error) {
+	requestRate := 0.0
+	if value := RequestRateEnv.StringValue(); value != "" {
+		var err error
+		if requestRate, err = strconv.ParseFloat(value, 64); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s value", RequestRateEnv.Name())
+		}
+	}
+	config := &Config{
+		Global: Limits{
+			MaxConnections: MaxConnectionsEnv.GetIntOrDefault(0),
+			RequestRate:    requestRate,
+			RequestBurst:   RequestBurstEnv.GetIntOrDefault(0),
+			MaxEndpoints:   MaxEndpointsEnv.GetIntOrDefault(0),
+		},
+	}
+
+	if file := ConfigFileEnv.StringValue(); file != "" {
+		bytes, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read workspace limits config %s", file)
+		}
+		if err := json.Unmarshal(bytes, config); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse workspace limits config %s", file)
+		}
+	}
+	logrus.Infof("Workspace limits: %+v", config)
+	return config, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limits
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	g := NewWithT(t)
+
+	file, err := ioutil.TempFile("", "limits")
+	g.Expect(err).To(BeNil())
+	defer func() { _ = os.Remove(file.Name()) }()
+	_, err = file.WriteString(`{"global": {"requestBurst": 3}, "namespaces": {"restricted": {"maxConnections": 1, "maxEndpoints": 2}, "unlimited": {"maxConnections": 0}}}`)
+	g.Expect(err).To(BeNil())
+	g.Expect(file.Close()).To(BeNil())
+
+	_ = os.Setenv(MaxConnectionsEnv.Name(), "10")
+	_ = os.Setenv(RequestRateEnv.Name(), "0.5")
+	_ = os.Setenv(ConfigFileEnv.Name(), file.Name())
+	defer func() {
+		_ = os.Unsetenv(MaxConnectionsEnv.Name())
+		_ = os.Unsetenv(RequestRateEnv.Name())
+		_ = os.Unsetenv(ConfigFileEnv.Name())
+	}()
+
+	config, err := NewConfigFromEnv()
+	g.Expect(err).To(BeNil())
+	g.Expect(config.ForNamespace("default")).To(Equal(Limits{MaxConnections: 10, RequestRate: 0.5, RequestBurst: 3}))
+	g.Expect(config.ForNamespace("restricted")).To(Equal(Limits{MaxConnections: 1, RequestRate: 0.5, RequestBurst: 3, MaxEndpoints: 2}))
+	g.Expect(config.ForNamespace("unlimited")).To(Equal(Limits{RequestRate: 0.5, RequestBurst: 3}))
+
+	_ = os.Setenv(RequestRateEnv.Name(), "fast")
+	_, err = NewConfigFromEnv()
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestLimiter(t *testing.T) {
+	g := NewWithT(t)
+
+	limiter := NewLimiter(Limits{MaxConnections: 2, MaxEndpoints: 1, RequestRate: 0.001, RequestBurst: 2})
+	g.Expect(limiter.AllowRequest("ws")).To(BeNil())
+	g.Expect(limiter.AllowRequest("ws")).To(BeNil())
+	g.Expect(status.Code(limiter.AllowRequest("ws"))).To(Equal(codes.ResourceExhausted))
+
+	release, err := limiter.ReserveConnection("ws", 1)
+	g.Expect(err).To(BeNil())
+	_, err = limiter.ReserveConnection("ws", 1)
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+	release()
+	release()
+	release, err = limiter.ReserveConnection("ws", 1)
+	g.Expect(err).To(BeNil())
+	release()
+	_, err = limiter.ReserveConnection("ws", 2)
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+	g.Expect(limiter.AllowEndpoint("ws", 0)).To(BeNil())
+	g.Expect(status.Code(limiter.AllowEndpoint("ws", 1))).To(Equal(codes.ResourceExhausted))
+
+	unlimited := NewLimiter(Limits{})
+	for i := 0; i < 10; i++ {
+		g.Expect(unlimited.AllowRequest("ws")).To(BeNil())
+	}
+	_, err = unlimited.ReserveConnection("ws", 100)
+	g.Expect(err).To(BeNil())
+	g.Expect(unlimited.AllowEndpoint("ws", 100)).To(BeNil())
+}
+
+func TestLimiterReservesConnectionsConcurrently(t *testing.T) {
+	g := NewWithT(t)
+
+	limiter := NewLimiter(Limits{MaxConnections: 3})
+	var wg sync.WaitGroup
+	var admitted int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := limiter.ReserveConnection("ws", 0); err == nil {
+				atomic.AddInt32(&admitted, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	g.Expect(admitted).To(Equal(int32(3)))
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/limits"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+// LimiterProvider - provides actual admission limiter of a workspace
+type LimiterProvider interface {
+	Limiter() *limits.Limiter
+}
+
+// admissionService - refuses requests exceeding request rate or max connections of a workspace
+type admissionService struct {
+	model           model.Model
+	limiterProvider LimiterProvider
+}
+
+// NewAdmissionService - creates a service to check workspace limits for requests
+func NewAdmissionService(model model.Model, limiterProvider LimiterProvider) networkservice.NetworkServiceServer {
+	return &admissionService{
+		model:           model,
+		limiterProvider: limiterProvider,
+	}
+}
+
+func (srv *admissionService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	workspaceName := common.WorkspaceName(ctx)
+	limiter := srv.limiterProvider.Limiter()
+
+	if err := limiter.AllowRequest(workspaceName); err != nil {
+		common.Log(ctx).Error(err)
+		return nil, err
+	}
+
+	// Updates of existing connections do not change an amount of connections
+	if id := request.GetConnection().GetId(); id == "" || srv.model.GetClientConnection(id) == nil {
+		release, err := limiter.ReserveConnection(workspaceName, srv.establishedConnections(workspaceName))
+		if err != nil {
+			common.Log(ctx).Error(err)
+			return nil, err
+		}
+		// Established connection is counted by the model, a failed one is removed from it
+		defer release()
+	}
+	return common.ProcessNext(ctx, request)
+}
+
+func (srv *admissionService) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return common.ProcessClose(ctx, connection)
+}
+
+// establishedConnections - returns an amount of connections of workspace, new connections being requested hold
+// reserved slots instead and closing ones release their slots
+func (srv *admissionService) establishedConnections(workspaceName string) int {
+	established := 0
+	for _, cc := range srv.model.GetAllClientConnections() {
+		if cc.ConnectionState != model.ClientConnectionClosing && cc.ConnectionState != model.ClientConnectionRequesting &&
+			cc.GetWorkspace() == workspaceName {
+			established++
+		}
+	}
+	return established
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	mechanismCommon "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+// contextConflictService detects connection contexts conflicting with other connections in the client namespace,
+// networks of their addresses are excluded from the request and the endpoint reply is checked against them
+type contextConflictService struct {
+	model model.Model
+}
+
+// NewContextConflictService - creates a service detecting conflicts of connection contexts in the client namespace
+func NewContextConflictService(model model.Model) networkservice.NetworkServiceServer {
+	return &contextConflictService{
+		model: model,
+	}
+}
+
+func (srv *contextConflictService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	logger := common.Log(ctx)
+
+	state := srv.clientNetNsState(request.GetConnection())
+	if state.IsEmpty() {
+		return common.ProcessNext(ctx, request)
+	}
+
+	requestNext := request.Clone()
+	conn := requestNext.GetConnection()
+	if conn.Context == nil {
+		conn.Context = &connectioncontext.ConnectionContext{}
+	}
+	if conn.Context.IpContext == nil {
+		conn.Context.IpContext = &connectioncontext.IPContext{}
+	}
+	prefixes := state.ExcludedPrefixes()
+	logger.Infof("ContextConflictService: excluding prefixes of other connections in the client namespace: %v", prefixes)
+	ipCtx := conn.Context.IpContext
+	ipCtx.ExcludedPrefixes = append(ipCtx.GetExcludedPrefixes(), prefixes...)
+
+	conn, err := common.ProcessNext(ctx, requestNext)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = state.CheckClientContext(conn.GetContext()); err != nil {
+		err = errors.Wrap(err, "connection context conflicts with other connections in the client namespace")
+		logger.Errorf("ContextConflictService: %v", err)
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (srv *contextConflictService) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return common.ProcessClose(ctx, connection)
+}
+
+// clientNetNsState returns addresses and routes of other established connections in the namespace of the client
+func (srv *contextConflictService) clientNetNsState(conn *connection.Connection) *connectioncontext.NetNsState {
+	state := &connectioncontext.NetNsState{}
+	netNsInode := conn.GetMechanism().GetParameters()[mechanismCommon.NetNsInodeKey]
+	if netNsInode == "" {
+		return state
+	}
+	for _, cc := range srv.model.GetAllClientConnections() {
+		if cc.GetID() == conn.GetId() || cc.ConnectionState == model.ClientConnectionClosing {
+			continue
+		}
+		source := cc.Xcon.GetLocalSource()
+		if source.GetMechanism().GetParameters()[mechanismCommon.NetNsInodeKey] != netNsInode {
+			continue
+		}
+		state.AddClientContext(source.GetContext())
+	}
+	return state
+}
@@ -61,6 +61,7 @@ func (cce *endpointSelectorService) Request(ctx context.Context, request *networ
 
 	// 7.1 try find NSE and do a Request to it.
 	var lastError error
+	var lastEndpoint *registry.NSERegistration
 	ignoreEndpoints := common.IgnoredEndpoints(ctx)
 	parentCtx := ctx
 	attempt := 0
@@ -75,7 +76,11 @@ func (cce *endpointSelectorService) Request(ctx context.Context, request *networ
 		newRequest, endpoint, err := cce.prepareRequest(ctx, request, clientConnection, ignoreEndpoints)
 		if err != nil {
 			span.Finish()
-			return cce.combineErrors(span, lastError, err)
+			_, err = cce.combineErrors(span, lastError, err)
+			if lastEndpoint != nil {
+				return nil, common.WithEndpointReason(err, common.ReasonEndpointRequestFailed, lastEndpoint)
+			}
+			return nil, common.WithReason(err, common.ReasonNoMatchingEndpoint)
 		}
 		if err = cce.checkTimeout(parentCtx, span); err != nil {
 			span.Finish()
@@ -91,6 +96,7 @@ func (cce *endpointSelectorService) Request(ctx context.Context, request *networ
 		if err != nil {
 			logger.Errorf("NSM:(7.1.8) NSE respond with error: %v ", err)
 			lastError = err
+			lastEndpoint = endpoint
 			ignoreEndpoints[endpoint.GetEndpointNSMName()] = endpoint
 			span.Finish()
 			continue
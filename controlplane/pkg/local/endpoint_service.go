@@ -95,7 +95,17 @@ func (cce *endpointService) Request(ctx context.Context, request *networkservice
 	if cce.nseManager.IsLocalEndpoint(endpoint) {
 		message = cce.createLocalNSERequest(endpoint, request.Connection, dp.LocalMechanisms, clientConnection)
 	} else {
-		message = cce.createRemoteNSMRequest(endpoint, request.Connection, common.RemoteMechanisms(ctx), clientConnection)
+		remoteMechanisms, mechanismsErr := common.RemoteMechanisms(ctx)
+		if mechanismsErr != nil {
+			logger.Errorf("NSM:(7.2.6.2) Failed to prepare remote mechanisms: %v", mechanismsErr)
+			return nil, mechanismsErr
+		}
+		message = cce.createRemoteNSMRequest(endpoint, request.Connection, remoteMechanisms, clientConnection)
+	}
+	if err = common.SignOutgoingRequest(ctx, request, message); err != nil {
+		err = errors.Wrap(err, "NSM:(7.2.6.2) failed to sign request")
+		logger.Error(err)
+		return nil, err
 	}
 	logger.Infof("NSM:(7.2.6.2) Requesting NSE with request %v", message)
 
@@ -17,9 +17,12 @@ package local
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/srv6"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/subinterface"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
 
 	"github.com/pkg/errors"
 
@@ -80,6 +83,12 @@ func (cce *forwarderService) updateMechanism(request *networkservice.NetworkServ
 	for _, m := range request.GetRequestMechanismPreferences() {
 		if dpMechanism := cce.findMechanism(dp.LocalMechanisms, m.GetType()); dpMechanism != nil {
 			conn.Mechanism = m.Clone()
+			if conn.GetMechanism().GetParameters() == nil {
+				conn.Mechanism.Parameters = map[string]string{}
+			}
+			if m.GetType() == subinterface.MECHANISM {
+				updateSubInterfaceParameters(conn.GetMechanism().GetParameters(), dpMechanism.GetParameters())
+			}
 			break
 		}
 	}
@@ -88,13 +97,19 @@ func (cce *forwarderService) updateMechanism(request *networkservice.NetworkServ
 		return errors.Errorf("required mechanism are not found... %v ", request.GetRequestMechanismPreferences())
 	}
 
-	if conn.GetMechanism().GetParameters() == nil {
-		conn.Mechanism.Parameters = map[string]string{}
-	}
-
 	return nil
 }
 
+// updateSubInterfaceParameters sets the parent interface configured on forwarder, kind and mode are taken from
+// forwarder only if they are not requested by client
+func updateSubInterfaceParameters(parameters, dpParameters map[string]string) {
+	parameters[subinterface.Parent] = dpParameters[subinterface.Parent]
+	if parameters[subinterface.Kind] == "" {
+		parameters[subinterface.Kind] = dpParameters[subinterface.Kind]
+		parameters[subinterface.Mode] = dpParameters[subinterface.Mode]
+	}
+}
+
 func (cce *forwarderService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
 	logger := common.Log(ctx)
 	span := spanhelper.GetSpanHelper(ctx)
@@ -103,13 +118,13 @@ func (cce *forwarderService) Request(ctx context.Context, request *networkservic
 	// 3. get forwarder
 	if err := cce.serviceRegistry.WaitForForwarderAvailable(ctx, cce.model, ForwarderTimeout); err != nil {
 		logger.Errorf("Error waiting for forwarder: %v", err)
-		return nil, err
+		return nil, common.WithReason(err, common.ReasonForwarderTimeout)
 	}
 
 	// TODO: We could iterate forwarders to match required one, if failed with first one.
 	dp, err := cce.selectForwarder(request)
 	if err != nil {
-		return nil, err
+		return nil, common.WithReason(err, common.ReasonNoMatchingForwarder)
 	}
 
 	// 5. Select a local forwarder and put it into conn object
@@ -121,21 +136,36 @@ func (cce *forwarderService) Request(ctx context.Context, request *networkservic
 	span.LogObject("dataplane", dp)
 
 	ctx = common.WithForwarder(ctx, dp)
-	ctx = common.WithRemoteMechanisms(ctx, cce.prepareRemoteMechanisms(request, dp))
+	// A port of an established connection is kept if its update fails
+	hadPort := cce.serviceRegistry.PortAllocator().Allocated(request.GetConnection().GetId())
+	ctx = common.WithRemoteMechanisms(ctx, func() ([]*connection.Mechanism, error) {
+		return cce.prepareRemoteMechanisms(request, dp)
+	})
 	conn, connErr := common.ProcessNext(ctx, request)
 	if connErr != nil {
+		if !hadPort {
+			cce.serviceRegistry.PortAllocator().Release(request.GetConnection().GetId())
+		}
 		return conn, connErr
 	}
+	// A port is kept only if remote NSM selected WireGuard
+	if clientConnection.Xcon.GetRemoteDestination().GetMechanism().GetType() != wireguard.MECHANISM {
+		cce.serviceRegistry.PortAllocator().Release(request.GetConnection().GetId())
+	}
 
 	// We need to program forwarder.
 	return cce.programForwarder(ctx, conn, dp, clientConnection)
 }
 
-// prepareRemoteMechanisms fills mechanism properties
-func (cce *forwarderService) prepareRemoteMechanisms(request *networkservice.NetworkServiceRequest, dp *model.Forwarder) []*connection.Mechanism {
+// prepareRemoteMechanisms fills mechanism properties, only a mechanism required by connection label is prepared if any
+func (cce *forwarderService) prepareRemoteMechanisms(request *networkservice.NetworkServiceRequest, dp *model.Forwarder) ([]*connection.Mechanism, error) {
 	mechanisms := []*connection.Mechanism{}
+	required := request.GetConnection().GetLabels()[connection.RemoteMechanismKey]
 
 	for _, mechanism := range dp.RemoteMechanisms {
+		if required != "" && mechanism.GetType() != required {
+			continue
+		}
 		m := mechanism.Clone()
 		switch m.GetType() {
 		case srv6.MECHANISM:
@@ -146,11 +176,22 @@ func (cce *forwarderService) prepareRemoteMechanisms(request *networkservice.Net
 			parameters[srv6.SrcBSID] = cce.serviceRegistry.SIDAllocator().SID(request.Connection.GetId())
 			parameters[srv6.SrcLocalSID] = cce.serviceRegistry.SIDAllocator().SID(request.Connection.GetId())
 			m.Parameters = parameters
+		case wireguard.MECHANISM:
+			parameters := m.GetParameters()
+			if parameters == nil {
+				parameters = map[string]string{}
+			}
+			port := cce.serviceRegistry.PortAllocator().Port(request.Connection.GetId())
+			if port == 0 {
+				return nil, errors.New("failed to allocate WireGuard port, all ports are in use")
+			}
+			parameters[wireguard.SrcPort] = strconv.Itoa(port)
+			m.Parameters = parameters
 		}
 		mechanisms = append(mechanisms, m)
 	}
 
-	return mechanisms
+	return mechanisms, nil
 }
 
 func (cce *forwarderService) doFailureClose(ctx context.Context) {
@@ -178,6 +219,7 @@ func (cce *forwarderService) Close(ctx context.Context, conn *connection.Connect
 	if closeErr := cce.performClose(ctx, cc, logger); closeErr != nil {
 		logger.Errorf("Failed to close: %v", closeErr)
 	}
+	cce.serviceRegistry.PortAllocator().Release(conn.GetId())
 	return empt, err
 }
 
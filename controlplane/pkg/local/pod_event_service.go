@@ -0,0 +1,59 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/api/nsm"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+)
+
+// podEventService - records failed requests as events of client pods and endpoint pods caused the failure
+type podEventService struct {
+	provider nsm.PodEventRecorderProvider
+}
+
+// NewPodEventService - creates a service recording failed requests as pod events with a recorder of provider
+func NewPodEventService(provider nsm.PodEventRecorderProvider) networkservice.NetworkServiceServer {
+	return &podEventService{
+		provider: provider,
+	}
+}
+
+func (srv *podEventService) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	conn, err := common.ProcessNext(ctx, request)
+	if err != nil {
+		reason, endpoint := common.Reason(err)
+		message := fmt.Sprintf("Request for network service %s failed: %v", request.GetConnection().GetNetworkService(), err)
+		recorder := srv.provider.PodEventRecorder()
+		recorder.RecordWorkspaceEvent(common.WorkspaceName(ctx), reason, message)
+		if endpoint != nil {
+			recorder.RecordEndpointEvent(endpoint, reason, message)
+		}
+	}
+	return conn, err
+}
+
+func (srv *podEventService) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return common.ProcessClose(ctx, connection)
+}
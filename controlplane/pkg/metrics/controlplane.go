@@ -0,0 +1,149 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+const (
+	// NetworkServiceKey is vector label for network service name
+	NetworkServiceKey = "network_service"
+	// ScopeKey is vector label for NSMD API scope, "local" or "remote"
+	ScopeKey = "scope"
+	// ResultKey is vector label for a result of operation
+	ResultKey = "result"
+	// HealStateKey is vector label for a cause of healing
+	HealStateKey = "heal_state"
+	// MethodKey is vector label for gRPC method
+	MethodKey = "method"
+
+	// LocalScope - requests from local clients
+	LocalScope = "local"
+	// RemoteScope - requests from remote NSMDs
+	RemoteScope = "remote"
+
+	successResult = "success"
+	errorResult   = "error"
+	healedResult  = "healed"
+	closedResult  = "closed"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "nsmd_request_duration_seconds",
+		Help: "Time NSMD takes to process connection requests",
+	}, []string{NetworkServiceKey, ScopeKey, ResultKey})
+	closeDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "nsmd_close_duration_seconds",
+		Help: "Time NSMD takes to close connections",
+	}, []string{NetworkServiceKey, ScopeKey, ResultKey})
+	heals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "nsmd_heals_total",
+		Help: "Connections healed or closed by NSMD healing",
+	}, []string{HealStateKey, ResultKey})
+	endpoints = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "nsmd_endpoints",
+		Help: "Local endpoints registered in NSMD",
+	})
+	forwarders = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "nsmd_forwarders",
+		Help: "Forwarders registered in NSMD",
+	})
+	registryCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "nsmd_registry_call_duration_seconds",
+		Help: "Time Network Service Registry takes to process NSMD calls",
+	}, []string{MethodKey, ResultKey})
+)
+
+// RegisterControlPlaneMetrics registers metrics of NSMD in registerer, metrics are tracked even if not registered
+func RegisterControlPlaneMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{requestDuration, closeDuration, heals, endpoints, forwarders, registryCallDuration} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ObserveRequest records how long a request for networkService started at start took
+func ObserveRequest(networkService, scope string, start time.Time, err error) {
+	requestDuration.WithLabelValues(networkService, scope, result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveClose records how long closing a connection to networkService started at start took
+func ObserveClose(networkService, scope string, start time.Time, err error) {
+	closeDuration.WithLabelValues(networkService, scope, result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveHeal records if a connection healing caused by healState ended up healed or closed
+func ObserveHeal(healState string, healed bool) {
+	outcome := closedResult
+	if healed {
+		outcome = healedResult
+	}
+	heals.WithLabelValues(healState, outcome).Inc()
+}
+
+// RegistryCallInterceptor returns an interceptor recording latencies of Network Service Registry calls
+func RegistryCallInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		registryCallDuration.WithLabelValues(method, result(err)).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// modelListener counts endpoints and forwarders registered in a model
+type modelListener struct {
+	model.ListenerImpl
+}
+
+// NewModelListener creates a model listener keeping endpoints and forwarders gauges up to date
+func NewModelListener() model.Listener {
+	return &modelListener{}
+}
+
+func (l *modelListener) EndpointAdded(_ context.Context, _ *model.Endpoint) {
+	endpoints.Inc()
+}
+
+func (l *modelListener) EndpointDeleted(_ context.Context, _ *model.Endpoint) {
+	endpoints.Dec()
+}
+
+func (l *modelListener) ForwarderAdded(_ context.Context, _ *model.Forwarder) {
+	forwarders.Inc()
+}
+
+func (l *modelListener) ForwarderDeleted(_ context.Context, _ *model.Forwarder) {
+	forwarders.Dec()
+}
+
+func result(err error) string {
+	if err != nil {
+		return errorResult
+	}
+	return successResult
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+func TestHealsAreCountedByStateAndResult(t *testing.T) {
+	g := NewWithT(t)
+
+	ObserveHeal("DstDown", true)
+	ObserveHeal("DstDown", false)
+	ObserveHeal("DstDown", false)
+
+	g.Expect(testutil.ToFloat64(heals.WithLabelValues("DstDown", healedResult))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(heals.WithLabelValues("DstDown", closedResult))).To(Equal(2.0))
+}
+
+func TestModelListenerCountsEndpointsAndForwarders(t *testing.T) {
+	g := NewWithT(t)
+
+	mdl := model.NewModel()
+	mdl.AddListener(NewModelListener())
+
+	ctx := context.Background()
+	mdl.AddEndpoint(ctx, &model.Endpoint{})
+	mdl.AddForwarder(ctx, &model.Forwarder{RegisteredName: "forwarder"})
+	g.Eventually(func() float64 { return testutil.ToFloat64(endpoints) }).Should(Equal(1.0))
+	g.Eventually(func() float64 { return testutil.ToFloat64(forwarders) }).Should(Equal(1.0))
+
+	mdl.DeleteForwarder(ctx, "forwarder")
+	g.Eventually(func() float64 { return testutil.ToFloat64(forwarders) }).Should(BeZero())
+}
+
+func TestRegisterControlPlaneMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := prometheus.NewRegistry()
+	g.Expect(RegisterControlPlaneMetrics(registry)).To(Succeed())
+	g.Expect(RegisterControlPlaneMetrics(registry)).NotTo(Succeed())
+}
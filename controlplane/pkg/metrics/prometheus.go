@@ -83,6 +83,9 @@ type PrometheusMetricsContext struct {
 	DstNamespace string
 	// Metrics is the metrics data for the connection
 	Metrics map[string]string
+	// Name is the name of the metrics in a cross connect event, a side and an id of the cross connect,
+	// typed metrics are tracked separately for each name
+	Name string
 }
 
 // BuildPrometheusMetricsContext builds single metrics context,
@@ -156,7 +159,8 @@ func buildPrometheusMetric(metricType string) PrometheusMetric {
 
 // CollectAllMetrics collects metrcis from the crossconnect.Metrics data,
 // and based on the given context (i.e. src and destination connection
-// the metrics are for) tracks that data in the corresponding vectors
+// the metrics are for) tracks that data in the corresponding vectors.
+// Typed metrics are tracked as counters and rates as well.
 func CollectAllMetrics(ctx PrometheusMetricsContext, prometheusMetrics []PrometheusMetric, metrics *crossconnect.Metrics) {
 	for i := range prometheusMetrics {
 		if metrics != nil {
@@ -165,6 +169,9 @@ func CollectAllMetrics(ctx PrometheusMetricsContext, prometheusMetrics []Prometh
 			}
 		}
 	}
+	if metrics.IsTyped() {
+		getTrafficCollector().collect(ctx, metrics)
+	}
 }
 
 func (pm *PrometheusMetric) collect(ctx PrometheusMetricsContext, trafficSize string) {
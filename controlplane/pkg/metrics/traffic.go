@@ -0,0 +1,153 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+const (
+	// DirectionKey is vector label for traffic direction, "rx" or "tx"
+	DirectionKey = "direction"
+
+	rxDirection = "rx"
+	txDirection = "tx"
+)
+
+var trafficLabels = []string{SrcPodKey, SrcNamespaceKey, DstPodKey, DstNamespaceKey, DirectionKey}
+
+// trafficCollector tracks typed cross connect metrics as Prometheus counters and rates
+type trafficCollector struct {
+	bytes       *prometheus.CounterVec
+	packets     *prometheus.CounterVec
+	errors      *prometheus.CounterVec
+	drops       *prometheus.CounterVec
+	bytesRate   *prometheus.GaugeVec
+	packetsRate *prometheus.GaugeVec
+
+	lock sync.Mutex
+	// previous - last collected metrics of each cross connect side, counters are increased by a difference with them
+	previous map[string]*crossconnect.Metrics
+}
+
+var (
+	traffic     *trafficCollector
+	trafficOnce sync.Once
+)
+
+func getTrafficCollector() *trafficCollector {
+	trafficOnce.Do(func() {
+		traffic = &trafficCollector{
+			bytes:       registerCounterVec("crossconnect_bytes_total", "Bytes transmitted by cross connect interfaces"),
+			packets:     registerCounterVec("crossconnect_packets_total", "Packets transmitted by cross connect interfaces"),
+			errors:      registerCounterVec("crossconnect_errors_total", "Packets failed to be transmitted by cross connect interfaces"),
+			drops:       registerCounterVec("crossconnect_drops_total", "Packets dropped by cross connect interfaces"),
+			bytesRate:   registerGaugeVec("crossconnect_bytes_per_second", "Bytes per second transmitted by cross connect interfaces"),
+			packetsRate: registerGaugeVec("crossconnect_packets_per_second", "Packets per second transmitted by cross connect interfaces"),
+			previous:    map[string]*crossconnect.Metrics{},
+		}
+	})
+	return traffic
+}
+
+func registerCounterVec(name, help string) *prometheus.CounterVec {
+	vec := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, trafficLabels)
+	if err := prometheus.Register(vec); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return are.ExistingCollector.(*prometheus.CounterVec)
+		}
+		logrus.Infof("failed to register vector %v, err: %v", name, err)
+	}
+	return vec
+}
+
+func registerGaugeVec(name, help string) *prometheus.GaugeVec {
+	vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, trafficLabels)
+	if err := prometheus.Register(vec); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return are.ExistingCollector.(*prometheus.GaugeVec)
+		}
+		logrus.Infof("failed to register vector %v, err: %v", name, err)
+	}
+	return vec
+}
+
+func (c *trafficCollector) collect(ctx PrometheusMetricsContext, metrics *crossconnect.Metrics) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := ctx.Name
+	if key == "" {
+		key = strings.Join([]string{ctx.SrcPodName, ctx.SrcNamespace, ctx.DstPodName, ctx.DstNamespace}, "/")
+	}
+	previous := c.previous[key]
+	c.previous[key] = metrics
+
+	var seconds float64
+	if previous != nil {
+		current, ok := metrics.GetTime()
+		last, lastOk := previous.GetTime()
+		if ok && lastOk && current.After(last) {
+			seconds = current.Sub(last).Seconds()
+		}
+	}
+
+	c.collectDirection(ctx, rxDirection, metrics.GetRx(), previous.GetRx(), seconds)
+	c.collectDirection(ctx, txDirection, metrics.GetTx(), previous.GetTx(), seconds)
+}
+
+func (c *trafficCollector) forget(names ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, name := range names {
+		delete(c.previous, name)
+	}
+}
+
+// ForgetCrossConnectMetrics forgets last collected typed metrics of both sides of the deleted cross connect
+func ForgetCrossConnectMetrics(crossConnectID string) {
+	getTrafficCollector().forget("SRC-"+crossConnectID, "DST-"+crossConnectID)
+}
+
+func (c *trafficCollector) collectDirection(ctx PrometheusMetricsContext, direction string, current, previous *crossconnect.TrafficCounters, seconds float64) {
+	if current == nil {
+		return
+	}
+	labels := prometheus.Labels{
+		SrcPodKey:       ctx.SrcPodName,
+		SrcNamespaceKey: ctx.SrcNamespace,
+		DstPodKey:       ctx.DstPodName,
+		DstNamespaceKey: ctx.DstNamespace,
+		DirectionKey:    direction,
+	}
+	increase := current.Sub(previous)
+	c.bytes.With(labels).Add(float64(increase.GetBytes()))
+	c.packets.With(labels).Add(float64(increase.GetPackets()))
+	c.errors.With(labels).Add(float64(increase.GetErrors()))
+	c.drops.With(labels).Add(float64(increase.GetDrops()))
+	if previous != nil && seconds > 0 {
+		c.bytesRate.With(labels).Set(float64(increase.GetBytes()) / seconds)
+		c.packetsRate.With(labels).Set(float64(increase.GetPackets()) / seconds)
+	}
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+func TestTypedMetricsAreCollectedAsCounters(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := BuildPrometheusMetricsContext("src-pod", "src-ns", "dst-pod", "dst-ns")
+	labels := prometheus.Labels{
+		SrcPodKey:       "src-pod",
+		SrcNamespaceKey: "src-ns",
+		DstPodKey:       "dst-pod",
+		DstNamespaceKey: "dst-ns",
+		DirectionKey:    rxDirection,
+	}
+	collector := getTrafficCollector()
+
+	now := time.Now()
+	CollectAllMetrics(ctx, nil, crossconnect.NewMetrics(
+		&crossconnect.TrafficCounters{Bytes: 1000, Packets: 10, Drops: 1},
+		&crossconnect.TrafficCounters{Bytes: 500, Packets: 5},
+		now))
+	g.Expect(testutil.ToFloat64(collector.bytes.With(labels))).To(Equal(1000.0))
+
+	CollectAllMetrics(ctx, nil, crossconnect.NewMetrics(
+		&crossconnect.TrafficCounters{Bytes: 3000, Packets: 30, Drops: 2},
+		&crossconnect.TrafficCounters{Bytes: 500, Packets: 5},
+		now.Add(2*time.Second)))
+	g.Expect(testutil.ToFloat64(collector.bytes.With(labels))).To(Equal(3000.0))
+	g.Expect(testutil.ToFloat64(collector.packets.With(labels))).To(Equal(30.0))
+	g.Expect(testutil.ToFloat64(collector.drops.With(labels))).To(Equal(2.0))
+	g.Expect(testutil.ToFloat64(collector.bytesRate.With(labels))).To(Equal(1000.0))
+	g.Expect(testutil.ToFloat64(collector.packetsRate.With(labels))).To(Equal(10.0))
+
+	// Reset interface counters keep Prometheus counters growing
+	CollectAllMetrics(ctx, nil, crossconnect.NewMetrics(
+		&crossconnect.TrafficCounters{Bytes: 100, Packets: 1},
+		nil,
+		now.Add(3*time.Second)))
+	g.Expect(testutil.ToFloat64(collector.bytes.With(labels))).To(Equal(3100.0))
+}
+
+func TestTypedMetricsAreTrackedPerCrossConnectSide(t *testing.T) {
+	g := NewWithT(t)
+
+	labels := prometheus.Labels{
+		SrcPodKey:       "pod-a",
+		SrcNamespaceKey: "ns",
+		DstPodKey:       "pod-b",
+		DstNamespaceKey: "ns",
+		DirectionKey:    rxDirection,
+	}
+	collect := func(name string, bytes uint64) {
+		ctx := BuildPrometheusMetricsContext("pod-a", "ns", "pod-b", "ns")
+		ctx.Name = name
+		CollectAllMetrics(ctx, nil, crossconnect.NewMetrics(&crossconnect.TrafficCounters{Bytes: bytes}, nil, time.Now()))
+	}
+	collector := getTrafficCollector()
+
+	// Cross connects between the same pods do not mix their counters
+	collect("DST-1", 1000)
+	collect("DST-2", 200)
+	collect("DST-1", 1500)
+	g.Expect(testutil.ToFloat64(collector.bytes.With(labels))).To(Equal(1700.0))
+
+	// Deleted cross connect with the same id is counted from the start
+	ForgetCrossConnectMetrics("1")
+	collect("DST-1", 100)
+	g.Expect(testutil.ToFloat64(collector.bytes.With(labels))).To(Equal(1800.0))
+}
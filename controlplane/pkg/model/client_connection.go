@@ -6,6 +6,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	mechanismCommon "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
@@ -66,6 +67,15 @@ func (cc *ClientConnection) GetNetworkService() string {
 	return cc.Endpoint.GetNetworkService().GetName()
 }
 
+// GetWorkspace returns name of workspace local connection is requested from, empty for remote connections
+func (cc *ClientConnection) GetWorkspace() string {
+	src := cc.GetConnectionSource()
+	if src == nil || src.IsRemote() {
+		return ""
+	}
+	return src.GetMechanism().GetParameters()[mechanismCommon.Workspace]
+}
+
 // GetConnectionSource returns source part of connection
 func (cc *ClientConnection) GetConnectionSource() *connection.Connection {
 	if cc.Xcon == nil {
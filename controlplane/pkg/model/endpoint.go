@@ -64,6 +64,15 @@ func (d *endpointDomain) GetEndpoint(name string) *Endpoint {
 	return nil
 }
 
+func (d *endpointDomain) GetAllEndpoints() []*Endpoint {
+	var rv []*Endpoint
+	d.kvRange(func(_ string, value interface{}) bool {
+		rv = append(rv, value.(*Endpoint))
+		return true
+	})
+	return rv
+}
+
 func (d *endpointDomain) GetEndpointsByNetworkService(nsName string) []*Endpoint {
 	var rv []*Endpoint
 	d.kvRange(func(key string, value interface{}) bool {
@@ -104,6 +104,38 @@ func TestGetEndpointsByNs(t *testing.T) {
 	}
 }
 
+func TestGetAllEndpoints(t *testing.T) {
+	g := NewWithT(t)
+
+	ed := newEndpointDomain()
+	g.Expect(ed.GetAllEndpoints()).To(BeEmpty())
+
+	amount := 3
+	for i := 0; i < amount; i++ {
+		ed.AddEndpoint(context.Background(), &Endpoint{
+			Endpoint: &registry.NSERegistration{
+				NetworkService: &registry.NetworkService{
+					Name: fmt.Sprintf("ns%d", i),
+				},
+				NetworkServiceEndpoint: &registry.NetworkServiceEndpoint{
+					Name:               fmt.Sprintf("endp%d", i),
+					NetworkServiceName: fmt.Sprintf("ns%d", i),
+				},
+			},
+			Workspace: "ws",
+		})
+	}
+
+	endpoints := ed.GetAllEndpoints()
+	g.Expect(len(endpoints)).To(Equal(amount))
+
+	names := map[string]bool{}
+	for _, endp := range endpoints {
+		names[endp.EndpointName()] = true
+	}
+	g.Expect(names).To(Equal(map[string]bool{"endp0": true, "endp1": true, "endp2": true}))
+}
+
 func TestDeleteEndpoint(t *testing.T) {
 	g := NewWithT(t)
 
@@ -204,3 +236,17 @@ func TestUpdateNotExisting(t *testing.T) {
 	g.Expect(updated.Endpoint.NetworkServiceManager.Url).To(Equal("2.2.2.2"))
 	g.Expect(updated.Endpoint.NetworkService.Name).To(Equal("ns1"))
 }
+
+func TestCordonEndpoint(t *testing.T) {
+	g := NewWithT(t)
+
+	m := NewModel()
+	g.Expect(m.IsEndpointCordoned("endp1")).To(BeFalse())
+
+	m.CordonEndpoint("endp1", true)
+	g.Expect(m.IsEndpointCordoned("endp1")).To(BeTrue())
+	g.Expect(m.IsEndpointCordoned("endp2")).To(BeFalse())
+
+	m.CordonEndpoint("endp1", false)
+	g.Expect(m.IsEndpointCordoned("endp1")).To(BeFalse())
+}
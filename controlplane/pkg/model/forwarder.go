@@ -95,6 +95,16 @@ func (d *forwarderDomain) GetForwarder(name string) *Forwarder {
 	return nil
 }
 
+// GetAllForwarders returns all forwarders registered in model
+func (d *forwarderDomain) GetAllForwarders() []*Forwarder {
+	var rv []*Forwarder
+	d.kvRange(func(_ string, value interface{}) bool {
+		rv = append(rv, value.(*Forwarder))
+		return true
+	})
+	return rv
+}
+
 func (d *forwarderDomain) DeleteForwarder(ctx context.Context, name string) {
 	d.delete(ctx, name)
 }
@@ -16,10 +16,14 @@ type Model interface {
 
 	AddEndpoint(ctx context.Context, endpoint *Endpoint)
 	GetEndpoint(name string) *Endpoint
+	GetAllEndpoints() []*Endpoint
+	CordonEndpoint(name string, cordon bool)
+	IsEndpointCordoned(name string) bool
 	UpdateEndpoint(ctx context.Context, endpoint *Endpoint)
 	DeleteEndpoint(ctx context.Context, name string)
 
 	GetForwarder(name string) *Forwarder
+	GetAllForwarders() []*Forwarder
 	AddForwarder(ctx context.Context, forwarder *Forwarder)
 	UpdateForwarder(ctx context.Context, forwarder *Forwarder)
 	DeleteForwarder(ctx context.Context, name string)
@@ -55,6 +59,7 @@ type model struct {
 	selector         selector.Selector
 	nsm              *registry.NetworkServiceManager
 	listeners        map[Listener]func()
+	cordoned         map[string]bool
 }
 
 func (m *model) AddListener(listener Listener) {
@@ -125,6 +130,7 @@ func NewModel() Model {
 		forwarderDomain:        newForwarderDomain(),
 		selector:               selector.NewMatchSelector(),
 		listeners:              make(map[Listener]func()),
+		cordoned:               make(map[string]bool),
 	}
 }
 
@@ -163,6 +169,26 @@ func (m *model) SetNsm(nsm *registry.NetworkServiceManager) {
 	m.nsm = nsm
 }
 
+// CordonEndpoint marks endpoint as not available for new connections, or makes it available again if cordon is false
+func (m *model) CordonEndpoint(name string, cordon bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if cordon {
+		m.cordoned[name] = true
+	} else {
+		delete(m.cordoned, name)
+	}
+}
+
+// IsEndpointCordoned returns true if endpoint is not available for new connections
+func (m *model) IsEndpointCordoned(name string) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.cordoned[name]
+}
+
 func (m *model) GetSelector() selector.Selector {
 	return m.selector
 }
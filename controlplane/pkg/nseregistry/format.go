@@ -0,0 +1,174 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nseregistry
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+)
+
+const (
+	// registryVersion - current version of local registry file format.
+	registryVersion = 2
+	// headerPrefix - first field of a header line, identifies versioned registry files.
+	headerPrefix = "#nsm-local-registry"
+	// fieldSeparator - separates fields of a record, could not appear inside base64 encoded values.
+	fieldSeparator = "\t"
+	// kindVersionSeparator - separates a record kind from a format version the record is written in,
+	// a record without a version is written in the version of the file header.
+	kindVersionSeparator = "/"
+)
+
+// header - returns a header line for current registry file format.
+func header() string {
+	return fmt.Sprintf("%s%sv%d", headerPrefix, fieldSeparator, registryVersion)
+}
+
+// parseHeader - checks if line is a registry file header and returns a format version.
+func parseHeader(line string) (int, bool) {
+	values := strings.Split(strings.TrimSpace(line), fieldSeparator)
+	if len(values) != 2 || values[0] != headerPrefix {
+		return 0, false
+	}
+	version := 0
+	if _, err := fmt.Sscanf(values[1], "v%d", &version); err != nil {
+		return 0, false
+	}
+	return version, true
+}
+
+// parseKind - splits a record kind field into a kind and a format version, fileVersion is used if there is no version.
+func parseKind(field string, fileVersion int) (kind string, version int, err error) {
+	idx := strings.LastIndex(field, kindVersionSeparator)
+	if idx < 0 {
+		return field, fileVersion, nil
+	}
+	if _, err := fmt.Sscanf(field[idx+1:], "v%d", &version); err != nil {
+		return "", 0, errors.Errorf("wrong record version: %s", field[idx+1:])
+	}
+	return field[:idx], version, nil
+}
+
+// encodeRecord - encodes record of kind with values, every value is base64 encoded and record is
+// finished with a crc32 checksum of all previous fields.
+func encodeRecord(kind string, values ...string) string {
+	fields := []string{kind}
+	for _, v := range values {
+		fields = append(fields, base64.StdEncoding.EncodeToString([]byte(v)))
+	}
+	body := strings.Join(fields, fieldSeparator)
+	return fmt.Sprintf("%s%s%08x", body, fieldSeparator, crc32.ChecksumIEEE([]byte(body)))
+}
+
+// decodeRecord - decodes a record line, verifies its checksum and returns record kind and values.
+func decodeRecord(line string) (kind string, values []string, err error) {
+	line = strings.TrimRight(line, "\r\n")
+	idx := strings.LastIndex(line, fieldSeparator)
+	if idx < 0 {
+		return "", nil, errors.Errorf("record has no checksum")
+	}
+	body, checksum := line[:idx], line[idx+1:]
+	if expected := fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(body))); expected != checksum {
+		return "", nil, errors.Errorf("checksum mismatch, expected %s, actual %s", expected, checksum)
+	}
+	fields := strings.Split(body, fieldSeparator)
+	for _, f := range fields[1:] {
+		bytes, err := base64.StdEncoding.DecodeString(f)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to decode record field")
+		}
+		values = append(values, string(bytes))
+	}
+	return fields[0], values, nil
+}
+
+// encodeNSE - serializes NSE registration to be stored as a record value.
+func encodeNSE(nseReg *registry.NSERegistration) (string, error) {
+	bytes, err := proto.Marshal(nseReg)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to serialize NSE registration")
+	}
+	return string(bytes), nil
+}
+
+// decodeNSE - deserializes NSE registration stored as a record value.
+func decodeNSE(value []byte) (*registry.NSERegistration, error) {
+	nseReg := &registry.NSERegistration{}
+	if err := proto.Unmarshal(value, nseReg); err != nil {
+		return nil, errors.Wrap(err, "failed to decode NSE registration")
+	}
+	return nseReg, nil
+}
+
+// encodeMetadata - serializes client workspace metadata to be stored as a record value.
+func encodeMetadata(metadata *nsmdapi.WorkspaceMetadata) (string, error) {
+	bytes, err := proto.Marshal(metadata)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to serialize client metadata")
+	}
+	return string(bytes), nil
+}
+
+// decodeMetadata - deserializes client workspace metadata stored as a record value.
+func decodeMetadata(value []byte) (*nsmdapi.WorkspaceMetadata, error) {
+	metadata := &nsmdapi.WorkspaceMetadata{}
+	if err := proto.Unmarshal(value, metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to decode client metadata")
+	}
+	return metadata, nil
+}
+
+// decodeLegacyRecord - decodes a record line of the legacy tab-separated format without a version header.
+func decodeLegacyRecord(line string) (kind string, values []string, err error) {
+	values = restore(line)
+	if len(values) == 0 {
+		return "", nil, errors.Errorf("empty record")
+	}
+	kind, values = values[0], values[1:]
+	if kind == NSERegistered && len(values) == 3 {
+		bytes, err := base64.StdEncoding.DecodeString(values[2])
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to decode NSE registration")
+		}
+		values[2] = string(bytes)
+	}
+	return kind, values, nil
+}
+
+func unescape(s string) string {
+	s = strings.Replace(s, "\\t", "\t", -1)
+	s = strings.Replace(s, "\\n", "\n", -1)
+	s = strings.Replace(s, "\\r", "\r", -1)
+	return s
+}
+
+func restore(inputS string) []string {
+	ts := strings.TrimSpace(inputS)
+	segments := strings.SplitN(ts, "\t", -1)
+	for idx, sm := range segments {
+		segments[idx] = unescape(sm)
+	}
+	return segments
+}
@@ -2,21 +2,23 @@ package nseregistry
 
 import (
 	"bufio"
-	"encoding/base64"
 	"io"
 	"os"
-	"strings"
+	"path/filepath"
+	"sort"
 	"sync"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
 )
 
 const (
 	ClientRegistered = "CLE"
-	NSERegistered    = "NSE"
+	// ClientMetadata - a record of a workload client workspace is allocated for, the last record wins.
+	ClientMetadata = "CLM"
+	NSERegistered  = "NSE"
 )
 
 type NSERegistry struct {
@@ -29,44 +31,35 @@ type NSEEntry struct {
 	NseReg    *registry.NSERegistration
 }
 
-func NewNSERegistry(file string) *NSERegistry {
-	return &NSERegistry{file: file}
+// records - a content of registry file.
+type records struct {
+	clients  []string
+	metadata map[string]*nsmdapi.WorkspaceMetadata
+	nses     map[string]NSEEntry
 }
 
-func store(values ...string) string {
-	res := ""
-	for _, s := range values {
-		if len(res) > 0 {
-			res += "\t"
-		}
-		ss := strings.Replace(s, "\t", "\\t,", -1)
-		ss = strings.Replace(ss, "\n", "\\n,", -1) // Just in case
-		ss = strings.Replace(ss, "\r", "\\r,", -1) // Just in case
-		res += ss
+func newRecords() *records {
+	return &records{
+		metadata: map[string]*nsmdapi.WorkspaceMetadata{},
+		nses:     map[string]NSEEntry{},
 	}
-	return res
-}
-func unescape(s string) string {
-	s = strings.Replace(s, "\\t", "\t", -1)
-	s = strings.Replace(s, "\\n", "\n", -1)
-	s = strings.Replace(s, "\\r", "\r", -1)
-	return s
 }
-func restore(inputS string) []string {
-	ts := strings.TrimSpace(inputS)
-	segments := strings.SplitN(ts, "\t", -1)
-	for idx, sm := range segments {
-		segments[idx] = unescape(sm)
-	}
-	return segments
+
+func NewNSERegistry(file string) *NSERegistry {
+	return &NSERegistry{file: file}
 }
 
 /**
 We adding a registration for client.
 */
-func (reg *NSERegistry) writeLine(op ...string) error {
+func (reg *NSERegistry) writeRecord(kind string, values ...string) error {
 	reg.lock.Lock()
 	defer reg.lock.Unlock()
+
+	if err := reg.prepareForAppend(); err != nil {
+		return err
+	}
+
 	f, err := os.OpenFile(reg.file, os.O_APPEND|os.O_WRONLY|os.O_SYNC|os.O_CREATE, 0600)
 	if err != nil {
 		logrus.Errorf("Failed to store Client information")
@@ -75,39 +68,83 @@ func (reg *NSERegistry) writeLine(op ...string) error {
 
 	defer f.Close()
 
-	if _, err = f.WriteString(store(op...) + "\n"); err != nil {
+	if _, err = f.WriteString(encodeRecord(kind, values...) + "\n"); err != nil {
 		return err
 	}
 	_ = f.Sync()
 	return nil
 }
+
+/**
+Checks the registry file could be appended with a record of the current format.
+A legacy or damaged file is compacted first, so a new record will never be glued to a partially written one.
+*/
+func (reg *NSERegistry) prepareForAppend() error {
+	info, err := os.Stat(reg.file)
+	if os.IsNotExist(err) || (err == nil && info.Size() == 0) {
+		return reg.save(newRecords())
+	}
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(reg.file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	firstLine, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return err
+	}
+	lastByte := make([]byte, 1)
+	if _, err = f.ReadAt(lastByte, info.Size()-1); err != nil {
+		return err
+	}
+	if version, ok := parseHeader(firstLine); ok && version == registryVersion && lastByte[0] == '\n' {
+		return nil
+	}
+
+	logrus.Warnf("Local registry file %s requires compaction before append", reg.file)
+	content, err := reg.loadRegistry()
+	if err != nil {
+		return err
+	}
+	return reg.save(content)
+}
+
 func (reg *NSERegistry) AppendClientRequest(workspace string) error {
-	return reg.writeLine(ClientRegistered, workspace)
+	return reg.writeRecord(ClientRegistered, workspace)
 }
 
-func (reg *NSERegistry) AppendNSERegRequest(workspace string, nseReg *registry.NSERegistration) error {
-	return reg.writeLine(NSERegistered, nseReg.GetNetworkServiceEndpoint().GetName(), workspace, reg.storeNSEBase64(nseReg)) // Few workspaces could contain few NSEs
+// AppendClientMetadata - stores a description of a workload client workspace is allocated for.
+func (reg *NSERegistry) AppendClientMetadata(workspace string, metadata *nsmdapi.WorkspaceMetadata) error {
+	value, err := encodeMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	return reg.writeRecord(ClientMetadata, workspace, value)
 }
 
-func (reg *NSERegistry) storeNSEBase64(nseReg *registry.NSERegistration) string {
-	bytes, err := proto.Marshal(nseReg)
+func (reg *NSERegistry) AppendNSERegRequest(workspace string, nseReg *registry.NSERegistration) error {
+	value, err := encodeNSE(nseReg)
 	if err != nil {
-		logrus.Errorf("Failed to serialize NSE passed %v", err)
+		return err
 	}
-	strData := base64.StdEncoding.EncodeToString(bytes)
-	return strData
+	return reg.writeRecord(NSERegistered, nseReg.GetNetworkServiceEndpoint().GetName(), workspace, value) // Few workspaces could contain few NSEs
 }
 
 func (reg *NSERegistry) DeleteNSE(endpointid string) error {
 	reg.lock.Lock()
 	defer reg.lock.Unlock()
-	clients, nses, err := reg.loadRegistry()
+	content, err := reg.loadRegistry()
 	if err != nil {
 		return err
 	}
-	delete(nses, endpointid)
+	delete(content.nses, endpointid)
 
-	return reg.Save(clients, nses)
+	return reg.save(content)
 }
 
 /**
@@ -116,110 +153,267 @@ Delete client workspace and all NSEs registered.
 func (reg *NSERegistry) DeleteClient(workspace string) error {
 	reg.lock.Lock()
 	defer reg.lock.Unlock()
-	clients, nses, err := reg.loadRegistry()
+	content, err := reg.loadRegistry()
 	if err != nil {
 		return err
 	}
-	for idx, ws := range clients {
-		if ws == workspace {
-			clients = append(clients[:idx], clients[idx+1:]...)
+	updatedClients := content.clients[:0]
+	for _, ws := range content.clients {
+		if ws != workspace {
+			updatedClients = append(updatedClients, ws)
 		}
 	}
+	content.clients = updatedClients
+	delete(content.metadata, workspace)
 
-	for endpointId, entry := range nses {
+	for endpointId, entry := range content.nses {
 		if entry.Workspace == workspace {
-			delete(nses, endpointId)
+			delete(content.nses, endpointId)
 		}
 	}
 
-	return reg.Save(clients, nses)
+	return reg.save(content)
+}
+
+/**
+Loads stored clients and NSEs. Legacy format files are migrated and corrupted records are dropped
+by compaction of the registry file.
+*/
+func (reg *NSERegistry) LoadRegistry() ([]string, map[string]NSEEntry, error) {
+	reg.lock.Lock()
+	defer reg.lock.Unlock()
+	content, compact, err := reg.readRegistry()
+	if err != nil {
+		return nil, nil, err
+	}
+	if compact {
+		logrus.Infof("Compacting local registry file %s", reg.file)
+		if err = reg.save(content); err != nil {
+			logrus.Errorf("Failed to compact local registry file %s: %v", reg.file, err)
+		}
+	}
+	return content.clients, content.nses, nil
 }
 
-func (reg *NSERegistry) LoadRegistry() (clients []string, nses map[string]NSEEntry, err error) {
+// LoadClientMetadata - loads stored descriptions of workloads client workspaces are allocated for.
+func (reg *NSERegistry) LoadClientMetadata() (map[string]*nsmdapi.WorkspaceMetadata, error) {
 	reg.lock.Lock()
 	defer reg.lock.Unlock()
-	return reg.loadRegistry()
+	content, err := reg.loadRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return content.metadata, nil
 }
 
-func (reg *NSERegistry) loadRegistry() (clients []string, nses map[string]NSEEntry, res_err error) {
-	nses = map[string]NSEEntry{}
+func (reg *NSERegistry) loadRegistry() (*records, error) {
+	content, _, err := reg.readRegistry()
+	return content, err
+}
+
+/**
+Reads registry file, records with a wrong checksum, content or an unsupported version are skipped with a warning.
+compact is true if file is in a legacy or unsupported format or some records were skipped.
+*/
+func (reg *NSERegistry) readRegistry() (content *records, compact bool, err error) {
+	content = newRecords()
 
 	f, err := os.OpenFile(reg.file, os.O_RDONLY, 0600)
 	if err != nil {
 		logrus.Infof("No stored registry file exists")
-		return
+		return content, false, nil
 	}
 	defer f.Close()
 
+	decode := decodeLegacyRecord
+	fileVersion := registryVersion
 	reader := bufio.NewReader(f)
-	for {
-		r, err := reader.ReadString('\n')
-		if err != nil {
-			if err != io.EOF {
-				res_err = err
-				return
+	for lineNumber := 1; ; lineNumber++ {
+		r, readErr := reader.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, false, readErr
+		}
+		if readErr == io.EOF {
+			if len(r) > 0 {
+				logrus.Warnf("Local registry file %s: skipping partially written record at line %d", reg.file, lineNumber)
+				compact = true
 			}
-			// End of file
 			break
 		}
-		values := restore(r)
-		logrus.Printf("Values: %v", values)
-		if len(values) > 0 {
-			if values[0] == ClientRegistered && len(values) == 2 {
-				clients = append(clients, values[1])
-			} else if values[0] == NSERegistered && len(values) == 4 {
-				entry := NSEEntry{}
-				entry.Workspace = values[2]
-				strData := values[3]
-				bytes, err := base64.StdEncoding.DecodeString(strData)
-				if err != nil {
-					logrus.Errorf("Failed to decode NSE registration %v", err)
-				}
-				nseReg := &registry.NSERegistration{}
-				err = proto.Unmarshal(bytes, nseReg)
-				if err != nil {
-					logrus.Errorf("Failed to decode nse registration message %v", err)
+
+		if lineNumber == 1 {
+			if version, ok := parseHeader(r); ok {
+				if version != registryVersion {
+					logrus.Warnf("Local registry file %s has an unsupported version %d, only records of version %d will be loaded", reg.file, version, registryVersion)
+					compact = true
 				}
-				entry.NseReg = nseReg
-				nses[values[1]] = entry
-			} else {
-				logrus.Errorf("Unknown registry file line: %v", r)
+				fileVersion = version
+				decode = decodeRecord
+				continue
 			}
+			logrus.Infof("Local registry file %s has a legacy format, will be migrated", reg.file)
+			compact = true
+		}
+
+		kind, values, decodeErr := decode(r)
+		version := fileVersion
+		if decodeErr == nil {
+			kind, version, decodeErr = parseKind(kind, fileVersion)
+		}
+		if decodeErr != nil {
+			logrus.Warnf("Local registry file %s: skipping corrupted record at line %d: %v", reg.file, lineNumber, decodeErr)
+			compact = true
+			continue
+		}
+		if version != registryVersion {
+			logrus.Warnf("Local registry file %s: skipping record of unsupported version %d at line %d", reg.file, version, lineNumber)
+			compact = true
+			continue
+		}
+		if !reg.applyRecord(kind, values, content) {
+			logrus.Warnf("Local registry file %s: skipping unknown record at line %d: %v", reg.file, lineNumber, kind)
+			compact = true
 		}
 	}
-	logrus.Infof("Clients: %v", clients)
-	logrus.Infof("NSEs: %v", nses)
+	logrus.Infof("Clients: %v", content.clients)
+	logrus.Infof("NSEs: %v", content.nses)
 
-	return
+	return content, compact, nil
+}
+
+func (reg *NSERegistry) applyRecord(kind string, values []string, content *records) bool {
+	switch {
+	case kind == ClientRegistered && len(values) == 1:
+		for _, ws := range content.clients {
+			if ws == values[0] {
+				return true
+			}
+		}
+		content.clients = append(content.clients, values[0])
+		return true
+	case kind == ClientMetadata && len(values) == 2:
+		metadata, err := decodeMetadata([]byte(values[1]))
+		if err != nil {
+			logrus.Errorf("Failed to decode client metadata %v", err)
+			return false
+		}
+		content.metadata[values[0]] = metadata
+		return true
+	case kind == NSERegistered && len(values) == 3:
+		nseReg, err := decodeNSE([]byte(values[2]))
+		if err != nil {
+			logrus.Errorf("Failed to decode NSE registration %v", err)
+			return false
+		}
+		content.nses[values[0]] = NSEEntry{
+			Workspace: values[1],
+			NseReg:    nseReg,
+		}
+		return true
+	}
+	return false
 }
 
 /**
-Saves memory model info file
+Saves memory model info file, stored metadata of remaining clients is kept.
 */
 func (reg *NSERegistry) Save(clients []string, nses map[string]NSEEntry) error {
+	reg.lock.Lock()
+	defer reg.lock.Unlock()
+	stored, err := reg.loadRegistry()
+	if err != nil {
+		return err
+	}
+	content := newRecords()
+	content.clients = clients
+	for _, ws := range clients {
+		if metadata, ok := stored.metadata[ws]; ok {
+			content.metadata[ws] = metadata
+		}
+	}
+	if nses != nil {
+		content.nses = nses
+	}
+	return reg.save(content)
+}
+
+/**
+Writes a compacted registry into a temporary file and atomically replaces the registry file with it.
+*/
+func (reg *NSERegistry) save(content *records) error {
 	tmpFile := reg.file + "_tmp"
-	f, err := os.OpenFile(tmpFile, os.O_APPEND|os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0600)
+	f, err := os.OpenFile(tmpFile, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0600)
 	if err != nil {
 		logrus.Errorf("Failed to store Client information")
 		return err
 	}
 
-	for _, workspace := range clients {
-		if _, err = f.WriteString(store(ClientRegistered, workspace) + "\n"); err != nil {
+	if err = writeRecords(f, content); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmpFile)
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	// Now we need to replace existing file with new one.
+	if err = os.Rename(tmpFile, reg.file); err != nil {
+		return err
+	}
+	syncDir(filepath.Dir(reg.file))
+	return nil
+}
+
+func writeRecords(f *os.File, content *records) error {
+	w := bufio.NewWriter(f)
+	if _, err := w.WriteString(header() + "\n"); err != nil {
+		return err
+	}
+	for _, workspace := range content.clients {
+		if _, err := w.WriteString(encodeRecord(ClientRegistered, workspace) + "\n"); err != nil {
+			return err
+		}
+		metadata, ok := content.metadata[workspace]
+		if !ok {
+			continue
+		}
+		value, err := encodeMetadata(metadata)
+		if err != nil {
+			return err
+		}
+		if _, err := w.WriteString(encodeRecord(ClientMetadata, workspace, value) + "\n"); err != nil {
 			return err
 		}
 	}
 
-	for endpointId, entry := range nses {
-		if _, err = f.WriteString(store(NSERegistered, endpointId, entry.Workspace, reg.storeNSEBase64(entry.NseReg)) + "\n"); err != nil {
+	endpointIds := make([]string, 0, len(content.nses))
+	for endpointId := range content.nses {
+		endpointIds = append(endpointIds, endpointId)
+	}
+	sort.Strings(endpointIds)
+	for _, endpointId := range endpointIds {
+		entry := content.nses[endpointId]
+		value, err := encodeNSE(entry.NseReg)
+		if err != nil {
+			return err
+		}
+		if _, err := w.WriteString(encodeRecord(NSERegistered, endpointId, entry.Workspace, value) + "\n"); err != nil {
 			return err
 		}
 	}
-	if err = f.Close(); err != nil {
+	if err := w.Flush(); err != nil {
 		return err
 	}
-	// Now we need to replace existing file with new one.
-	return os.Rename(tmpFile, reg.file)
+	return f.Sync()
+}
+
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	_ = d.Sync()
 }
 
 func (reg *NSERegistry) Delete() {
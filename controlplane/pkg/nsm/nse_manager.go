@@ -34,6 +34,9 @@ func (nsem *nseManager) GetEndpoint(ctx context.Context, requestConnection *conn
 	span.LogObject("targetEndpoint", targetEndpoint)
 	if len(targetEndpoint) > 0 {
 		endpoint := nsem.model.GetEndpoint(targetEndpoint)
+		if nsem.model.IsEndpointCordoned(targetEndpoint) {
+			return nil, errors.Errorf("endpoint %s is cordoned", targetEndpoint)
+		}
 		if endpoint != nil && ignoreEndpoints[endpoint.Endpoint.GetEndpointNSMName()] == nil {
 			return endpoint.Endpoint, nil
 		} else {
@@ -142,6 +145,9 @@ func (nsem *nseManager) filterEndpoints(endpoints []*registry.NetworkServiceEndp
 	// Do filter of endpoints
 	for _, candidate := range endpoints {
 		endpointName := registry.NewEndpointNSMName(candidate, managers[candidate.NetworkServiceManagerName])
+		if nsem.model.IsEndpointCordoned(candidate.GetName()) || managers[candidate.NetworkServiceManagerName].IsDraining() {
+			continue
+		}
 		if ignoreEndpoints[endpointName] == nil {
 			result = append(result, candidate)
 		}
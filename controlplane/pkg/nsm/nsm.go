@@ -25,9 +25,12 @@ import (
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	mechanismCommon "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/geneve"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/gre"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/srv6"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
@@ -60,7 +63,9 @@ type networkServiceManager struct {
 	nseManager       nsm.NetworkServiceEndpointManager
 
 	remoteService networkservice.NetworkServiceServer
+	podEvents     nsm.PodEventRecorder
 	ctx           context.Context
+	draining      bool
 }
 
 func (srv *networkServiceManager) Context() context.Context {
@@ -87,6 +92,29 @@ func (srv *networkServiceManager) SetRemoteServer(server networkservice.NetworkS
 	srv.remoteService = server
 }
 
+// PodEventRecorder returns a recorder of pod events, events are not recorded if it is not set
+func (srv *networkServiceManager) PodEventRecorder() nsm.PodEventRecorder {
+	srv.RLock()
+	defer srv.RUnlock()
+	if srv.podEvents == nil {
+		return noopPodEventRecorder{}
+	}
+	return srv.podEvents
+}
+
+// SetPodEventRecorder sets a recorder of pod events
+func (srv *networkServiceManager) SetPodEventRecorder(recorder nsm.PodEventRecorder) {
+	srv.Lock()
+	defer srv.Unlock()
+	srv.podEvents = recorder
+}
+
+type noopPodEventRecorder struct{}
+
+func (noopPodEventRecorder) RecordWorkspaceEvent(string, string, string) {}
+
+func (noopPodEventRecorder) RecordEndpointEvent(*registry.NSERegistration, string, string) {}
+
 func (srv *networkServiceManager) ServiceRegistry() serviceregistry.ServiceRegistry {
 	return srv.serviceRegistry
 }
@@ -340,6 +368,12 @@ func (srv *networkServiceManager) getConnectionParameters(xcon *crossconnect.Cro
 
 		networkServiceName = src.GetNetworkService()
 		endpointName = src.GetNetworkServiceEndpointName()
+
+		if m := wireguard.ToMechanism(src.GetMechanism()); m != nil {
+			if port, err := m.DstPort(); err == nil {
+				srv.serviceRegistry.PortAllocator().Restore(src.GetId(), port)
+			}
+		}
 	} else if dst := xcon.GetDestination(); dst != nil && !dst.IsRemote() {
 		// Local NSE, connection is Ready
 		networkServiceName = dst.GetNetworkService()
@@ -371,6 +405,33 @@ func (srv *networkServiceManager) getConnectionParameters(xcon *crossconnect.Cro
 			} else {
 				srv.serviceRegistry.SIDAllocator().Restore(hardwareAddress, srcLocalSID)
 			}
+		case gre.MECHANISM:
+			m := gre.ToMechanism(mm)
+			srcIP, err := m.SrcIP()
+			dstIP, err2 := m.DstIP()
+			key, err3 := m.Key()
+			if err != nil || err2 != nil || err3 != nil {
+				logrus.Errorf("Error retrieving SRC/DST IP or key from Remote connection %v %v %v", err, err2, err3)
+			} else {
+				srv.serviceRegistry.VniAllocator().Restore(srcIP, dstIP, key)
+			}
+		case geneve.MECHANISM:
+			m := geneve.ToMechanism(mm)
+			srcIP, err := m.SrcIP()
+			dstIP, err2 := m.DstIP()
+			vni, err3 := m.VNI()
+			if err != nil || err2 != nil || err3 != nil {
+				logrus.Errorf("Error retrieving SRC/DST IP or VNI from Remote connection %v %v %v", err, err2, err3)
+			} else {
+				srv.serviceRegistry.VniAllocator().Restore(srcIP, dstIP, vni)
+			}
+		case wireguard.MECHANISM:
+			m := wireguard.ToMechanism(mm)
+			if port, err := m.SrcPort(); err != nil {
+				logrus.Errorf("Error retrieving SRC port from Remote connection %v", err)
+			} else {
+				srv.serviceRegistry.PortAllocator().Restore(xcon.GetSource().GetId(), port)
+			}
 			// Add other mechanisms support here
 		}
 	}
@@ -405,6 +466,29 @@ func (srv *networkServiceManager) RemoteConnectionLost(ctx context.Context, clie
 	}()
 }
 
+// SetDraining - marks NSM as draining before shutdown
+func (srv *networkServiceManager) SetDraining(draining bool) {
+	srv.Lock()
+	defer srv.Unlock()
+	srv.draining = draining
+}
+
+// IsDraining - return true if NSM is draining before shutdown
+func (srv *networkServiceManager) IsDraining() bool {
+	srv.RLock()
+	defer srv.RUnlock()
+	return srv.draining
+}
+
+// Heal - heals connection if NSM is not draining, otherwise connection is kept as is to be restored after restart
+func (srv *networkServiceManager) Heal(ctx context.Context, clientConnection nsm.ClientConnection, healState nsm.HealState) {
+	if srv.IsDraining() {
+		logrus.Infof("NSM: Draining, heal of connection %v is skipped", clientConnection.GetID())
+		return
+	}
+	srv.NetworkServiceHealProcessor.Heal(ctx, clientConnection, healState)
+}
+
 func (srv *networkServiceManager) NotifyRenamedEndpoint(nseOldName, nseNewName string) {
 	logrus.Infof("Notified about renamed endpoint %v => %v", nseOldName, nseNewName)
 	srv.renamedEndpoints[nseOldName] = nseNewName
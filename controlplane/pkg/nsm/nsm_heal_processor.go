@@ -17,6 +17,7 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/metrics"
 
 	"github.com/sirupsen/logrus"
 
@@ -174,6 +175,7 @@ func (p *healProcessor) serve() {
 				healed = p.healDstMgrDown(ctx, e.cc)
 			}
 
+			metrics.ObserveHeal(e.healState.String(), healed)
 			if healed {
 				span.LogValue("status", "healed")
 				logger.Infof("NSM_Heal(%v) Heal: Connection recovered: %v", e.healID, e.cc)
@@ -182,12 +184,25 @@ func (p *healProcessor) serve() {
 				p.healCancellersMutex.Unlock()
 			} else {
 				span.LogValue("status", "closing")
+				p.recordHealFailed(e)
 				_ = p.CloseConnection(ctx, e.cc)
 			}
 		}()
 	}
 }
 
+func (p *healProcessor) recordHealFailed(e healEvent) {
+	message := fmt.Sprintf("Connection %v to network service %v is closed, healing of %v failed",
+		e.cc.GetID(), e.cc.GetNetworkService(), e.healState)
+	recorder := p.manager.PodEventRecorder()
+	if workspace := e.cc.GetWorkspace(); workspace != "" {
+		recorder.RecordWorkspaceEvent(workspace, common.ReasonHealFailed, message)
+	}
+	if e.cc.Endpoint != nil {
+		recorder.RecordEndpointEvent(e.cc.Endpoint, common.ReasonHealFailed, message)
+	}
+}
+
 func (p *healProcessor) healDstDown(ctx context.Context, cc *model.ClientConnection) bool {
 	span := spanhelper.FromContext(ctx, "healDstDown")
 	defer span.Finish()
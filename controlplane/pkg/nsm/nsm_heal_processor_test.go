@@ -19,6 +19,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	mechanismCommon "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
@@ -182,6 +183,24 @@ func TestHealDstDown_LocalClientLocalEndpoint(t *testing.T) {
 		Verify(t)
 }
 
+func TestHealFailedIsRecordedForClientAndEndpoint(t *testing.T) {
+	g := NewWithT(t)
+	data := newHealTestData()
+
+	nse1 := data.createEndpoint(nse1Name, localNSMName)
+	xcon := data.createCrossConnection(false, false, "src", "dst")
+	xcon.Source.Mechanism = &connection.Mechanism{
+		Parameters: map[string]string{mechanismCommon.Workspace: "nsm-1"},
+	}
+	cc := data.createClientConnection("id", xcon, nse1, localNSMName, forwarder1Name, data.createRequest(false))
+
+	data.healProcessor.recordHealFailed(healEvent{cc: cc, healState: nsm.HealStateDstDown})
+	g.Expect(data.connectionManager.podEvents).To(ConsistOf(
+		"nsm-1:"+common.ReasonHealFailed,
+		nse1Name+":"+common.ReasonHealFailed,
+	))
+}
+
 func TestHealDstDown_LocalClientLocalEndpoint_NoNSEFound(t *testing.T) {
 	g := NewWithT(t)
 	data := newHealTestData()
@@ -319,6 +338,22 @@ type connectionManagerStub struct {
 	nse          *registry.NSERegistration
 
 	closeError error
+
+	podEvents podEventRecorderStub
+}
+
+type podEventRecorderStub []string
+
+func (stub *podEventRecorderStub) RecordWorkspaceEvent(workspace, reason, _ string) {
+	*stub = append(*stub, workspace+":"+reason)
+}
+
+func (stub *podEventRecorderStub) RecordEndpointEvent(endpoint *registry.NSERegistration, reason, _ string) {
+	*stub = append(*stub, endpoint.GetNetworkServiceEndpoint().GetName()+":"+reason)
+}
+
+func (stub *connectionManagerStub) PodEventRecorder() nsm.PodEventRecorder {
+	return &stub.podEvents
 }
 
 func (stub *connectionManagerStub) LocalManager(cc nsm.ClientConnection) networkservice.NetworkServiceServer {
@@ -0,0 +1,181 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nsmd
+
+import (
+	"context"
+	"net"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmadmin"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/api/nsm"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/services"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools/spanhelper"
+)
+
+// AdminSock defines the path of NSM admin API socket
+const AdminSock = "/var/lib/networkservicemesh/nsm.admin.io.sock"
+
+type nsmAdminServer struct {
+	model       model.Model
+	manager     nsm.NetworkServiceManager
+	xconManager *services.ClientConnectionManager
+}
+
+// StartAdminServerAt starts admin gRPC API server at sock, only users allowed by NSMD_ADMIN_ALLOWED_UIDS are able to use it
+func (nsm *nsmServer) StartAdminServerAt(ctx context.Context, sock net.Listener) error {
+	span := spanhelper.FromContext(ctx, "start-admin-api-server")
+	defer span.Finish()
+
+	allowedUIDs, err := getAdminAllowedUIDs()
+	if err != nil {
+		span.LogError(err)
+		return err
+	}
+	span.LogObject("allowed-uids", allowedUIDs)
+
+	nsm.adminServer = tools.NewServerInsecure(grpc.Creds(newPeerCredentials(allowedUIDs)))
+	nsmadmin.RegisterNSMAdminServer(nsm.adminServer, &nsmAdminServer{
+		model:       nsm.model,
+		manager:     nsm.manager,
+		xconManager: nsm.xconManager,
+	})
+
+	go func() {
+		if err := nsm.adminServer.Serve(sock); err != nil {
+			span.Logger().Errorf("Failed to serve NSM admin API: %+v", err)
+		}
+	}()
+	span.Logger().Infof("NSM gRPC admin API Server: %s is operational", sock.Addr().String())
+	return nil
+}
+
+func (srv *nsmAdminServer) ListConnections(ctx context.Context, _ *empty.Empty) (*nsmadmin.ConnectionList, error) {
+	span := spanhelper.FromContext(ctx, "Admin.ListConnections")
+	defer span.Finish()
+
+	result := &nsmadmin.ConnectionList{}
+	for _, cc := range srv.model.GetAllClientConnections() {
+		result.Connections = append(result.Connections, &nsmadmin.ClientConnection{
+			ConnectionId:    cc.GetID(),
+			ConnectionState: nsmadmin.ConnectionState(cc.ConnectionState),
+			ForwarderState:  nsmadmin.ForwarderState(cc.ForwarderState),
+			NetworkService:  cc.GetNetworkService(),
+			ForwarderName:   cc.ForwarderRegisteredName,
+			Xcon:            cc.Xcon,
+			RemoteNsm:       cc.RemoteNsm,
+			Endpoint:        cc.Endpoint,
+		})
+	}
+	span.LogObject("connections", len(result.Connections))
+	return result, nil
+}
+
+func (srv *nsmAdminServer) HealConnection(ctx context.Context, request *nsmadmin.HealRequest) (*empty.Empty, error) {
+	span := spanhelper.FromContext(ctx, "Admin.HealConnection")
+	defer span.Finish()
+	span.LogObject("request", request)
+
+	if request.GetHealState() == nsmadmin.HealState_HEAL_UNKNOWN {
+		err := errors.Errorf("heal state should be specified for connection %s", request.GetConnectionId())
+		span.LogError(err)
+		return nil, err
+	}
+
+	cc := srv.model.GetClientConnection(request.GetConnectionId())
+	if cc == nil {
+		err := errors.Errorf("no connection with id: %s", request.GetConnectionId())
+		span.LogError(err)
+		return nil, err
+	}
+	if cc.ConnectionState != model.ClientConnectionReady {
+		err := errors.Errorf("connection %s could not be healed in state %v", cc.GetID(), nsmadmin.ConnectionState(cc.ConnectionState))
+		span.LogError(err)
+		return nil, err
+	}
+
+	srv.manager.Heal(span.Context(), cc, nsm.HealState(request.GetHealState()))
+	return &empty.Empty{}, nil
+}
+
+func (srv *nsmAdminServer) CloseConnection(ctx context.Context, request *nsmadmin.CloseRequest) (*empty.Empty, error) {
+	span := spanhelper.FromContext(ctx, "Admin.CloseConnection")
+	defer span.Finish()
+	span.LogObject("request", request)
+
+	cc := srv.model.GetClientConnection(request.GetConnectionId())
+	if cc == nil {
+		err := errors.Errorf("no connection with id: %s", request.GetConnectionId())
+		span.LogError(err)
+		return nil, err
+	}
+
+	if err := srv.manager.CloseConnection(span.Context(), cc); err != nil {
+		span.LogError(err)
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (srv *nsmAdminServer) CordonEndpoint(ctx context.Context, request *nsmadmin.CordonRequest) (*empty.Empty, error) {
+	span := spanhelper.FromContext(ctx, "Admin.CordonEndpoint")
+	defer span.Finish()
+	span.LogObject("request", request)
+
+	if request.GetEndpointName() == "" {
+		err := errors.New("endpoint name should be specified")
+		span.LogError(err)
+		return nil, err
+	}
+
+	srv.model.CordonEndpoint(request.GetEndpointName(), request.GetCordon())
+	return &empty.Empty{}, nil
+}
+
+func (srv *nsmAdminServer) DumpForwarders(ctx context.Context, _ *empty.Empty) (*nsmadmin.ForwarderList, error) {
+	span := spanhelper.FromContext(ctx, "Admin.DumpForwarders")
+	defer span.Finish()
+
+	result := &nsmadmin.ForwarderList{}
+	for _, fwd := range srv.model.GetAllForwarders() {
+		// Connections managed by ClientConnectionManager could duplicate ones stored in model.
+		var xcons []*crossconnect.CrossConnect
+		seen := map[string]bool{}
+		for _, cc := range srv.xconManager.GetClientConnectionsByForwarder(fwd.RegisteredName) {
+			if cc.Xcon != nil && !seen[cc.GetID()] {
+				seen[cc.GetID()] = true
+				xcons = append(xcons, cc.Xcon)
+			}
+		}
+		result.Forwarders = append(result.Forwarders, &nsmadmin.Forwarder{
+			Name:                 fwd.RegisteredName,
+			SocketLocation:       fwd.SocketLocation,
+			LocalMechanisms:      fwd.LocalMechanisms,
+			RemoteMechanisms:     fwd.RemoteMechanisms,
+			MechanismsConfigured: fwd.MechanismsConfigured,
+			CrossConnects:        xcons,
+		})
+	}
+	span.LogObject("forwarders", len(result.Forwarders))
+	return result, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nsmd
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// AdminAllowedUIDsEnv is the name of the env variable with a comma separated list of users allowed to use admin API
+	AdminAllowedUIDsEnv = "NSMD_ADMIN_ALLOWED_UIDS"
+	// AdminAllowedUIDsDefault allows only root to use admin API
+	AdminAllowedUIDsDefault = "0"
+
+	peerCredentialsProtocol = "peercred"
+)
+
+// PeerCredentialsInfo is an AuthInfo of admin API connection, holds credentials of a peer process
+type PeerCredentialsInfo struct {
+	Ucred *unix.Ucred
+}
+
+// AuthType returns authentication protocol name
+func (*PeerCredentialsInfo) AuthType() string {
+	return peerCredentialsProtocol
+}
+
+// peerCredentials authenticates unix socket peers by their SO_PEERCRED user id
+type peerCredentials struct {
+	allowedUIDs map[uint32]bool
+}
+
+func newPeerCredentials(allowedUIDs map[uint32]bool) credentials.TransportCredentials {
+	return &peerCredentials{
+		allowedUIDs: allowedUIDs,
+	}
+}
+
+func getAdminAllowedUIDs() (map[uint32]bool, error) {
+	value := strings.TrimSpace(os.Getenv(AdminAllowedUIDsEnv))
+	if value == "" {
+		value = AdminAllowedUIDsDefault
+	}
+	result := map[uint32]bool{}
+	for _, s := range strings.Split(value, ",") {
+		uid, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s value: %s", AdminAllowedUIDsEnv, value)
+		}
+		result[uint32(uid)] = true
+	}
+	return result, nil
+}
+
+func (c *peerCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	unixConn, ok := rawConn.(*net.UnixConn)
+	if !ok {
+		_ = rawConn.Close()
+		return nil, nil, errors.Errorf("admin API is available over unix socket only, got %s connection", rawConn.RemoteAddr().Network())
+	}
+	ucred, err := getPeerCredentials(unixConn)
+	if err != nil {
+		_ = rawConn.Close()
+		return nil, nil, err
+	}
+	if !c.allowedUIDs[ucred.Uid] {
+		_ = rawConn.Close()
+		return nil, nil, errors.Errorf("admin API access denied for uid %d, pid %d", ucred.Uid, ucred.Pid)
+	}
+	return rawConn, &PeerCredentialsInfo{Ucred: ucred}, nil
+}
+
+func (c *peerCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("peer credentials are not supported for clients")
+}
+
+func (c *peerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: peerCredentialsProtocol,
+	}
+}
+
+func (c *peerCredentials) Clone() credentials.TransportCredentials {
+	allowedUIDs := map[uint32]bool{}
+	for uid := range c.allowedUIDs {
+		allowedUIDs[uid] = true
+	}
+	return newPeerCredentials(allowedUIDs)
+}
+
+func (c *peerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+func getPeerCredentials(conn *net.UnixConn) (*unix.Ucred, error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+	var ucred *unix.Ucred
+	var ucredErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		ucred, ucredErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return nil, err
+	}
+	if ucredErr != nil {
+		return nil, errors.Wrap(ucredErr, "failed to get peer credentials")
+	}
+	return ucred, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nsmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools/spanhelper"
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const (
+	// DrainTimeoutEnv is the name of the env variable to configure a deadline of NSMD drain on shutdown
+	DrainTimeoutEnv = utils.EnvVar("NSMD_DRAIN_TIMEOUT")
+	// DrainTimeoutDefault is a default deadline of NSMD drain on shutdown
+	DrainTimeoutDefault = 20 * time.Second
+
+	drainCheckInterval = 100 * time.Millisecond
+)
+
+// Drain prepares NSMD to shutdown: new requests are refused, NSM is marked as draining in registry, so remote peers
+// stop selecting its endpoints, and remote peers are notified their connections are deleted, so they heal proactively.
+// Drain returns once remote peers closed their connections or timeout is passed. Local connections are kept to be
+// restored after restart.
+func (nsm *nsmServer) Drain(ctx context.Context, timeout time.Duration) {
+	span := spanhelper.FromContext(ctx, "Drain")
+	defer span.Finish()
+	span.LogValue("timeout", timeout)
+
+	ctx, cancel := context.WithTimeout(span.Context(), timeout)
+	defer cancel()
+
+	nsm.manager.SetDraining(true)
+
+	if err := nsm.markDraining(ctx); err != nil {
+		span.LogError(err)
+	}
+
+	remoteConnections := nsm.getRemoteSourceConnections()
+	span.LogValue("remote-connections", len(remoteConnections))
+	for _, cc := range remoteConnections {
+		span.Logger().Infof("Drain: notify remote peer connection %v is deleted", cc.GetConnectionSource().GetId())
+		nsm.remoteConnectionMonitor.Delete(ctx, cc.GetConnectionSource())
+	}
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for len(remoteConnections) > 0 {
+		select {
+		case <-ctx.Done():
+			span.Logger().Warnf("Drain: timeout, %d remote connections are still active", len(remoteConnections))
+			return
+		case <-ticker.C:
+			remoteConnections = nsm.getRemoteSourceConnections()
+		}
+	}
+	span.Logger().Infof("Drain: completed")
+}
+
+func (nsm *nsmServer) markDraining(ctx context.Context) error {
+	client, err := nsm.serviceRegistry.NsmRegistryClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RegistryClient")
+	}
+	if _, err := client.RegisterNSM(ctx, &registry.NetworkServiceManager{
+		Url:   nsm.serviceRegistry.GetPublicAPI(),
+		State: registry.NSMStateDraining,
+	}); err != nil {
+		return errors.Wrap(err, "failed to mark NetworkServiceManager as draining")
+	}
+	return nil
+}
+
+func (nsm *nsmServer) getRemoteSourceConnections() []*model.ClientConnection {
+	var result []*model.ClientConnection
+	for _, cc := range nsm.model.GetAllClientConnections() {
+		if cc.Xcon != nil && cc.GetConnectionSource().IsRemote() && cc.ConnectionState != model.ClientConnectionClosing {
+			result = append(result, cc)
+		}
+	}
+	return result
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nsmd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/api/nsm"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
+)
+
+const (
+	leaseCheckInterval = 500 * time.Millisecond
+	refreshTimeout     = 15 * time.Second
+)
+
+// leaseKeeper closes connections sources have not refreshed a lease for and refreshes leases of destinations
+// NSMD has requested.
+type leaseKeeper struct {
+	model   model.Model
+	manager nsm.NetworkServiceManager
+	// started - connections restored after NSMD restart are granted a lease from a start, since a lease stored
+	// in a forwarder cross connect is not updated by refreshes
+	started time.Time
+	// refreshed - when destinations were requested or refreshed last time
+	refreshed     map[string]time.Time
+	refreshedLock sync.Mutex
+	// inProgress - connections being closed or refreshed
+	inProgress sync.Map
+}
+
+func newLeaseKeeper(model model.Model, manager nsm.NetworkServiceManager) *leaseKeeper {
+	return &leaseKeeper{
+		model:     model,
+		manager:   manager,
+		started:   time.Now(),
+		refreshed: map[string]time.Time{},
+	}
+}
+
+func (k *leaseKeeper) run(ctx context.Context) {
+	ticker := time.NewTicker(leaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			k.check(ctx, now)
+		}
+	}
+}
+
+func (k *leaseKeeper) check(ctx context.Context, now time.Time) {
+	k.refreshedLock.Lock()
+	defer k.refreshedLock.Unlock()
+
+	expire := pathtoken.Expire()
+	refreshed := map[string]time.Time{}
+	for _, cc := range k.model.GetAllClientConnections() {
+		if cc.ConnectionState != model.ClientConnectionReady {
+			continue
+		}
+		if k.isLapsed(cc, now, expire) {
+			k.start(ctx, cc, k.closeLapsed)
+			continue
+		}
+
+		dst := cc.GetConnectionDestination()
+		if dst == nil || cc.Endpoint == nil {
+			continue
+		}
+		key := cc.GetID() + "/" + dst.GetId()
+		last, ok := k.refreshed[key]
+		if !ok {
+			// Destination has been just requested
+			last = now
+		}
+		refreshed[key] = last
+		if now.Sub(last) < expire*2/3 {
+			continue
+		}
+		k.start(ctx, cc, func(ctx context.Context, cc *model.ClientConnection) {
+			k.refreshDestination(ctx, cc, key, last.Add(expire))
+		})
+	}
+	k.refreshed = refreshed
+}
+
+func (k *leaseKeeper) isLapsed(cc *model.ClientConnection, now time.Time, expire time.Duration) bool {
+	expires, ok := cc.Request.GetConnection().GetPath().GetExpireTime()
+	if !ok && !common.DefaultLease() {
+		// Connection is requested by a client not aware of leases
+		return false
+	}
+	// Connections stored with no lease are granted a default one from a start
+	if restored := k.started.Add(expire); !ok || expires.Before(restored) {
+		expires = restored
+	}
+	return now.After(expires)
+}
+
+// start runs action for a connection unless another action is in progress for it
+func (k *leaseKeeper) start(ctx context.Context, cc *model.ClientConnection, action func(context.Context, *model.ClientConnection)) {
+	if _, loaded := k.inProgress.LoadOrStore(cc.GetID(), true); loaded {
+		return
+	}
+	go func() {
+		defer k.inProgress.Delete(cc.GetID())
+		action(ctx, cc)
+	}()
+}
+
+func (k *leaseKeeper) closeLapsed(ctx context.Context, cc *model.ClientConnection) {
+	span := common.SpanHelperFromConnection(ctx, cc, "CloseLapsed")
+	defer span.Finish()
+	span.Logger().Infof("Lease of connection %s has lapsed, closing", cc.GetID())
+
+	if err := k.manager.CloseConnection(span.Context(), cc); err != nil {
+		span.LogError(err)
+	}
+}
+
+// refreshDestination requests destination again to refresh its lease, heals connection if destination is changed or
+// lease has lapsed
+func (k *leaseKeeper) refreshDestination(ctx context.Context, cc *model.ClientConnection, key string, lapses time.Time) {
+	span := common.SpanHelperFromConnection(ctx, cc, "RefreshDestination")
+	defer span.Finish()
+	ctx = span.Context()
+
+	dst, err := k.requestDestination(ctx, cc)
+	if err != nil {
+		span.LogError(err)
+		if time.Now().After(lapses) {
+			span.Logger().Warnf("Lease of destination %s has lapsed, healing", cc.GetConnectionDestination().GetId())
+			k.manager.Heal(ctx, cc, nsm.HealStateDstDown)
+		}
+		return
+	}
+	if !isSameDestination(cc.GetConnectionDestination(), dst) {
+		span.LogObject("destination", dst)
+		span.Logger().Infof("Destination %s is changed, healing", dst.GetId())
+		k.manager.Heal(ctx, cc, nsm.HealStateDstUpdate)
+		return
+	}
+	k.refreshedLock.Lock()
+	defer k.refreshedLock.Unlock()
+	k.refreshed[key] = time.Now()
+}
+
+func (k *leaseKeeper) requestDestination(ctx context.Context, cc *model.ClientConnection) (*connection.Connection, error) {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	dst := cc.GetConnectionDestination().Clone()
+	dst.Path = common.Strings2Path(k.model.GetNsm().GetName())
+	if !k.manager.NseManager().IsLocalEndpoint(cc.Endpoint) {
+		dst.Path = common.AppendStrings2Path(dst.Path, cc.Endpoint.GetNetworkServiceManager().GetName())
+	}
+	request := &networkservice.NetworkServiceRequest{
+		Connection: dst,
+		MechanismPreferences: []*connection.Mechanism{
+			dst.GetMechanism(),
+		},
+	}
+	if err := common.SignOutgoingRequest(ctx, cc.Request, request); err != nil {
+		return nil, errors.Wrap(err, "failed to sign refresh request")
+	}
+
+	client, err := k.manager.NseManager().CreateNSEClient(ctx, cc.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create NSE client")
+	}
+	defer func() {
+		_ = client.Cleanup()
+	}()
+	return client.Request(ctx, request)
+}
+
+// isSameDestination returns if refreshed destination is not changed, mechanism parameters added by NSMD are ignored
+func isSameDestination(dst, refreshed *connection.Connection) bool {
+	if dst.GetId() != refreshed.GetId() ||
+		dst.GetMechanism().GetType() != refreshed.GetMechanism().GetType() ||
+		!proto.Equal(dst.GetContext(), refreshed.GetContext()) {
+		return false
+	}
+	for key, value := range refreshed.GetMechanism().GetParameters() {
+		if dst.GetMechanism().GetParameters()[key] != value {
+			return false
+		}
+	}
+	return true
+}
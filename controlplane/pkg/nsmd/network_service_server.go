@@ -5,7 +5,9 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/api/nsm"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/local"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/metrics"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 )
 
 // NewNetworkServiceServer - construct a local network service chain
@@ -13,11 +15,18 @@ func NewNetworkServiceServer(model model.Model, ws *Workspace,
 	nsmManager nsm.NetworkServiceManager) networkservice.NetworkServiceServer {
 	return common.NewCompositeService("Local",
 		common.NewRequestValidator(),
+		common.NewMetricsService(model, metrics.LocalScope),
+		common.NewPathVerifierService(tools.GetConfig().SecurityProvider),
+		common.NewDrainService(nsmManager),
 		common.NewMonitorService(ws.MonitorConnectionServer()),
 		local.NewWorkspaceService(ws.Name()),
+		local.NewPodEventService(nsmManager),
+		common.NewLeaseService(model),
+		local.NewAdmissionService(model, ws),
 		local.NewConnectionService(model),
 		local.NewForwarderService(model, nsmManager.ServiceRegistry()),
 		local.NewEndpointSelectorService(nsmManager.NseManager()),
+		local.NewContextConflictService(model),
 		common.NewExcludedPrefixesService(),
 		local.NewEndpointService(nsmManager.NseManager(), nsmManager.GetHealProperties(), nsmManager.Model()),
 		common.NewCrossConnectService(),
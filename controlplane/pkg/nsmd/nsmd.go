@@ -14,6 +14,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -29,6 +30,7 @@ import (
 	unified "github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/limits"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nseregistry"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/serviceregistry"
@@ -49,6 +51,8 @@ type NSMServer interface {
 	Stop()
 	StartForwarderRegistratorServer(ctx context.Context) error
 	StartAPIServerAt(ctx context.Context, sock net.Listener, probes probes.Probes)
+	StartAdminServerAt(ctx context.Context, sock net.Listener) error
+	Drain(ctx context.Context, timeout time.Duration)
 
 	XconManager() *services.ClientConnectionManager
 	Manager() nsm.NetworkServiceManager
@@ -68,6 +72,9 @@ type nsmServer struct {
 	registerServer   *grpc.Server
 	registerSock     net.Listener
 	regServer        *ForwarderRegistrarServer
+	adminServer      *grpc.Server
+	limitsConfig     *limits.Config
+	cancelLeases     context.CancelFunc
 
 	xconManager             *services.ClientConnectionManager
 	crossConnectMonitor     monitor_crossconnect.MonitorServer
@@ -101,8 +108,8 @@ func (nsm *nsmServer) RemoteConnectionMonitor() monitor.Server {
 	return nsm.remoteConnectionMonitor
 }
 
-// RequestWorkspace - request a workspace
-func RequestWorkspace(ctx context.Context, serviceRegistry serviceregistry.ServiceRegistry, id string) (*nsmdapi.ClientConnectionReply, error) {
+// RequestWorkspace - request a workspace for a workload described by metadata
+func RequestWorkspace(ctx context.Context, serviceRegistry serviceregistry.ServiceRegistry, id string, metadata *nsmdapi.WorkspaceMetadata) (*nsmdapi.ClientConnectionReply, error) {
 	span := spanhelper.FromContext(ctx, "RequestWorkspace")
 	defer span.Finish()
 	client, con, err := serviceRegistry.NSMDApiClient(span.Context())
@@ -111,7 +118,10 @@ func RequestWorkspace(ctx context.Context, serviceRegistry serviceregistry.Servi
 	}
 	defer con.Close()
 
-	reply, err := client.RequestClientConnection(ctx, &nsmdapi.ClientConnectionRequest{Workspace: id})
+	reply, err := client.RequestClientConnection(ctx, &nsmdapi.ClientConnectionRequest{
+		Workspace: id,
+		Metadata:  metadata,
+	})
 	span.LogError(err)
 	if err != nil {
 		return nil, err
@@ -132,6 +142,8 @@ func (nsm *nsmServer) RequestClientConnection(ctx context.Context, request *nsmd
 		span.LogError(err)
 		return nil, err
 	}
+	workspace.SetMetadata(request.GetMetadata())
+	span.LogObject("metadata", request.GetMetadata())
 	span.Logger().Infof("New workspace created: %+v", workspace)
 
 	err = nsm.localRegistry.AppendClientRequest(workspace.Name())
@@ -139,6 +151,11 @@ func (nsm *nsmServer) RequestClientConnection(ctx context.Context, request *nsmd
 		span.LogError(errors.Wrap(err, "failed to store Client information into local registry: %v"))
 		return nil, err
 	}
+	if request.GetMetadata() != nil {
+		if err = nsm.localRegistry.AppendClientMetadata(workspace.Name(), request.GetMetadata()); err != nil {
+			span.LogError(errors.Wrap(err, "failed to store Client metadata into local registry"))
+		}
+	}
 	nsm.Lock()
 	nsm.workspaces[workspace.Name()] = workspace
 	nsm.Unlock()
@@ -177,16 +194,65 @@ func (nsm *nsmServer) DeleteClientConnection(context context.Context, request *n
 	return &nsmdapi.DeleteConnectionReply{}, nil
 }
 
+func (nsm *nsmServer) UpdateClientConnection(ctx context.Context, request *nsmdapi.UpdateConnectionRequest) (*nsmdapi.UpdateConnectionReply, error) {
+	span := spanhelper.FromContext(ctx, "UpdateClientConnection")
+	defer span.Finish()
+	span.LogObject("request", request)
+
+	nsm.Lock()
+	workspace, ok := nsm.workspaces[request.GetWorkspace()]
+	nsm.Unlock()
+	if !ok {
+		err := errors.Errorf("no connection exists for workspace %s", request.GetWorkspace())
+		span.LogError(err)
+		return nil, err
+	}
+
+	workspace.SetMetadata(request.GetMetadata())
+	if err := nsm.localRegistry.AppendClientMetadata(workspace.Name(), request.GetMetadata()); err != nil {
+		span.LogError(err)
+		return nil, errors.Wrap(err, "failed to store Client metadata into local registry")
+	}
+	return &nsmdapi.UpdateConnectionReply{}, nil
+}
+
 func (nsm *nsmServer) EnumConnection(context context.Context, request *nsmdapi.EnumConnectionRequest) (*nsmdapi.EnumConnectionReply, error) {
 	nsm.Lock()
 	defer nsm.Unlock()
-	workspaces := []string{}
-	for w := range nsm.workspaces {
-		if len(w) > 0 {
-			workspaces = append(workspaces, w)
+
+	connections := map[string]uint32{}
+	for _, cc := range nsm.model.GetAllClientConnections() {
+		if cc.ConnectionState == model.ClientConnectionClosing || cc.ConnectionState == model.ClientConnectionBroken {
+			continue
+		}
+		if workspace := cc.GetWorkspace(); workspace != "" {
+			connections[workspace]++
 		}
 	}
-	return &nsmdapi.EnumConnectionReply{Workspace: workspaces}, nil
+	endpoints := map[string]uint32{}
+	for _, endpoint := range nsm.model.GetAllEndpoints() {
+		endpoints[endpoint.Workspace]++
+	}
+
+	reply := &nsmdapi.EnumConnectionReply{}
+	for name, w := range nsm.workspaces {
+		if len(name) == 0 {
+			continue
+		}
+		reply.Workspace = append(reply.Workspace, name)
+		creationTime, err := ptypes.TimestampProto(w.CreationTime())
+		if err != nil {
+			return nil, err
+		}
+		reply.Workspaces = append(reply.Workspaces, &nsmdapi.WorkspaceInfo{
+			Name:                name,
+			Metadata:            w.Metadata(),
+			CreationTime:        creationTime,
+			ActiveConnections:   connections[name],
+			RegisteredEndpoints: endpoints[name],
+		})
+	}
+	return reply, nil
 }
 
 func (nsm *nsmServer) restore(ctx context.Context, registeredEndpointsList *registry.NetworkServiceEndpointList) {
@@ -206,7 +272,13 @@ func (nsm *nsmServer) restore(ctx context.Context, registeredEndpointsList *regi
 		return
 	}
 
+	metadata, err := nsm.localRegistry.LoadClientMetadata()
+	if err != nil {
+		span.LogError(errors.Wrap(err, "error loading stored client metadata"))
+	}
+
 	span.LogObject("clients", clients)
+	span.LogObject("metadata", metadata)
 	span.LogObject("endpoints", nses)
 
 	registeredNSEs := map[string]string{}
@@ -214,7 +286,7 @@ func (nsm *nsmServer) restore(ctx context.Context, registeredEndpointsList *regi
 		registeredNSEs[endpoint.GetName()] = endpoint.GetNetworkServiceName()
 	}
 
-	updatedClients := nsm.restoreClients(span.Context(), clients)
+	updatedClients := nsm.restoreClients(span.Context(), clients, metadata)
 	span.LogObject("updated-clients", updatedClients)
 	updatedEndpoints, err := nsm.restoreEndpoints(span.Context(), nses, registeredNSEs)
 	span.LogObject("updated-endpoints", updatedEndpoints)
@@ -233,7 +305,7 @@ func (nsm *nsmServer) restore(ctx context.Context, registeredEndpointsList *regi
 	span.Logger().Info("NSMD: Restore of NSE/Clients Complete...")
 }
 
-func (nsm *nsmServer) restoreClients(ctx context.Context, clients []string) []string {
+func (nsm *nsmServer) restoreClients(ctx context.Context, clients []string, metadata map[string]*nsmdapi.WorkspaceMetadata) []string {
 	nsm.Lock()
 	defer nsm.Unlock()
 
@@ -253,6 +325,7 @@ func (nsm *nsmServer) restoreClients(ctx context.Context, clients []string) []st
 			span.LogError(errors.Wrapf(err, "error NSMServer: Failed to create workspace %s. Ignoring", client))
 			continue
 		}
+		workspace.SetMetadata(metadata[client])
 		nsm.workspaces[workspace.Name()] = workspace
 		updatedClients = append(updatedClients, client)
 		span.Logger().Infof("Workspace for client %v created", client)
@@ -433,6 +506,12 @@ func (nsm *nsmServer) Stop() {
 	if nsm.regServer != nil {
 		nsm.regServer.Stop()
 	}
+	if nsm.adminServer != nil {
+		nsm.adminServer.Stop()
+	}
+	if nsm.cancelLeases != nil {
+		nsm.cancelLeases()
+	}
 }
 
 // StartNSMServer registers and starts gRPC server which is listening for
@@ -447,9 +526,16 @@ func StartNSMServer(ctx context.Context, model model.Model, manager nsm.NetworkS
 		return nil, err
 	}
 
+	limitsConfig, err := limits.NewConfigFromEnv()
+	if err != nil {
+		span.LogError(err)
+		return nil, err
+	}
+
 	locationProvider := manager.ServiceRegistry().NewWorkspaceProvider()
 
 	nsm := createNsmServer(model, manager, locationProvider)
+	nsm.limitsConfig = limitsConfig
 
 	span.Logger().Infof("Starting NSM server")
 
@@ -489,10 +575,16 @@ func StartNSMServer(ctx context.Context, model model.Model, manager nsm.NetworkS
 
 	nsm.remoteServer = remote.NewRemoteNetworkServiceServer(nsm.manager, nsm.remoteConnectionMonitor)
 	nsm.manager.SetRemoteServer(nsm.remoteServer)
+	nsm.manager.SetPodEventRecorder(newPodEventRecorder(nsm))
 
 	// Restore existing clients in case of NSMd restart.
 	nsm.restore(span.Context(), endpoints)
 
+	// Close connections with lapsed leases and refresh leases of destinations
+	var leaseCtx context.Context
+	leaseCtx, nsm.cancelLeases = context.WithCancel(context.Background())
+	go newLeaseKeeper(nsm.model, nsm.manager).run(leaseCtx)
+
 	return nsm, nil
 }
 
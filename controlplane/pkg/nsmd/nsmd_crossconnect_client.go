@@ -54,6 +54,8 @@ const (
 	endpointConnectionTimeout = 10 * time.Second
 	eventConnectionTimeout    = 30 * time.Second
 
+	monitorReconnectAttempts = 1
+
 	peerName     = "peerName"
 	endpointName = "endpointName"
 )
@@ -217,11 +219,13 @@ func (client *NsmMonitorCrossConnectClient) ClientConnectionDeleted(ctx context.
 }
 
 type grpcConnectionSupplier func() (*grpc.ClientConn, error)
-type monitorClientSupplier func(conn *grpc.ClientConn) (monitor.Client, error)
+type monitorClientSupplier func(conn *grpc.ClientConn, revision uint64) (monitor.Client, error)
 
 type entityHandler func(entity monitor.Entity, eventType monitor.EventType, parameters map[string]string) error
 type eventHandler func(event monitor.Event, parameters map[string]string) error
 
+// monitor runs a monitor stream and, if an established stream breaks, reconnects once resuming from the last
+// received revision, so a short connection blip doesn't cost a full state transfer.
 func (client *NsmMonitorCrossConnectClient) monitor(
 	ctx context.Context,
 	logFormat, logWithParamFormat, name string,
@@ -229,18 +233,34 @@ func (client *NsmMonitorCrossConnectClient) monitor(
 	entityHandler entityHandler, eventHandler eventHandler, parameters map[string]string) error {
 	logrus.Infof(logFormat, name, "Added")
 
+	var revision uint64
+	established, err := client.monitorStream(ctx, logFormat, logWithParamFormat, name,
+		grpcConnectionSupplier, monitorClientSupplier, entityHandler, eventHandler, parameters, &revision)
+	for attempt := 0; err != nil && established && attempt < monitorReconnectAttempts; attempt++ {
+		logrus.Infof(logWithParamFormat, name, "Reconnecting from revision", revision)
+		established, err = client.monitorStream(ctx, logFormat, logWithParamFormat, name,
+			grpcConnectionSupplier, monitorClientSupplier, entityHandler, eventHandler, parameters, &revision)
+	}
+	return err
+}
+
+func (client *NsmMonitorCrossConnectClient) monitorStream(
+	ctx context.Context,
+	logFormat, logWithParamFormat, name string,
+	grpcConnectionSupplier grpcConnectionSupplier, monitorClientSupplier monitorClientSupplier,
+	entityHandler entityHandler, eventHandler eventHandler, parameters map[string]string, revision *uint64) (bool, error) {
 	conn, err := grpcConnectionSupplier()
 	if err != nil {
 		logrus.Errorf(logWithParamFormat, name, "Failed to connect", err)
-		return err
+		return false, err
 	}
 	logrus.Infof(logFormat, name, "Connected")
 	defer func() { _ = conn.Close() }()
 
-	monitorClient, err := monitorClientSupplier(conn)
+	monitorClient, err := monitorClientSupplier(conn, *revision)
 	if err != nil {
 		logrus.Errorf(logWithParamFormat, name, "Failed to start monitor", err)
-		return err
+		return false, err
 	}
 	logrus.Infof(logFormat, name, "Started monitor")
 	defer monitorClient.Close()
@@ -249,16 +269,19 @@ func (client *NsmMonitorCrossConnectClient) monitor(
 		select {
 		case <-ctx.Done():
 			logrus.Infof(logFormat, name, "Removed")
-			return nil
+			return true, nil
 		case err = <-monitorClient.ErrorChannel():
 			logrus.Errorf(logWithParamFormat, name, "Connection closed", err)
-			return err
+			return true, err
 		case event := <-monitorClient.EventChannel():
 			logrus.Infof(logWithParamFormat, name, "Received event", event)
 			if event == nil {
 				logrus.Info(logFormat, name, "Skip nil event")
 				continue
 			}
+			if event.Revision() != 0 {
+				*revision = event.Revision()
+			}
 			for _, entity := range event.Entities() {
 				if err = entityHandler(entity, event.EventType(), parameters); err != nil {
 					logrus.Errorf(logWithParamFormat, name, "Error handling entity", err)
@@ -281,13 +304,14 @@ func (client *NsmMonitorCrossConnectClient) endpointConnectionMonitor(ctx contex
 		return client.connectToEndpoint(endpoint)
 	}
 
-	monFunc := func(cc *grpc.ClientConn) (monitor.Client, error) {
+	monFunc := func(cc *grpc.ClientConn, revision uint64) (monitor.Client, error) {
 		return connectionMonitor.NewMonitorClient(cc, &connection.MonitorScopeSelector{
 			PathSegments: []*connection.PathSegment{
 				{
 					Name: client.model.GetNsm().GetName(),
 				},
 			},
+			ResumeFromRevision: revision,
 		})
 	}
 
@@ -365,7 +389,7 @@ func (client *NsmMonitorCrossConnectClient) forwarderCrossConnectMonitor(ctx con
 	_ = client.monitor(
 		span.Context(),
 		forwarderLogFormat, forwarderLogWithParamFormat, forwarder.RegisteredName,
-		grpcConnectionSupplier, monitor_crossconnect.NewMonitorClient,
+		grpcConnectionSupplier, monitor_crossconnect.ResumeMonitorClient,
 		client.handleXcon, eventHandler, nil)
 }
 
@@ -438,7 +462,7 @@ func (client *NsmMonitorCrossConnectClient) remotePeerConnectionMonitor(ctx cont
 		span.Logger().Infof(peerLogWithParamFormat, remoteNsm.Name, "Connecting to", remoteNsm.Url)
 		return tools.DialContextTCP(span.Context(), remoteNsm.GetUrl())
 	}
-	monitorClientSupplier := func(conn *grpc.ClientConn) (monitor.Client, error) {
+	monitorClientSupplier := func(conn *grpc.ClientConn, revision uint64) (monitor.Client, error) {
 		return connectionMonitor.NewMonitorClient(conn, &connection.MonitorScopeSelector{
 			PathSegments: []*connection.PathSegment{
 				{
@@ -448,6 +472,7 @@ func (client *NsmMonitorCrossConnectClient) remotePeerConnectionMonitor(ctx cont
 					Name: remoteNsm.Name, // dst
 				},
 			},
+			ResumeFromRevision: revision,
 		})
 	}
 
@@ -111,3 +111,10 @@ func (t *testEvent) Message() (interface{}, error) {
 func (*testEvent) Context() context.Context {
 	return context.Background()
 }
+
+func (*testEvent) Revision() uint64 {
+	return 0
+}
+
+func (*testEvent) SetRevision(uint64) {
+}
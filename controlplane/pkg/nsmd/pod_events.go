@@ -0,0 +1,116 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nsmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+)
+
+const (
+	podEventTimeout = 5 * time.Second
+	// podResolveInterval - workspace pod is resolved by nsmdp shortly after allocation, so recording waits for it
+	podResolveInterval = 200 * time.Millisecond
+)
+
+// podEventRecorder - sends pod events to the registry, events are recorded against pods of local workspaces or
+// against pods endpoints are labeled with
+type podEventRecorder struct {
+	nsm *nsmServer
+}
+
+func newPodEventRecorder(nsm *nsmServer) *podEventRecorder {
+	return &podEventRecorder{
+		nsm: nsm,
+	}
+}
+
+func (r *podEventRecorder) RecordWorkspaceEvent(workspace, reason, message string) {
+	go func() {
+		metadata := r.resolveWorkspaceMetadata(workspace)
+		if metadata.GetPodName() == "" {
+			logrus.Warnf("Workspace %v has no pod to record %v event against, event dropped: %v", workspace, reason, message)
+			return
+		}
+		r.send(&registry.PodEvent{
+			PodName:   metadata.GetPodName(),
+			Namespace: metadata.GetNamespace(),
+			PodUid:    metadata.GetPodUid(),
+			Reason:    reason,
+			Message:   message,
+		})
+	}()
+}
+
+func (r *podEventRecorder) RecordEndpointEvent(endpoint *registry.NSERegistration, reason, message string) {
+	nse := endpoint.GetNetworkServiceEndpoint()
+	if local := r.nsm.model.GetEndpoint(nse.GetName()); local != nil {
+		r.RecordWorkspaceEvent(local.Workspace, reason, message)
+		return
+	}
+	labels := nse.GetLabels()
+	if labels[connection.PodNameKey] == "" || labels[connection.NamespaceKey] == "" {
+		logrus.Debugf("Endpoint %v has no pod to record %v event against", nse.GetName(), reason)
+		return
+	}
+	go r.send(&registry.PodEvent{
+		PodName:   labels[connection.PodNameKey],
+		Namespace: labels[connection.NamespaceKey],
+		Reason:    reason,
+		Message:   message,
+	})
+}
+
+// resolveWorkspaceMetadata - waits up to podEventTimeout for a pod of workspace to be known
+func (r *podEventRecorder) resolveWorkspaceMetadata(workspace string) *nsmdapi.WorkspaceMetadata {
+	for deadline := time.Now().Add(podEventTimeout); ; <-time.After(podResolveInterval) {
+		metadata := r.workspaceMetadata(workspace)
+		if metadata.GetPodName() != "" || time.Now().After(deadline) {
+			return metadata
+		}
+	}
+}
+
+func (r *podEventRecorder) workspaceMetadata(workspace string) *nsmdapi.WorkspaceMetadata {
+	r.nsm.Lock()
+	ws := r.nsm.workspaces[workspace]
+	r.nsm.Unlock()
+	if ws == nil {
+		return nil
+	}
+	return ws.Metadata()
+}
+
+// send - events are sent in background, so failed requests and heals are not delayed by the registry
+func (r *podEventRecorder) send(event *registry.PodEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), podEventTimeout)
+	defer cancel()
+
+	client, err := r.nsm.serviceRegistry.PodEventRecorderClient(ctx)
+	if err == nil {
+		_, err = client.RecordPodEvent(ctx, event)
+	}
+	if err != nil {
+		logrus.Warnf("Failed to record %v event of pod %v/%v: %v", event.GetReason(), event.GetNamespace(), event.GetPodName(), err)
+	}
+}
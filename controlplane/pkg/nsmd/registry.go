@@ -62,6 +62,11 @@ func (es *registryServer) RegisterNSE(ctx context.Context, request *registry.NSE
 	defer span.Finish()
 	span.Logger().Infof("Received RegisterNSE request: %v", request)
 
+	if err := es.workspace.Limiter().AllowEndpoint(es.workspace.Name(), es.registeredEndpoints(request)); err != nil {
+		span.LogError(err)
+		return nil, err
+	}
+
 	// Check if there is already Network Service Endpoint object with the same name, if there is
 	// success will be returned to NSE, since it is a case of NSE pod coming back up.
 	client, err := es.nsm.serviceRegistry.NseRegistryClient(span.Context())
@@ -124,6 +129,17 @@ func (es *registryServer) RegisterNSEWithClient(ctx context.Context, request *re
 	return registration, nil
 }
 
+// registeredEndpoints - returns an amount of endpoints registered from workspace, excluding re-registered one
+func (es *registryServer) registeredEndpoints(request *registry.NSERegistration) int {
+	registered := 0
+	for _, endpoint := range es.nsm.model.GetAllEndpoints() {
+		if endpoint.Workspace == es.workspace.Name() && endpoint.EndpointName() != request.GetNetworkServiceEndpoint().GetName() {
+			registered++
+		}
+	}
+	return registered
+}
+
 func (es *registryServer) BulkRegisterNSE(srv registry.NetworkServiceRegistry_BulkRegisterNSEServer) error {
 	<-srv.Context().Done()
 	return nil
@@ -19,8 +19,10 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/metrics"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/serviceregistry"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/port"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/sid"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/vni"
 	forwarderapi "github.com/networkservicemesh/networkservicemesh/forwarder/api/forwarder"
@@ -50,6 +52,14 @@ func (*apiRegistry) NewNSMServerListener() (net.Listener, error) {
 	return net.Listen("unix", ServerSock)
 }
 
+func (*apiRegistry) NewAdminListener() (net.Listener, error) {
+	logrus.Infof("Starting NSM admin gRPC server listening on socket: %s", AdminSock)
+	if err := tools.SocketCleanup(AdminSock); err != nil {
+		return nil, err
+	}
+	return net.Listen("unix", AdminSock)
+}
+
 func NewApiRegistry() serviceregistry.ApiRegistry {
 	return &apiRegistry{}
 }
@@ -60,6 +70,7 @@ type nsmdServiceRegistry struct {
 	stopRedial               bool
 	vniAllocator             vni.VniAllocator
 	sidAllocator             sid.Allocator
+	portAllocator            port.Allocator
 	registryAddress          string
 }
 
@@ -146,6 +157,20 @@ func (impl *nsmdServiceRegistry) NsmRegistryClient(ctx context.Context) (registr
 	return nil, errors.New("Connection to Network Registry Server is not available")
 }
 
+func (impl *nsmdServiceRegistry) PodEventRecorderClient(ctx context.Context) (registry.PodEventRecorderClient, error) {
+	impl.RWMutex.Lock()
+	defer impl.RWMutex.Unlock()
+
+	logrus.Info("Requesting PodEventRecorderClient...")
+	ctx, cancel := context.WithTimeout(ctx, registryConnectTimeout)
+	defer cancel()
+	impl.initRegistryClient(ctx)
+	if impl.registryClientConnection != nil {
+		return registry.NewPodEventRecorderClient(impl.registryClientConnection), nil
+	}
+	return nil, errors.New("Connection to Network Registry Server is not available")
+}
+
 func (impl *nsmdServiceRegistry) GetPublicAPI() string {
 	return GetLocalIPAddress() + ":5001"
 }
@@ -185,7 +210,8 @@ func (impl *nsmdServiceRegistry) initRegistryClient(ctx context.Context) {
 		}
 		span.Logger().Println("Registry port now available, attempting to connect...")
 
-		conn, err := tools.DialContextTCP(span.Context(), impl.registryAddress)
+		conn, err := tools.DialContextTCP(span.Context(), impl.registryAddress,
+			grpc.WithChainUnaryInterceptor(metrics.RegistryCallInterceptor()))
 		if err != nil {
 			span.Logger().Errorf("Failed to dial Network Service Registry at %s: %s", impl.registryAddress, err)
 			continue
@@ -224,6 +250,7 @@ func NewServiceRegistryAt(nsmAddress string) serviceregistry.ServiceRegistry {
 		stopRedial:      true,
 		vniAllocator:    vni.NewVniAllocator(),
 		sidAllocator:    sid.NewSIDAllocator(),
+		portAllocator:   port.NewPortAllocator(),
 		registryAddress: nsmAddress,
 	}
 }
@@ -258,6 +285,10 @@ func (impl *nsmdServiceRegistry) SIDAllocator() sid.Allocator {
 	return impl.sidAllocator
 }
 
+func (impl *nsmdServiceRegistry) PortAllocator() port.Allocator {
+	return impl.portAllocator
+}
+
 type defaultWorkspaceProvider struct {
 	hostBaseDir     string
 	nsmBaseDir      string
@@ -31,7 +31,9 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	unified "github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/limits"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nseregistry"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/serviceregistry"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
@@ -58,6 +60,10 @@ type Workspace struct {
 	localRegistry    *nseregistry.NSERegistry
 	ctx              context.Context
 	discoveryServer  registry.NetworkServiceDiscoveryServer
+	metadata         *nsmdapi.WorkspaceMetadata
+	creationTime     time.Time
+	limitsConfig     *limits.Config
+	limiter          *limits.Limiter
 }
 
 // NewWorkSpace - constructs a new workspace.
@@ -71,6 +77,9 @@ func NewWorkSpace(ctx context.Context, nsm *nsmServer, name string, restore bool
 		state:            NEW,
 		localRegistry:    nsm.localRegistry,
 		ctx:              span.Context(),
+		creationTime:     time.Now(),
+		limitsConfig:     nsm.limitsConfig,
+		limiter:          limits.NewLimiter(nsm.limitsConfig.ForNamespace("")),
 	}
 	defer w.cleanup() // Cleans up if and only iff we are not in state RUNNING
 	span.LogValue("restore", restore)
@@ -163,6 +172,35 @@ func (w *Workspace) NsmClientSocket() string {
 	return w.NsmDirectory() + "/" + w.locationProvider.NsmClientSocket()
 }
 
+// Metadata returns a description of a workload workspace is allocated for
+func (w *Workspace) Metadata() *nsmdapi.WorkspaceMetadata {
+	w.Lock()
+	defer w.Unlock()
+	return w.metadata
+}
+
+// SetMetadata updates a description of a workload workspace is allocated for
+func (w *Workspace) SetMetadata(metadata *nsmdapi.WorkspaceMetadata) {
+	w.Lock()
+	defer w.Unlock()
+	if w.metadata.GetNamespace() != metadata.GetNamespace() {
+		w.limiter = limits.NewLimiter(w.limitsConfig.ForNamespace(metadata.GetNamespace()))
+	}
+	w.metadata = metadata
+}
+
+// Limiter returns admission limiter of workspace, depends on namespace of a workload
+func (w *Workspace) Limiter() *limits.Limiter {
+	w.Lock()
+	defer w.Unlock()
+	return w.limiter
+}
+
+// CreationTime returns a time workspace was created
+func (w *Workspace) CreationTime() time.Time {
+	return w.creationTime
+}
+
 // MonitorConnectionServer returns workspace.monitorConnectionServer
 func (w *Workspace) MonitorConnectionServer() connectionMonitor.MonitorServer {
 	if w == nil {
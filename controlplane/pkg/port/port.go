@@ -0,0 +1,133 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package port - allocates UDP ports forwarders listen on for tunnels of remote connections
+package port
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const (
+	// DefaultFirstPort - first port allocated by default, ports above the default Linux ephemeral port range
+	// 32768-60999 are allocated, so they do not collide with ports of outgoing connections of the node
+	DefaultFirstPort = 61000
+	// DefaultLastPort - last port allocated by default
+	DefaultLastPort = 65535
+)
+
+var (
+	// FirstPortEnv - environment variable name - first port allocated
+	FirstPortEnv = utils.EnvVar("NSMD_TUNNEL_FIRST_PORT")
+	// LastPortEnv - environment variable name - last port allocated
+	LastPortEnv = utils.EnvVar("NSMD_TUNNEL_LAST_PORT")
+)
+
+// Allocator - allocates a port per connection, ports are unique within a node
+type Allocator interface {
+	// Port - returns a port allocated for connection, allocates a new one if there is no such port
+	Port(connectionID string) int
+	// Allocated - returns if there is a port allocated for connection
+	Allocated(connectionID string) bool
+	// Restore - marks port as allocated for connection
+	Restore(connectionID string, port int)
+	// Release - releases port allocated for connection
+	Release(connectionID string)
+}
+
+type portAllocator struct {
+	ports     map[string]int
+	allocated map[int]string
+	firstPort int
+	lastPort  int
+	// nextPort - last allocated port, allocation continues after it
+	nextPort int
+	sync.Mutex
+}
+
+// NewPortAllocator - creates a new port allocator of a range configured with FirstPortEnv and LastPortEnv
+func NewPortAllocator() Allocator {
+	firstPort := FirstPortEnv.GetIntOrDefault(DefaultFirstPort)
+	lastPort := LastPortEnv.GetIntOrDefault(DefaultLastPort)
+	if firstPort <= 0 || lastPort > 65535 || firstPort > lastPort {
+		logrus.Errorf("Invalid port range %d-%d, using %d-%d", firstPort, lastPort, DefaultFirstPort, DefaultLastPort)
+		firstPort, lastPort = DefaultFirstPort, DefaultLastPort
+	}
+	return NewPortAllocatorOf(firstPort, lastPort)
+}
+
+// NewPortAllocatorOf - creates a new port allocator of a range from firstPort to lastPort
+func NewPortAllocatorOf(firstPort, lastPort int) Allocator {
+	return &portAllocator{
+		ports:     map[string]int{},
+		allocated: map[int]string{},
+		firstPort: firstPort,
+		lastPort:  lastPort,
+		nextPort:  lastPort,
+	}
+}
+
+func (a *portAllocator) Port(connectionID string) int {
+	a.Lock()
+	defer a.Unlock()
+
+	if port, ok := a.ports[connectionID]; ok {
+		return port
+	}
+	// Ports are allocated round robin, so a released port is not reused by a next connection right away
+	for i := a.firstPort; i <= a.lastPort; i++ {
+		if a.nextPort++; a.nextPort > a.lastPort {
+			a.nextPort = a.firstPort
+		}
+		if _, ok := a.allocated[a.nextPort]; !ok {
+			a.ports[connectionID] = a.nextPort
+			a.allocated[a.nextPort] = connectionID
+			return a.nextPort
+		}
+	}
+	return 0
+}
+
+func (a *portAllocator) Allocated(connectionID string) bool {
+	a.Lock()
+	defer a.Unlock()
+
+	_, ok := a.ports[connectionID]
+	return ok
+}
+
+func (a *portAllocator) Restore(connectionID string, port int) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.ports[connectionID] = port
+	a.allocated[port] = connectionID
+	a.nextPort = port
+}
+
+func (a *portAllocator) Release(connectionID string) {
+	a.Lock()
+	defer a.Unlock()
+
+	if port, ok := a.ports[connectionID]; ok {
+		delete(a.ports, connectionID)
+		delete(a.allocated, port)
+	}
+}
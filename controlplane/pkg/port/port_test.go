@@ -0,0 +1,22 @@
+package port
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPortAllocatorRange(t *testing.T) {
+	g := NewWithT(t)
+
+	allocator := NewPortAllocatorOf(1000, 1001)
+	g.Expect(allocator.Port("1")).To(Equal(1000))
+	g.Expect(allocator.Port("2")).To(Equal(1001))
+	g.Expect(allocator.Port("1")).To(Equal(1000))
+	g.Expect(allocator.Port("3")).To(Equal(0))
+
+	g.Expect(allocator.Allocated("2")).To(BeTrue())
+	allocator.Release("2")
+	g.Expect(allocator.Allocated("2")).To(BeFalse())
+	g.Expect(allocator.Port("3")).To(Equal(1001))
+}
@@ -93,6 +93,11 @@ func (cce *endpointService) Request(ctx context.Context, request *networkservice
 	}()
 
 	message := cce.createLocalNSERequest(endpoint, dp, request.Connection, clientConnection)
+	if err = common.SignOutgoingRequest(ctx, request, message); err != nil {
+		err = errors.Wrap(err, "NSM:(7.2.6.2) failed to sign request")
+		logger.Error(err)
+		return nil, err
+	}
 	logger.Infof("NSM:(7.2.6.2) Requesting NSE with request %v", message)
 
 	span := spanhelper.FromContext(ctx, "nse.request")
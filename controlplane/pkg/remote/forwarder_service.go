@@ -25,8 +25,12 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	mechanismCommon "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/geneve"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/gre"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/srv6"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
@@ -75,11 +79,42 @@ func (cce *forwarderService) findMechanism(mechanismPreferences []*connection.Me
 	return nil
 }
 
+// requiredRemoteMechanism - returns a type of mechanism required by client connection or by endpoint labels if any
+func (cce *forwarderService) requiredRemoteMechanism(request *networkservice.NetworkServiceRequest) (string, error) {
+	required := request.GetConnection().GetLabels()[connection.RemoteMechanismKey]
+	endpointName := request.GetConnection().GetNetworkServiceEndpointName()
+	if endpoint := cce.model.GetEndpoint(endpointName); endpoint != nil {
+		endpointRequired := endpoint.Endpoint.GetNetworkServiceEndpoint().GetLabels()[connection.RemoteMechanismKey]
+		if required != "" && endpointRequired != "" && required != endpointRequired {
+			return "", errors.Errorf("connection requires %v remote mechanism, but endpoint %v requires %v", required, endpointName, endpointRequired)
+		}
+		if endpointRequired != "" {
+			required = endpointRequired
+		}
+	}
+	return required, nil
+}
+
 func (cce *forwarderService) selectRemoteMechanism(request *networkservice.NetworkServiceRequest, dp *model.Forwarder) (*connection.Mechanism, error) {
 	var mechanism *connection.Mechanism
 	var dpMechanism *connection.Mechanism
 
-	if preferredMechanismName := PreferredRemoteMechanism.StringValue(); len(preferredMechanismName) > 0 {
+	requiredMechanismName, err := cce.requiredRemoteMechanism(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(requiredMechanismName) > 0 {
+		for _, m := range request.GetRequestMechanismPreferences() {
+			if m.GetType() == requiredMechanismName {
+				mechanism = m
+				dpMechanism = cce.findMechanism(dp.RemoteMechanisms, m.GetType())
+				break
+			}
+		}
+		if mechanism == nil || dpMechanism == nil {
+			return nil, errors.Errorf("failed to select mechanism, required %v mechanism is not supported", requiredMechanismName)
+		}
+	} else if preferredMechanismName := PreferredRemoteMechanism.StringValue(); len(preferredMechanismName) > 0 {
 		for _, m := range request.GetRequestMechanismPreferences() {
 			if m.GetType() == preferredMechanismName {
 				if dpm := cce.findMechanism(dp.RemoteMechanisms, m.GetType()); dpm != nil {
@@ -106,16 +141,33 @@ func (cce *forwarderService) selectRemoteMechanism(request *networkservice.Netwo
 		return nil, errors.Errorf("failed to select mechanism, no matched mechanisms found")
 	}
 
+	// Forwarders on both ends limit MTU of the connection by the smaller egress MTU
+	if mtu, ok := dpMechanism.GetParameters()[mechanismCommon.SrcMTU]; ok && mechanism.GetParameters() != nil {
+		mechanism.GetParameters()[mechanismCommon.DstMTU] = mtu
+	}
+
 	switch mechanism.GetType() {
 	case vxlan.MECHANISM:
 		cce.configureVXLANParameters(mechanism.GetParameters(), dpMechanism.GetParameters())
 
+	case gre.MECHANISM:
+		cce.configureTunnelParameters(mechanism.GetParameters(), dpMechanism.GetParameters(), gre.Key)
+
+	case geneve.MECHANISM:
+		cce.configureTunnelParameters(mechanism.GetParameters(), dpMechanism.GetParameters(), geneve.VNI)
+
 	case srv6.MECHANISM:
 		connectionID := request.GetConnection().GetId()
 		parameters := mechanism.GetParameters()
 		dpParameters := dpMechanism.GetParameters()
 
 		cce.configureSRv6Parameters(connectionID, parameters, dpParameters)
+
+	case wireguard.MECHANISM:
+		connectionID := request.GetConnection().GetId()
+		if err := cce.configureWireGuardParameters(connectionID, mechanism.GetParameters(), dpMechanism.GetParameters()); err != nil {
+			return nil, err
+		}
 	}
 
 	logrus.Infof("NSM:(5.1) Remote mechanism selected %v", mechanism)
@@ -148,6 +200,15 @@ func (cce *forwarderService) configureVXLANParameters(parameters, dpParameters m
 	parameters[vxlan.VNI] = strconv.FormatUint(uint64(vni), 10)
 }
 
+// configureTunnelParameters - sets destination IP and allocates a connection identifier stored as idKey parameter
+// for GRE and GENEVE mechanisms
+func (cce *forwarderService) configureTunnelParameters(parameters, dpParameters map[string]string, idKey string) {
+	parameters[gre.DstIP] = dpParameters[gre.SrcIP]
+
+	vni := cce.serviceRegistry.VniAllocator().Vni(parameters[gre.DstIP], parameters[gre.SrcIP])
+	parameters[idKey] = strconv.FormatUint(uint64(vni), 10)
+}
+
 func (cce *forwarderService) configureSRv6Parameters(connectionID string, parameters, dpParameters map[string]string) {
 	parameters[srv6.DstHardwareAddress] = dpParameters[srv6.SrcHardwareAddress]
 	parameters[srv6.DstHostIP] = dpParameters[srv6.SrcHostIP]
@@ -156,6 +217,20 @@ func (cce *forwarderService) configureSRv6Parameters(connectionID string, parame
 	parameters[srv6.DstLocalSID] = cce.serviceRegistry.SIDAllocator().SID(connectionID)
 }
 
+func (cce *forwarderService) configureWireGuardParameters(connectionID string, parameters, dpParameters map[string]string) error {
+	port := cce.serviceRegistry.PortAllocator().Port(connectionID)
+	if port == 0 {
+		return errors.New("failed to allocate WireGuard port, all ports are in use")
+	}
+	parameters[wireguard.DstIP] = dpParameters[wireguard.SrcIP]
+	parameters[wireguard.DstPublicKey] = dpParameters[wireguard.SrcPublicKey]
+	parameters[wireguard.DstPort] = strconv.Itoa(port)
+	if _, ok := parameters[wireguard.AllowedIPs]; !ok {
+		parameters[wireguard.AllowedIPs] = wireguard.DefaultAllowedIPs
+	}
+	return nil
+}
+
 func (cce *forwarderService) updateMechanism(request *networkservice.NetworkServiceRequest, dp *model.Forwarder) error {
 	conn := request.GetConnection()
 	// 5.x
@@ -193,11 +268,15 @@ func (cce *forwarderService) Request(ctx context.Context, request *networkservic
 		return nil, err
 	}
 
+	// A port of an established connection is kept if its update fails
+	hadPort := cce.serviceRegistry.PortAllocator().Allocated(request.GetConnection().GetId())
+
 	// 5. Select a local forwarder and put it into conn object
 	err = cce.updateMechanism(request, dp)
 	if err != nil {
 		// 5.1 Close forwarder connection, if had existing one and NSE is closed.
 		cce.doFailureClose(ctx)
+		cce.releasePort(request.GetConnection().GetId(), hadPort)
 		return nil, errors.Errorf("NSM:(5.1) %v", err)
 	}
 
@@ -207,12 +286,20 @@ func (cce *forwarderService) Request(ctx context.Context, request *networkservic
 	conn, connErr := common.ProcessNext(ctx, request)
 	if connErr != nil {
 		cce.doFailureClose(ctx)
+		cce.releasePort(request.GetConnection().GetId(), hadPort)
 		return conn, connErr
 	}
 	// We need to program forwarder.
 	return cce.programForwarder(ctx, conn, dp, clientConnection)
 }
 
+// releasePort releases a port allocated for a failed request, unless the connection had it before
+func (cce *forwarderService) releasePort(connectionID string, hadPort bool) {
+	if !hadPort {
+		cce.serviceRegistry.PortAllocator().Release(connectionID)
+	}
+}
+
 func (cce *forwarderService) doFailureClose(ctx context.Context) {
 	clientConnection := common.ModelConnection(ctx)
 
@@ -239,6 +326,7 @@ func (cce *forwarderService) Close(ctx context.Context, conn *connection.Connect
 	if closeErr := cce.performClose(ctx, cc, logger); closeErr != nil {
 		logger.Errorf("Failed to close: %v", closeErr)
 	}
+	cce.serviceRegistry.PortAllocator().Release(conn.GetId())
 	return empt, err
 }
 
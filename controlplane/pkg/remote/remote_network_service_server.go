@@ -18,6 +18,8 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/api/nsm"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/metrics"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 	"github.com/networkservicemesh/networkservicemesh/sdk/monitor/connectionmonitor"
 )
 
@@ -25,7 +27,11 @@ import (
 func NewRemoteNetworkServiceServer(manager nsm.NetworkServiceManager, connectionMonitor connectionmonitor.MonitorServer) networkservice.NetworkServiceServer {
 	return common.NewCompositeService("Remote",
 		common.NewRequestValidator(),
+		common.NewMetricsService(manager.Model(), metrics.RemoteScope),
+		common.NewPathVerifierService(tools.GetConfig().SecurityProvider),
+		common.NewDrainService(manager),
 		common.NewMonitorService(connectionMonitor),
+		common.NewLeaseService(manager.Model()),
 		NewConnectionService(manager.Model()),
 		NewForwarderService(manager.Model(), manager.ServiceRegistry()),
 		NewEndpointSelectorService(manager.NseManager(), manager.Model()),
@@ -4,6 +4,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/port"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/sid"
 
 	"golang.org/x/net/context"
@@ -21,6 +22,7 @@ import (
 type ApiRegistry interface {
 	NewNSMServerListener() (net.Listener, error)
 	NewPublicListener(nsmdAPIAddress string) (net.Listener, error)
+	NewAdminListener() (net.Listener, error)
 }
 
 /**
@@ -32,6 +34,7 @@ type ServiceRegistry interface {
 	DiscoveryClient(ctx context.Context) (registry.NetworkServiceDiscoveryClient, error)
 	NseRegistryClient(ctx context.Context) (registry.NetworkServiceRegistryClient, error)
 	NsmRegistryClient(ctx context.Context) (registry.NsmRegistryClient, error)
+	PodEventRecorderClient(ctx context.Context) (registry.PodEventRecorderClient, error)
 
 	Stop()
 	NSMDApiClient(ctx context.Context) (nsmdapi.NSMDClient, *grpc.ClientConn, error)
@@ -44,6 +47,7 @@ type ServiceRegistry interface {
 
 	VniAllocator() vni.VniAllocator
 	SIDAllocator() sid.Allocator
+	PortAllocator() port.Allocator
 
 	NewWorkspaceProvider() WorkspaceLocationProvider
 }
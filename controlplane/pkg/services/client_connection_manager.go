@@ -101,6 +101,13 @@ func (m *ClientConnectionManager) UpdateXcon(ctx context.Context, cc nsm.ClientC
 		m.manager.Heal(ctx, cc, nsm.HealStateDstDown)
 		return
 	}
+
+	if dst := newXcon.GetRemoteDestination(); dst != nil && dst.State == connection.State_DOWN {
+		// Forwarder has found datapath to remote destination broken
+		logger.Info("ClientConnection remote dst state is down. calling Heal.")
+		m.manager.Heal(ctx, cc, nsm.HealStateDstDown)
+		return
+	}
 }
 
 // DestinationDown handles case when destination down
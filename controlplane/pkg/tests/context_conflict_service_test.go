@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/local"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+func newConflictTestConnection(id, netNsInode string, ctx *connectioncontext.ConnectionContext) *connection.Connection {
+	return &connection.Connection{
+		Id:             id,
+		NetworkService: "golden_network",
+		Mechanism: &connection.Mechanism{
+			Type:       kernel.MECHANISM,
+			Parameters: map[string]string{common.NetNsInodeKey: netNsInode},
+		},
+		Context: ctx,
+	}
+}
+
+func newConflictTestModel() model.Model {
+	mdl := model.NewModel()
+	mdl.AddClientConnection(context.Background(), &model.ClientConnection{
+		ConnectionID: "1",
+		Xcon: &crossconnect.CrossConnect{
+			Id: "1",
+			Source: newConflictTestConnection("1", "10", &connectioncontext.ConnectionContext{
+				IpContext: &connectioncontext.IPContext{
+					SrcIpAddr: "10.20.1.1/30",
+					DstIpAddr: "10.20.1.2/30",
+					DstRoutes: []*connectioncontext.Route{{Prefix: "8.8.8.0/24"}},
+				},
+			}),
+		},
+	})
+	return mdl
+}
+
+func doConflictRequest(netNsInode string, ipContext *connectioncontext.IPContext, policy *connectioncontext.PolicyRoutingContext) (*connection.Connection, error) {
+	srv := local.NewContextConflictService(newConflictTestModel())
+	return srv.Request(context.Background(), &networkservice.NetworkServiceRequest{
+		Connection: newConflictTestConnection("2", netNsInode, &connectioncontext.ConnectionContext{
+			IpContext:            ipContext,
+			PolicyRoutingContext: policy,
+		}),
+	})
+}
+
+func TestContextConflictExcludesPrefixes(t *testing.T) {
+	g := NewWithT(t)
+
+	conn, err := doConflictRequest("10", &connectioncontext.IPContext{
+		SrcIpAddr: "10.30.1.1/30",
+		DstRoutes: []*connectioncontext.Route{{Prefix: "9.9.9.0/24"}},
+	}, nil)
+	g.Expect(err).To(BeNil())
+	g.Expect(conn.GetContext().GetIpContext().GetExcludedPrefixes()).To(ConsistOf("10.20.1.0/30"))
+}
+
+func TestContextConflictAddress(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := doConflictRequest("10", &connectioncontext.IPContext{SrcIpAddr: "10.20.1.0/24"}, nil)
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("address 10.20.1.0/24 overlaps address 10.20.1.1/30"))
+
+	_, err = doConflictRequest("11", &connectioncontext.IPContext{SrcIpAddr: "10.20.1.0/24"}, nil)
+	g.Expect(err).To(BeNil())
+}
+
+func TestContextConflictRoute(t *testing.T) {
+	g := NewWithT(t)
+
+	ipContext := &connectioncontext.IPContext{
+		SrcIpAddr: "10.30.1.1/30",
+		DstRoutes: []*connectioncontext.Route{{Prefix: "8.8.8.0/24"}},
+	}
+	_, err := doConflictRequest("10", ipContext, nil)
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("route 8.8.8.0/24 is already present in routing table 0"))
+
+	_, err = doConflictRequest("10", ipContext, &connectioncontext.PolicyRoutingContext{Table: 100})
+	g.Expect(err).To(BeNil())
+
+	_, err = doConflictRequest("10", &connectioncontext.IPContext{
+		SrcIpAddr: "10.30.1.1/30",
+		DstRoutes: []*connectioncontext.Route{{Prefix: "10.20.1.2/32"}},
+	}, nil)
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("route 10.20.1.2/32 falls within network of address 10.20.1.1/30"))
+}
@@ -1,12 +1,19 @@
 package tests
 
 import (
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+
 	. "github.com/onsi/gomega"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nseregistry"
 )
@@ -71,6 +78,136 @@ func TestNSEDeleteClientTest(t *testing.T) {
 	}}))
 }
 
+func TestNSELegacyRegistryMigration(t *testing.T) {
+	g := NewWithT(t)
+	fileName, err := tmpFile()
+	g.Expect(err).To(BeNil())
+	defer os.Remove(fileName)
+
+	bytes, err := proto.Marshal(createNSEReg("endpoint1"))
+	g.Expect(err).To(BeNil())
+	legacy := "CLE\tnsm-1\nCLE\tnsm-2\nNSE\tendpoint1\tnsm-1\t" + base64.StdEncoding.EncodeToString(bytes) + "\n"
+	g.Expect(ioutil.WriteFile(fileName, []byte(legacy), 0600)).To(BeNil())
+
+	reg := nseregistry.NewNSERegistry(fileName)
+	clients, _, err := reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-1", "nsm-2"}))
+
+	content, err := ioutil.ReadFile(fileName)
+	g.Expect(err).To(BeNil())
+	g.Expect(strings.HasPrefix(string(content), "#nsm-local-registry\tv2\n")).To(BeTrue())
+
+	clients, nses, err := reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-1", "nsm-2"}))
+	g.Expect(nses).To(Equal(map[string]nseregistry.NSEEntry{"endpoint1": createEntry("nsm-1", "endpoint1")}))
+
+	g.Expect(reg.AppendClientRequest("nsm-3")).To(BeNil())
+	clients, nses, err = reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-1", "nsm-2", "nsm-3"}))
+	g.Expect(nses).To(Equal(map[string]nseregistry.NSEEntry{"endpoint1": createEntry("nsm-1", "endpoint1")}))
+}
+
+func TestNSECorruptedRegistry(t *testing.T) {
+	g := NewWithT(t)
+	fileName, err := tmpFile()
+	g.Expect(err).To(BeNil())
+	defer os.Remove(fileName)
+	reg := nseregistry.NewNSERegistry(fileName)
+
+	g.Expect(addValues(reg)).To(BeNil())
+
+	content, err := ioutil.ReadFile(fileName)
+	g.Expect(err).To(BeNil())
+	lines := strings.Split(string(content), "\n")
+	// Damage a second client record and emulate partially written record at the end of file.
+	lines[2] = strings.Replace(lines[2], "CLE", "CLF", 1)
+	damaged := strings.Join(lines, "\n") + lines[4][:len(lines[4])/2]
+	g.Expect(ioutil.WriteFile(fileName, []byte(damaged), 0600)).To(BeNil())
+
+	clients, _, err := reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-1", "nsm-3"}))
+
+	clients, nses, err := reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-1", "nsm-3"}))
+	g.Expect(nses).To(Equal(map[string]nseregistry.NSEEntry{"endpoint1": createEntry("nsm-1", "endpoint1"), "endpoint2": createEntry("nsm-2", "endpoint2")}))
+
+	g.Expect(reg.AppendClientRequest("nsm-4")).To(BeNil())
+	clients, _, err = reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-1", "nsm-3", "nsm-4"}))
+}
+
+func TestNSEUnsupportedVersionRecords(t *testing.T) {
+	g := NewWithT(t)
+	fileName, err := tmpFile()
+	g.Expect(err).To(BeNil())
+	defer os.Remove(fileName)
+	reg := nseregistry.NewNSERegistry(fileName)
+
+	g.Expect(addValues(reg)).To(BeNil())
+
+	content, err := ioutil.ReadFile(fileName)
+	g.Expect(err).To(BeNil())
+	lines := strings.Split(string(content), "\n")
+	// Emulate a second client record written in a newer version.
+	fields := strings.Split(lines[2], "\t")
+	body := strings.Join(append([]string{"CLE/v3"}, fields[1:len(fields)-1]...), "\t")
+	lines[2] = fmt.Sprintf("%s\t%08x", body, crc32.ChecksumIEEE([]byte(body)))
+	g.Expect(ioutil.WriteFile(fileName, []byte(strings.Join(lines, "\n")), 0600)).To(BeNil())
+
+	clients, nses, err := reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-1", "nsm-3"}))
+	g.Expect(nses).To(HaveLen(2))
+
+	// Records of a file of a newer version are skipped without failing the load.
+	g.Expect(ioutil.WriteFile(fileName, []byte(strings.Replace(string(content), "\tv2\n", "\tv3\n", 1)), 0600)).To(BeNil())
+	clients, nses, err = reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(BeEmpty())
+	g.Expect(nses).To(BeEmpty())
+
+	g.Expect(reg.AppendClientRequest("nsm-4")).To(BeNil())
+	clients, _, err = reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(clients).To(Equal([]string{"nsm-4"}))
+}
+
+func TestNSEClientMetadata(t *testing.T) {
+	g := NewWithT(t)
+	fileName, err := tmpFile()
+	g.Expect(err).To(BeNil())
+	defer os.Remove(fileName)
+	reg := nseregistry.NewNSERegistry(fileName)
+
+	g.Expect(addValues(reg)).To(BeNil())
+	g.Expect(reg.AppendClientMetadata("nsm-1", &nsmdapi.WorkspaceMetadata{PodName: "old"})).To(BeNil())
+	g.Expect(reg.AppendClientMetadata("nsm-1", &nsmdapi.WorkspaceMetadata{PodName: "pod-1", Namespace: "ns", PodUid: "uid-1"})).To(BeNil())
+	g.Expect(reg.AppendClientMetadata("nsm-2", &nsmdapi.WorkspaceMetadata{PodName: "pod-2", Namespace: "ns", PodUid: "uid-2"})).To(BeNil())
+
+	metadata, err := reg.LoadClientMetadata()
+	g.Expect(err).To(BeNil())
+	g.Expect(metadata).To(HaveLen(2))
+	g.Expect(metadata["nsm-1"].GetPodUid()).To(Equal("uid-1"))
+	g.Expect(metadata["nsm-2"].GetPodUid()).To(Equal("uid-2"))
+
+	// Metadata follows its client on save and delete.
+	clients, nses, err := reg.LoadRegistry()
+	g.Expect(err).To(BeNil())
+	g.Expect(reg.Save(clients[:2], nses)).To(BeNil())
+	g.Expect(reg.DeleteClient("nsm-2")).To(BeNil())
+
+	metadata, err = reg.LoadClientMetadata()
+	g.Expect(err).To(BeNil())
+	g.Expect(metadata).To(HaveLen(1))
+	g.Expect(metadata["nsm-1"].GetPodName()).To(Equal("pod-1"))
+}
+
 func addValues(reg *nseregistry.NSERegistry) error {
 	err := reg.AppendClientRequest("nsm-1")
 	if err != nil {
@@ -0,0 +1,117 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmadmin"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nsmd"
+)
+
+func startAdminClient(g *WithT, srv *nsmdFullServerImpl) (nsmadmin.NSMAdminClient, *grpc.ClientConn) {
+	sock, err := srv.apiRegistry.NewAdminListener()
+	g.Expect(err).To(BeNil())
+	g.Expect(srv.nsmServer.StartAdminServerAt(context.Background(), sock)).To(BeNil())
+
+	conn, err := grpc.Dial("unix:"+sock.Addr().String(), grpc.WithInsecure())
+	g.Expect(err).To(BeNil())
+	return nsmadmin.NewNSMAdminClient(conn), conn
+}
+
+func TestNSMDAdminAPI(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	adminClient, adminConn := startAdminClient(g, srv)
+	defer adminConn.Close()
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+	nsmResponse, err := nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+
+	connections, err := adminClient.ListConnections(context.Background(), &empty.Empty{})
+	g.Expect(err).To(BeNil())
+	g.Expect(len(connections.GetConnections())).To(Equal(1))
+	cc := connections.GetConnections()[0]
+	g.Expect(cc.GetConnectionState()).To(Equal(nsmadmin.ConnectionState_CONNECTION_READY))
+	g.Expect(cc.GetForwarderState()).To(Equal(nsmadmin.ForwarderState_FORWARDER_READY))
+	g.Expect(cc.GetForwarderName()).To(Equal("test_data_plane"))
+	g.Expect(cc.GetXcon().GetLocalSource().GetId()).To(Equal(nsmResponse.GetId()))
+
+	forwarders, err := adminClient.DumpForwarders(context.Background(), &empty.Empty{})
+	g.Expect(err).To(BeNil())
+	g.Expect(len(forwarders.GetForwarders())).To(Equal(1))
+	g.Expect(forwarders.GetForwarders()[0].GetName()).To(Equal("test_data_plane"))
+	g.Expect(len(forwarders.GetForwarders()[0].GetCrossConnects())).To(Equal(1))
+
+	_, err = adminClient.HealConnection(context.Background(), &nsmadmin.HealRequest{
+		ConnectionId: "unknown",
+		HealState:    nsmadmin.HealState_HEAL_DST_DOWN,
+	})
+	g.Expect(err).NotTo(BeNil())
+
+	_, err = adminClient.CordonEndpoint(context.Background(), &nsmadmin.CordonRequest{
+		EndpointName: "golden_networkprovider",
+		Cordon:       true,
+	})
+	g.Expect(err).To(BeNil())
+	_, err = nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).NotTo(BeNil())
+
+	_, err = adminClient.CordonEndpoint(context.Background(), &nsmadmin.CordonRequest{
+		EndpointName: "golden_networkprovider",
+		Cordon:       false,
+	})
+	g.Expect(err).To(BeNil())
+
+	_, err = adminClient.CloseConnection(context.Background(), &nsmadmin.CloseRequest{
+		ConnectionId: cc.GetConnectionId(),
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(srv.TestModel.GetClientConnection(cc.GetConnectionId())).To(BeNil())
+}
+
+func TestNSMDAdminAPIAccessDenied(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(nsmd.AdminAllowedUIDsEnv, fmt.Sprintf("%d", os.Getuid()+1))
+	defer func() { _ = os.Unsetenv(nsmd.AdminAllowedUIDsEnv) }()
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+
+	adminClient, adminConn := startAdminClient(g, srv)
+	defer adminConn.Close()
+
+	_, err := adminClient.ListConnections(context.Background(), &empty.Empty{})
+	g.Expect(err).NotTo(BeNil())
+}
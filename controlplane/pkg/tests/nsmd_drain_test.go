@@ -0,0 +1,103 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+	"github.com/networkservicemesh/networkservicemesh/sdk/monitor"
+	"github.com/networkservicemesh/networkservicemesh/sdk/monitor/connectionmonitor"
+)
+
+func TestNSMDDrainLocal(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+	nsmResponse, err := nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+
+	srv.nsmServer.Drain(context.Background(), time.Second)
+
+	storage.RLock()
+	g.Expect(storage.managers[Master].IsDraining()).To(BeTrue())
+	storage.RUnlock()
+
+	// New requests are refused, but local connections are kept to be restored after restart.
+	_, err = nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(len(srv.TestModel.GetAllClientConnections())).To(Equal(1))
+	g.Expect(srv.TestModel.GetAllClientConnections()[0].GetConnectionSource().GetId()).To(Equal(nsmResponse.GetId()))
+}
+
+func TestNSMDDrainRemote(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	srv2 := NewNSMDFullServer(Worker, storage)
+	defer srv.Stop()
+	defer srv2.Stop()
+
+	srv.TestModel.AddForwarder(context.Background(), testForwarder1)
+	srv2.TestModel.AddForwarder(context.Background(), testForwarder2)
+	srv2.TestModel.AddEndpoint(context.Background(), srv2.RegisterFakeEndpoint("golden_network", "test", Worker))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+	_, err := nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+	g.Expect(len(srv2.TestModel.GetAllClientConnections())).To(Equal(1))
+	remoteID := srv2.TestModel.GetAllClientConnections()[0].GetConnectionSource().GetId()
+
+	monitorConn, err := tools.DialTCP(fmt.Sprintf("127.0.0.1:%d", srv2.apiRegistry.nsmdPublicPort))
+	g.Expect(err).To(BeNil())
+	defer monitorConn.Close()
+	monitorClient, err := connectionmonitor.NewMonitorClient(monitorConn, &connection.MonitorScopeSelector{
+		PathSegments: []*connection.PathSegment{{Name: Master}, {Name: Worker}},
+	})
+	g.Expect(err).To(BeNil())
+	defer monitorClient.Close()
+
+	event := <-monitorClient.EventChannel()
+	g.Expect(event.EventType()).To(Equal(monitor.EventTypeInitialStateTransfer))
+	g.Expect(event.Entities()).To(HaveKey(remoteID))
+
+	srv2.nsmServer.Drain(context.Background(), time.Second)
+
+	// Remote peers are notified to heal proactively.
+	event = <-monitorClient.EventChannel()
+	g.Expect(event.EventType()).To(Equal(monitor.EventTypeDelete))
+	g.Expect(event.Entities()).To(HaveKey(remoteID))
+
+	// Remote peers do not select endpoints of draining NSM anymore.
+	_, err = nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).NotTo(BeNil())
+}
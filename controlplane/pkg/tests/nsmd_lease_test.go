@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
+)
+
+func newLeasedRequest(g *WithT, conn *connection.Connection) *networkservice.NetworkServiceRequest {
+	request := CreateRequest()
+	if conn != nil {
+		request = &networkservice.NetworkServiceRequest{
+			Connection: conn.Clone(),
+			MechanismPreferences: []*connection.Mechanism{
+				conn.GetMechanism(),
+			},
+		}
+	}
+	request.Connection.Path = &connection.Path{
+		PathSegments: []*connection.PathSegment{{Name: "nsm-1"}},
+	}
+	g.Expect(pathtoken.Sign(context.Background(), nil, request.Connection.Path, "")).To(BeNil())
+	return request
+}
+
+func TestNSMDLeaseRefreshIsCheap(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+
+	nsmResponse, err := nsmClient.Request(context.Background(), newLeasedRequest(g, nil))
+	g.Expect(err).To(BeNil())
+	expires, ok := nsmResponse.GetPath().GetExpireTime()
+	g.Expect(ok).To(BeTrue())
+
+	forwarderRequests := len(srv.serviceRegistry.testForwarderConnection.connections)
+	nseRequests := srv.serviceRegistry.localTestNSE.(*localTestNSENetworkServiceClient).requestHandleCounter
+
+	<-time.After(time.Second)
+	refreshed, err := nsmClient.Request(context.Background(), newLeasedRequest(g, nsmResponse))
+	g.Expect(err).To(BeNil())
+	g.Expect(refreshed.GetId()).To(Equal(nsmResponse.GetId()))
+	g.Expect(refreshed.GetContext()).To(Equal(nsmResponse.GetContext()))
+
+	refreshedExpires, ok := refreshed.GetPath().GetExpireTime()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(refreshedExpires.After(expires)).To(BeTrue())
+
+	// Neither forwarder nor endpoint are requested
+	g.Expect(len(srv.serviceRegistry.testForwarderConnection.connections)).To(Equal(forwarderRequests))
+	g.Expect(srv.serviceRegistry.localTestNSE.(*localTestNSENetworkServiceClient).requestHandleCounter).To(Equal(nseRequests))
+
+	cc := srv.TestModel.GetClientConnection(nsmResponse.GetId())
+	g.Expect(cc).NotTo(BeNil())
+	modelExpires, ok := cc.Request.GetConnection().GetPath().GetExpireTime()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(modelExpires).To(Equal(refreshedExpires))
+
+	// Changed request is not a refresh
+	changed := newLeasedRequest(g, refreshed)
+	changed.Connection.Labels = map[string]string{"app": "changed"}
+	_, err = nsmClient.Request(context.Background(), changed)
+	g.Expect(err).To(BeNil())
+	g.Expect(len(srv.serviceRegistry.testForwarderConnection.connections)).To(BeNumerically(">", forwarderRequests))
+}
+
+func TestNSMDLapsedConnectionIsClosed(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(pathtoken.TokenExpireEnv.Name(), "1s")
+	_ = os.Setenv(common.DefaultLeaseEnv.Name(), "true")
+	defer func() {
+		_ = os.Unsetenv(pathtoken.TokenExpireEnv.Name())
+		_ = os.Unsetenv(common.DefaultLeaseEnv.Name())
+	}()
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+
+	leased, err := nsmClient.Request(context.Background(), newLeasedRequest(g, nil))
+	g.Expect(err).To(BeNil())
+
+	// Connections of clients not aware of leases are granted a default lease if it is on
+	unleased, err := nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+	_, ok := unleased.GetPath().GetExpireTime()
+	g.Expect(ok).To(BeTrue())
+
+	g.Eventually(func() bool {
+		return srv.TestModel.GetClientConnection(leased.GetId()) == nil &&
+			srv.TestModel.GetClientConnection(unleased.GetId()) == nil
+	}, 10*time.Second, 100*time.Millisecond).Should(BeTrue())
+}
+
+func TestNSMDUnleasedConnectionIsKept(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(pathtoken.TokenExpireEnv.Name(), "1s")
+	defer func() {
+		_ = os.Unsetenv(pathtoken.TokenExpireEnv.Name())
+	}()
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+
+	// Connections of clients not aware of leases are not granted a lease by default
+	unleased, err := nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+	_, ok := unleased.GetPath().GetExpireTime()
+	g.Expect(ok).To(BeFalse())
+
+	g.Consistently(func() bool {
+		return srv.TestModel.GetClientConnection(unleased.GetId()) != nil
+	}, 3*time.Second, 100*time.Millisecond).Should(BeTrue())
+}
+
+func TestNSMDLeaseIsLimited(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(common.MaxLeaseEnv.Name(), "1m")
+	defer func() {
+		_ = os.Unsetenv(common.MaxLeaseEnv.Name())
+	}()
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+
+	request := newLeasedRequest(g, nil)
+	request.Connection.Path.PathSegments[0].Expires, _ = ptypes.TimestampProto(time.Now().Add(24 * time.Hour))
+	leased, err := nsmClient.Request(context.Background(), request)
+	g.Expect(err).To(BeNil())
+	expires, ok := leased.GetPath().GetExpireTime()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(expires.Before(time.Now().Add(time.Minute))).To(BeTrue())
+}
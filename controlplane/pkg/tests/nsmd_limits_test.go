@@ -0,0 +1,115 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/limits"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+)
+
+func TestNSMDWorkspaceConnectionLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(limits.MaxConnectionsEnv.Name(), "1")
+	_ = os.Setenv(limits.MaxEndpointsEnv.Name(), "1")
+	defer func() {
+		_ = os.Unsetenv(limits.MaxConnectionsEnv.Name())
+		_ = os.Unsetenv(limits.MaxEndpointsEnv.Name())
+	}()
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	response := srv.RequestNSM("nsm-1")
+	nsmClient, conn := srv.CreateNSClient(response)
+	defer conn.Close()
+
+	nsmResponse, err := nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+
+	_, err = nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+	// Updates of existing connection are allowed
+	request := CreateRequest()
+	request.Connection.Id = nsmResponse.GetId()
+	_, err = nsmClient.Request(context.Background(), request)
+	g.Expect(err).To(BeNil())
+
+	regConn, err := tools.DialUnix(response.HostBasedir + "/" + response.Workspace + "/" + response.NsmServerSocket)
+	g.Expect(err).To(BeNil())
+	defer regConn.Close()
+	registryClient := registry.NewNetworkServiceRegistryClient(regConn)
+
+	_, err = registryClient.RegisterNSE(context.Background(), &registry.NSERegistration{
+		NetworkService: &registry.NetworkService{
+			Name:    "golden_network",
+			Payload: "test",
+		},
+		NetworkServiceEndpoint: &registry.NetworkServiceEndpoint{
+			Name:               "silver_networkprovider",
+			Payload:            "test",
+			NetworkServiceName: "golden_network",
+		},
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+}
+
+func TestNSMDWorkspaceRequestRateLimit(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(limits.RequestRateEnv.Name(), "0.001")
+	_ = os.Setenv(limits.RequestBurstEnv.Name(), "2")
+	defer func() {
+		_ = os.Unsetenv(limits.RequestRateEnv.Name())
+		_ = os.Unsetenv(limits.RequestBurstEnv.Name())
+	}()
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer conn.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := nsmClient.Request(context.Background(), CreateRequest())
+		g.Expect(err).To(BeNil())
+	}
+	_, err := nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+	// Other workspaces have their own rate
+	otherClient, otherConn := srv.requestNSMConnection("nsm-2")
+	defer otherConn.Close()
+	_, err = otherClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+}
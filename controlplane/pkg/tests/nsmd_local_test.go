@@ -17,6 +17,7 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
 )
 
 type nseWithOptions struct {
@@ -99,6 +100,66 @@ func TestNSMDRequestClientConnectionRequest(t *testing.T) {
 	logrus.Print("End of test")
 }
 
+func TestNSMDEnumConnectionWorkspaceMetadata(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	apiClient, apiConn, err := srv.serviceRegistry.NSMDApiClient(context.Background())
+	g.Expect(err).To(BeNil())
+	defer apiConn.Close()
+
+	metadata := &nsmdapi.WorkspaceMetadata{
+		Containers: []*nsmdapi.ContainerInfo{
+			{
+				DeviceIds: []string{"nsm-1"},
+			},
+		},
+	}
+	response, err := apiClient.RequestClientConnection(context.Background(), &nsmdapi.ClientConnectionRequest{
+		Workspace: "nsm-1",
+		Metadata:  metadata,
+	})
+	g.Expect(err).To(BeNil())
+
+	nsmClient, conn := srv.CreateNSClient(response)
+	defer conn.Close()
+	_, err = nsmClient.Request(context.Background(), CreateRequest())
+	g.Expect(err).To(BeNil())
+
+	metadata.PodName = "nsc-pod"
+	metadata.Namespace = "default"
+	_, err = apiClient.UpdateClientConnection(context.Background(), &nsmdapi.UpdateConnectionRequest{
+		Workspace: "nsm-1",
+		Metadata:  metadata,
+	})
+	g.Expect(err).To(BeNil())
+
+	reply, err := apiClient.EnumConnection(context.Background(), &nsmdapi.EnumConnectionRequest{})
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.GetWorkspace()).To(Equal([]string{"nsm-1"}))
+	g.Expect(len(reply.GetWorkspaces())).To(Equal(1))
+
+	info := reply.GetWorkspaces()[0]
+	g.Expect(info.GetName()).To(Equal("nsm-1"))
+	g.Expect(info.GetMetadata().GetPodName()).To(Equal("nsc-pod"))
+	g.Expect(info.GetMetadata().GetNamespace()).To(Equal("default"))
+	g.Expect(info.GetMetadata().GetContainers()[0].GetDeviceIds()).To(Equal([]string{"nsm-1"}))
+	g.Expect(info.GetCreationTime()).NotTo(BeNil())
+	g.Expect(info.GetActiveConnections()).To(Equal(uint32(1)))
+	g.Expect(info.GetRegisteredEndpoints()).To(Equal(uint32(1)))
+
+	_, err = apiClient.UpdateClientConnection(context.Background(), &nsmdapi.UpdateConnectionRequest{
+		Workspace: "nsm-2",
+	})
+	g.Expect(err).NotTo(BeNil())
+}
+
 func TestNSENoSrc(t *testing.T) {
 	g := NewWithT(t)
 
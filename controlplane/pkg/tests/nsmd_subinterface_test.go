@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/subinterface"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+var testSubInterfaceForwarder = &model.Forwarder{
+	RegisteredName: "test_data_plane",
+	SocketLocation: "tcp:some_addr",
+	LocalMechanisms: []*connection.Mechanism{
+		{
+			Type: kernel.MECHANISM,
+		},
+		{
+			Type: subinterface.MECHANISM,
+			Parameters: map[string]string{
+				subinterface.Parent: "eth1",
+				subinterface.Kind:   subinterface.KindMacvlan,
+				subinterface.Mode:   "private",
+			},
+		},
+	},
+	MechanismsConfigured: true,
+}
+
+func requestSubInterface(t *testing.T, parameters map[string]string) *connection.Mechanism {
+	g := NewWithT(t)
+
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	defer srv.Stop()
+	srv.TestModel.AddForwarder(context.Background(), testSubInterfaceForwarder)
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", Master))
+
+	nsmClient, conn := srv.requestNSMConnection("nsm")
+	defer func() { _ = conn.Close() }()
+
+	parameters[common.NetNsInodeKey] = "10"
+	parameters[common.InterfaceNameKey] = "icmp-responder1"
+	request := CreateRequest()
+	request.MechanismPreferences = []*connection.Mechanism{
+		{
+			Type:       subinterface.MECHANISM,
+			Parameters: parameters,
+		},
+	}
+
+	nsmResponse, err := nsmClient.Request(context.Background(), request)
+	g.Expect(err).To(BeNil())
+	g.Expect(nsmResponse.GetMechanism().GetType()).To(Equal(subinterface.MECHANISM))
+
+	xcons := srv.serviceRegistry.testForwarderConnection.connections
+	g.Expect(len(xcons)).To(Equal(1))
+	g.Expect(xcons[0].GetDestination().GetMechanism().GetType()).To(Equal(kernel.MECHANISM))
+	return xcons[0].GetSource().GetMechanism()
+}
+
+func TestNSMDSubInterfaceForwarderDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	m := requestSubInterface(t, map[string]string{})
+	g.Expect(m.GetType()).To(Equal(subinterface.MECHANISM))
+
+	helper := subinterface.ToMechanism(m)
+	g.Expect(helper.Parent()).To(Equal("eth1"))
+	g.Expect(helper.Kind()).To(Equal(subinterface.KindMacvlan))
+	g.Expect(helper.Mode()).To(Equal("private"))
+}
+
+func TestNSMDSubInterfaceRequestedKind(t *testing.T) {
+	g := NewWithT(t)
+
+	m := requestSubInterface(t, map[string]string{
+		subinterface.Parent: "eth0",
+		subinterface.Kind:   subinterface.KindIpvlan,
+	})
+
+	helper := subinterface.ToMechanism(m)
+	g.Expect(helper.Parent()).To(Equal("eth1"))
+	g.Expect(helper.Kind()).To(Equal(subinterface.KindIpvlan))
+	g.Expect(helper.Mode()).To(Equal("l2"))
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nsm"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nsmd"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/serviceregistry"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/port"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/sid"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/vni"
 	"github.com/networkservicemesh/networkservicemesh/forwarder/api/forwarder"
@@ -166,6 +167,7 @@ type nsmdTestServiceRegistry struct {
 	localTestNSE            networkservice.NetworkServiceClient
 	vniAllocator            vni.VniAllocator
 	sidAllocator            sid.Allocator
+	portAllocator           port.Allocator
 	rootDir                 string
 }
 
@@ -173,6 +175,10 @@ func (impl *nsmdTestServiceRegistry) SIDAllocator() sid.Allocator {
 	return impl.sidAllocator
 }
 
+func (impl *nsmdTestServiceRegistry) PortAllocator() port.Allocator {
+	return impl.portAllocator
+}
+
 func (impl *nsmdTestServiceRegistry) VniAllocator() vni.VniAllocator {
 	return impl.vniAllocator
 }
@@ -339,6 +345,10 @@ func (impl *nsmdTestServiceRegistry) NsmRegistryClient(context.Context) (registr
 	return impl.nseRegistry, nil
 }
 
+func (impl *nsmdTestServiceRegistry) PodEventRecorderClient(context.Context) (registry.PodEventRecorderClient, error) {
+	return nil, errors.New("pod events are not recorded in tests")
+}
+
 func (impl *nsmdTestServiceRegistry) Stop() {
 	logrus.Printf("Delete temporary workspace root: %s", impl.rootDir)
 	os.RemoveAll(impl.rootDir)
@@ -361,6 +371,14 @@ func (impl *testApiRegistry) NewPublicListener(nsmdAPIAddress string) (net.Liste
 	return listener, err
 }
 
+func (impl *testApiRegistry) NewAdminListener() (net.Listener, error) {
+	dir, err := ioutil.TempDir("", "nsmd_admin_test")
+	if err != nil {
+		return nil, err
+	}
+	return net.Listen("unix", path.Join(dir, "nsm.admin.io.sock"))
+}
+
 func newTestApiRegistry() *testApiRegistry {
 	return &testApiRegistry{
 		nsmdPort:       0,
@@ -412,6 +430,11 @@ func (srv *nsmdFullServerImpl) Stop() {
 	}
 }
 
+// ServiceRegistry - returns a service registry to talk to the server
+func (srv *nsmdFullServerImpl) ServiceRegistry() serviceregistry.ServiceRegistry {
+	return srv.serviceRegistry
+}
+
 func (srv *nsmdFullServerImpl) StopNoClean() {
 	if srv.nsmServer != nil {
 		srv.nsmServer.Stop()
@@ -536,8 +559,9 @@ func newNSMDFullServerAt(ctx context.Context, nsmgrName string, storage *sharedS
 			prefixPool:           prefixPool,
 			requestHandleCounter: 0,
 		},
-		vniAllocator: vni.NewVniAllocator(),
-		rootDir:      rootDir,
+		vniAllocator:  vni.NewVniAllocator(),
+		portAllocator: port.NewPortAllocator(),
+		rootDir:       rootDir,
 	}
 
 	srv.TestModel = testModel
@@ -669,7 +693,7 @@ func readNMSDCrossConnectEvents(address string, count int) []*crossconnect.Cross
 	forwarderClient := crossconnect.NewMonitorCrossConnectClient(conn)
 
 	// Looping indefinitely or until grpc returns an error indicating the other end closed connection.
-	stream, err := forwarderClient.MonitorCrossConnects(context.Background(), &empty.Empty{})
+	stream, err := forwarderClient.MonitorCrossConnects(context.Background(), &connection.MonitorScopeSelector{})
 	if err != nil {
 		logrus.Warningf("Error: %+v.", err)
 		return nil
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/geneve"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/gre"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+)
+
+func testRemoteTunnel(t *testing.T, mechanismType, idKey string) {
+	g := NewWithT(t)
+
+	srv, srv2 := newRemoteMechanismTestServers(
+		newTunnelTestForwarder("test_data_plane", "127.0.0.1", mechanismType),
+		newTunnelTestForwarder("test_data_plane2", "127.0.0.2", vxlan.MECHANISM, mechanismType),
+		nil)
+	defer srv.Stop()
+	defer srv2.Stop()
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer func() { _ = conn.Close() }()
+
+	_, err := nsmClient.Request(context.Background(), newRemoteMechanismTestRequest(map[string]string{}))
+	g.Expect(err).To(BeNil())
+
+	xcons := srv2.serviceRegistry.testForwarderConnection.connections
+	g.Expect(len(xcons)).To(Equal(1))
+
+	m := xcons[0].GetSource().GetMechanism()
+	g.Expect(m.GetType()).To(Equal(mechanismType))
+	g.Expect(m.IsValid()).To(BeNil())
+	g.Expect(m.GetParameters()[gre.SrcIP]).To(Equal("127.0.0.1"))
+	g.Expect(m.GetParameters()[gre.DstIP]).To(Equal("127.0.0.2"))
+	id, err := strconv.Atoi(m.GetParameters()[idKey])
+	g.Expect(err).To(BeNil())
+	g.Expect(id).To(BeNumerically(">", 0))
+}
+
+func TestNSMDRemoteGRE(t *testing.T) {
+	testRemoteTunnel(t, gre.MECHANISM, gre.Key)
+}
+
+func TestNSMDRemoteGENEVE(t *testing.T) {
+	testRemoteTunnel(t, geneve.MECHANISM, geneve.VNI)
+}
+
+func TestTunnelMechanismValidation(t *testing.T) {
+	g := NewWithT(t)
+
+	m := &connection.Mechanism{
+		Type: gre.MECHANISM,
+		Parameters: map[string]string{
+			gre.SrcIP: "127.0.0.1",
+			gre.DstIP: "127.0.0.2",
+		},
+	}
+	g.Expect(m.IsValid()).NotTo(BeNil())
+	m.Parameters[gre.Key] = "0"
+	g.Expect(m.IsValid()).NotTo(BeNil())
+	m.Parameters[gre.Key] = "4294967295"
+	g.Expect(m.IsValid()).To(BeNil())
+
+	m = &connection.Mechanism{
+		Type: geneve.MECHANISM,
+		Parameters: map[string]string{
+			geneve.SrcIP: "127.0.0.1",
+			geneve.DstIP: "127.0.0.2",
+			geneve.VNI:   "16777216",
+		},
+	}
+	g.Expect(m.IsValid()).NotTo(BeNil())
+	m.Parameters[geneve.VNI] = "16777215"
+	g.Expect(m.IsValid()).To(BeNil())
+}
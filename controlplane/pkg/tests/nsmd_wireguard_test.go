@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/port"
+)
+
+func newRemoteMechanismTestServers(clientForwarder, endpointForwarder *model.Forwarder, endpointLabels map[string]string) (*nsmdFullServerImpl, *nsmdFullServerImpl) {
+	storage := NewSharedStorage()
+	srv := NewNSMDFullServer(Master, storage)
+	srv2 := NewNSMDFullServer(Worker, storage)
+
+	srv.TestModel.AddForwarder(context.Background(), clientForwarder)
+	srv2.TestModel.AddForwarder(context.Background(), endpointForwarder)
+
+	nseReg := srv2.RegisterFakeEndpoint("golden_network", "test", Worker)
+	nseReg.Endpoint.NetworkServiceEndpoint.Labels = endpointLabels
+	srv2.TestModel.AddEndpoint(context.Background(), nseReg)
+	return srv, srv2
+}
+
+func newRemoteMechanismTestRequest(labels map[string]string) *networkservice.NetworkServiceRequest {
+	return &networkservice.NetworkServiceRequest{
+		Connection: &connection.Connection{
+			NetworkService: "golden_network",
+			Context: &connectioncontext.ConnectionContext{
+				IpContext: &connectioncontext.IPContext{
+					DstIpRequired: true,
+					SrcIpRequired: true,
+				},
+			},
+			Labels: labels,
+		},
+		MechanismPreferences: []*connection.Mechanism{
+			{
+				Type: kernel.MECHANISM,
+				Parameters: map[string]string{
+					common.NetNsInodeKey:    "10",
+					common.InterfaceNameKey: "icmp-responder1",
+				},
+			},
+		},
+	}
+}
+
+func TestNSMDRemoteWireGuardRequiredByClient(t *testing.T) {
+	g := NewWithT(t)
+
+	srv, srv2 := newRemoteMechanismTestServers(testWireGuardForwarder1, testWireGuardForwarder2, nil)
+	defer srv.Stop()
+	defer srv2.Stop()
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer func() { _ = conn.Close() }()
+
+	nsmConn, err := nsmClient.Request(context.Background(), newRemoteMechanismTestRequest(map[string]string{
+		connection.RemoteMechanismKey: wireguard.MECHANISM,
+	}))
+	g.Expect(err).To(BeNil())
+	g.Expect(srv.serviceRegistry.PortAllocator().Allocated(nsmConn.GetId())).To(BeTrue())
+
+	xcons := srv2.serviceRegistry.testForwarderConnection.connections
+	g.Expect(len(xcons)).To(Equal(1))
+
+	m := xcons[0].GetSource().GetMechanism()
+	g.Expect(m.GetType()).To(Equal(wireguard.MECHANISM))
+	g.Expect(m.GetParameters()[wireguard.SrcIP]).To(Equal("127.0.0.1"))
+	g.Expect(m.GetParameters()[wireguard.DstIP]).To(Equal("127.0.0.2"))
+	g.Expect(m.GetParameters()[wireguard.AllowedIPs]).To(Equal(wireguard.DefaultAllowedIPs))
+
+	wg := wireguard.ToMechanism(m)
+	srcKey, err := wg.SrcPublicKey()
+	g.Expect(err).To(BeNil())
+	g.Expect(srcKey[0]).To(Equal(byte(1)))
+	dstKey, err := wg.DstPublicKey()
+	g.Expect(err).To(BeNil())
+	g.Expect(dstKey[0]).To(Equal(byte(2)))
+	_, err = wg.SrcPort()
+	g.Expect(err).To(BeNil())
+	_, err = wg.DstPort()
+	g.Expect(err).To(BeNil())
+}
+
+func TestNSMDRemoteWireGuardRequiredByEndpoint(t *testing.T) {
+	g := NewWithT(t)
+
+	srv, srv2 := newRemoteMechanismTestServers(testWireGuardForwarder1, testWireGuardForwarder2, map[string]string{
+		connection.RemoteMechanismKey: wireguard.MECHANISM,
+	})
+	defer srv.Stop()
+	defer srv2.Stop()
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer func() { _ = conn.Close() }()
+
+	_, err := nsmClient.Request(context.Background(), newRemoteMechanismTestRequest(map[string]string{}))
+	g.Expect(err).To(BeNil())
+
+	xcons := srv2.serviceRegistry.testForwarderConnection.connections
+	g.Expect(len(xcons)).To(Equal(1))
+	g.Expect(xcons[0].GetSource().GetMechanism().GetType()).To(Equal(wireguard.MECHANISM))
+}
+
+func TestNSMDRemoteWireGuardNotSupported(t *testing.T) {
+	g := NewWithT(t)
+
+	srv, srv2 := newRemoteMechanismTestServers(testWireGuardForwarder1, testForwarder2, nil)
+	defer srv.Stop()
+	defer srv2.Stop()
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer func() { _ = conn.Close() }()
+
+	_, err := nsmClient.Request(context.Background(), newRemoteMechanismTestRequest(map[string]string{
+		connection.RemoteMechanismKey: wireguard.MECHANISM,
+	}))
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(len(srv2.serviceRegistry.testForwarderConnection.connections)).To(Equal(0))
+}
+
+func TestNSMDRemoteWireGuardNotRequired(t *testing.T) {
+	g := NewWithT(t)
+
+	srv, srv2 := newRemoteMechanismTestServers(testWireGuardForwarder1, testWireGuardForwarder2, nil)
+	defer srv.Stop()
+	defer srv2.Stop()
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer func() { _ = conn.Close() }()
+
+	nsmConn, err := nsmClient.Request(context.Background(), newRemoteMechanismTestRequest(map[string]string{}))
+	g.Expect(err).To(BeNil())
+	// WireGuard port is not held by a connection using other mechanism
+	g.Expect(srv.serviceRegistry.PortAllocator().Allocated(nsmConn.GetId())).To(BeFalse())
+
+	xcons := srv2.serviceRegistry.testForwarderConnection.connections
+	g.Expect(len(xcons)).To(Equal(1))
+	g.Expect(xcons[0].GetSource().GetMechanism().GetType()).To(Equal(vxlan.MECHANISM))
+}
+
+func TestNSMDRemoteWireGuardPortsExhausted(t *testing.T) {
+	g := NewWithT(t)
+
+	srv, srv2 := newRemoteMechanismTestServers(testWireGuardForwarder1, testWireGuardForwarder2, nil)
+	defer srv.Stop()
+	defer srv2.Stop()
+	srv.serviceRegistry.portAllocator = port.NewPortAllocatorOf(port.DefaultFirstPort, port.DefaultFirstPort)
+	srv.serviceRegistry.portAllocator.Port("other")
+
+	nsmClient, conn := srv.requestNSMConnection("nsm-1")
+	defer func() { _ = conn.Close() }()
+
+	_, err := nsmClient.Request(context.Background(), newRemoteMechanismTestRequest(map[string]string{
+		connection.RemoteMechanismKey: wireguard.MECHANISM,
+	}))
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(len(srv2.serviceRegistry.testForwarderConnection.connections)).To(Equal(0))
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/pkg/security"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
+)
+
+// forwardingHop - signs a path segment of a hop on behalf of a verified request and passes it to a next hop
+type forwardingHop struct {
+	provider security.Provider
+	path     *connection.Path
+	next     networkservice.NetworkServiceServer
+}
+
+func (h *forwardingHop) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	outgoing := &networkservice.NetworkServiceRequest{
+		Connection: &connection.Connection{
+			Id:             "-",
+			NetworkService: request.GetConnection().GetNetworkService(),
+			Path:           h.path,
+		},
+	}
+	if err := pathtoken.Sign(ctx, h.provider, outgoing.Connection.Path, pathtoken.ChainToken(ctx)); err != nil {
+		return nil, err
+	}
+	return h.next.Request(context.Background(), outgoing)
+}
+
+func (h *forwardingHop) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+// chainRecorder - records a verified chain of a request
+type chainRecorder struct {
+	chain []*security.PathClaims
+}
+
+func (r *chainRecorder) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	r.chain = pathtoken.Chain(ctx)
+	return request.GetConnection(), nil
+}
+
+func (r *chainRecorder) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+func newPathProviders(g *WithT, names ...string) (*security.SelfSignedCA, map[string]security.Provider) {
+	ca, err := security.NewSelfSignedCA("test.com")
+	g.Expect(err).To(BeNil())
+	providers := map[string]security.Provider{}
+	for _, name := range names {
+		providers[name], err = ca.NewProvider(name)
+		g.Expect(err).To(BeNil())
+	}
+	return ca, providers
+}
+
+func newSignedClientRequest(g *WithT, provider security.Provider) *networkservice.NetworkServiceRequest {
+	request := &networkservice.NetworkServiceRequest{
+		Connection: &connection.Connection{
+			NetworkService: "golden_network",
+			Path:           common.Strings2Path("client"),
+		},
+	}
+	g.Expect(pathtoken.Sign(context.Background(), provider, request.Connection.Path, "")).To(BeNil())
+	return request
+}
+
+func TestPathVerifierChain(t *testing.T) {
+	g := NewWithT(t)
+
+	_, providers := newPathProviders(g, "client", Master, Worker)
+	recorder := &chainRecorder{}
+
+	remote := common.NewCompositeService("Remote",
+		common.NewPathVerifierService(providers[Worker]),
+		recorder,
+	)
+	local := common.NewCompositeService("Local",
+		common.NewPathVerifierService(providers[Master]),
+		&forwardingHop{
+			provider: providers[Master],
+			path:     common.Strings2Path(Master, Worker),
+			next:     remote,
+		},
+	)
+
+	_, err := local.Request(context.Background(), newSignedClientRequest(g, providers["client"]))
+	g.Expect(err).To(BeNil())
+	g.Expect(len(recorder.chain)).To(Equal(2))
+	g.Expect(recorder.chain[0].Subject).To(Equal("spiffe://test.com/client"))
+	g.Expect(recorder.chain[1].Subject).To(Equal("spiffe://test.com/" + Master))
+}
+
+func TestPathVerifierRefusesForgedPath(t *testing.T) {
+	g := NewWithT(t)
+
+	_, providers := newPathProviders(g, Worker)
+	_, forgers := newPathProviders(g, Master)
+	verifier := common.NewCompositeService("Remote",
+		common.NewPathVerifierService(providers[Worker]),
+	)
+
+	// Not signed remote request
+	request := &networkservice.NetworkServiceRequest{
+		Connection: &connection.Connection{
+			NetworkService: "golden_network",
+			Path:           common.Strings2Path(Master, Worker),
+		},
+	}
+	_, err := verifier.Request(context.Background(), request)
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	// Signed by untrusted identity
+	g.Expect(pathtoken.Sign(context.Background(), forgers[Master], request.Connection.Path, "")).To(BeNil())
+	_, err = verifier.Request(context.Background(), request)
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	// Local clients could skip signing
+	_, err = verifier.Request(context.Background(), &networkservice.NetworkServiceRequest{
+		Connection: &connection.Connection{
+			NetworkService: "golden_network",
+		},
+	})
+	g.Expect(err).To(BeNil())
+}
+
+func TestPathVerifierRefusesMismatchedSegment(t *testing.T) {
+	g := NewWithT(t)
+
+	_, providers := newPathProviders(g, Master, Worker)
+	verifier := common.NewCompositeService("Remote",
+		common.NewPathVerifierService(providers[Worker]),
+	)
+
+	// Token signed for another path segment
+	request := &networkservice.NetworkServiceRequest{
+		Connection: &connection.Connection{
+			NetworkService: "golden_network",
+			Path:           common.Strings2Path(Master, Worker),
+		},
+	}
+	g.Expect(pathtoken.Sign(context.Background(), providers[Master], request.Connection.Path, "")).To(BeNil())
+	request.Connection.Path.PathSegments[0].Name = "forged"
+	_, err := verifier.Request(context.Background(), request)
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+}
+
+func TestPathSignRefusesExpiredObo(t *testing.T) {
+	g := NewWithT(t)
+
+	_, providers := newPathProviders(g, "client", Master)
+	cert, err := providers["client"].GetCertificate(context.Background())
+	g.Expect(err).To(BeNil())
+	expired, err := security.GenerateToken(cert, &security.PathClaims{
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+		},
+		Segment: "client",
+	})
+	g.Expect(err).To(BeNil())
+
+	path := common.Strings2Path(Master, Worker)
+	g.Expect(pathtoken.Sign(context.Background(), providers[Master], path, expired)).NotTo(BeNil())
+	g.Expect(path.GetPathSegments()[0].GetToken()).To(BeEmpty())
+
+	g.Expect(pathtoken.Sign(context.Background(), providers[Master], path, "not a token")).NotTo(BeNil())
+	g.Expect(path.GetPathSegments()[0].GetToken()).To(BeEmpty())
+}
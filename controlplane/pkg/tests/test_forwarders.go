@@ -2,8 +2,10 @@ package tests
 
 import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
 )
 
@@ -62,3 +64,77 @@ var testForwarder2 = &model.Forwarder{
 	},
 	MechanismsConfigured: true,
 }
+
+var testWireGuardForwarder1 = &model.Forwarder{
+	RegisteredName: "test_data_plane_wg",
+	SocketLocation: "tcp:some_addr",
+	LocalMechanisms: []*connection.Mechanism{
+		{
+			Type: kernel.MECHANISM,
+		},
+	},
+	RemoteMechanisms: []*connection.Mechanism{
+		{
+			Type: vxlan.MECHANISM,
+			Parameters: map[string]string{
+				vxlan.SrcIP: "127.0.0.1",
+			},
+		},
+		{
+			Type: wireguard.MECHANISM,
+			Parameters: map[string]string{
+				wireguard.SrcIP:        "127.0.0.1",
+				wireguard.SrcPublicKey: "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=",
+			},
+		},
+	},
+	MechanismsConfigured: true,
+}
+
+var testWireGuardForwarder2 = &model.Forwarder{
+	RegisteredName: "test_data_plane_wg2",
+	SocketLocation: "tcp:some_addr",
+	LocalMechanisms: []*connection.Mechanism{
+		{
+			Type: kernel.MECHANISM,
+		},
+	},
+	RemoteMechanisms: []*connection.Mechanism{
+		{
+			Type: vxlan.MECHANISM,
+			Parameters: map[string]string{
+				vxlan.SrcIP: "127.0.0.2",
+			},
+		},
+		{
+			Type: wireguard.MECHANISM,
+			Parameters: map[string]string{
+				wireguard.SrcIP:        "127.0.0.2",
+				wireguard.SrcPublicKey: "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+			},
+		},
+	},
+	MechanismsConfigured: true,
+}
+
+func newTunnelTestForwarder(name, srcIP string, mechanismTypes ...string) *model.Forwarder {
+	forwarder := &model.Forwarder{
+		RegisteredName: name,
+		SocketLocation: "tcp:some_addr",
+		LocalMechanisms: []*connection.Mechanism{
+			{
+				Type: kernel.MECHANISM,
+			},
+		},
+		MechanismsConfigured: true,
+	}
+	for _, mechanismType := range mechanismTypes {
+		forwarder.RemoteMechanisms = append(forwarder.RemoteMechanisms, &connection.Mechanism{
+			Type: mechanismType,
+			Parameters: map[string]string{
+				common.SrcIP: srcIP,
+			},
+		})
+	}
+	return forwarder
+}
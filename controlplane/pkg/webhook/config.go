@@ -0,0 +1,74 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const (
+	// URLsEnv - comma separated list of HTTP endpoints connection events are posted to, empty disables webhooks
+	URLsEnv = utils.EnvVar("NSMD_WEBHOOK_URLS")
+	// SecretEnv - key to sign posted events with HMAC-SHA256, events are not signed if it is empty
+	SecretEnv = utils.EnvVar("NSMD_WEBHOOK_SECRET")
+	// BatchSizeEnv - max amount of events posted at once
+	BatchSizeEnv = utils.EnvVar("NSMD_WEBHOOK_BATCH_SIZE")
+	// BatchIntervalEnv - max time an event waits for a batch to be filled up before it is posted
+	BatchIntervalEnv = utils.EnvVar("NSMD_WEBHOOK_BATCH_INTERVAL")
+	// RetriesEnv - amount of retries of a failed post before events are dropped
+	RetriesEnv = utils.EnvVar("NSMD_WEBHOOK_RETRIES")
+	// TimeoutEnv - timeout of a single post
+	TimeoutEnv = utils.EnvVar("NSMD_WEBHOOK_TIMEOUT")
+
+	defaultBatchSize     = 50
+	defaultBatchInterval = time.Second
+	defaultRetries       = 5
+	defaultTimeout       = 5 * time.Second
+	// queueSize - max amount of events waiting to be posted to a single endpoint, newer events are dropped
+	queueSize = 1000
+)
+
+// Config - webhooks configuration
+type Config struct {
+	URLs          []string
+	Secret        []byte
+	BatchSize     int
+	BatchInterval time.Duration
+	Retries       int
+	Timeout       time.Duration
+}
+
+// NewConfigFromEnv - reads webhooks configuration from environment
+func NewConfigFromEnv() *Config {
+	var urls []string
+	for _, url := range strings.Split(URLsEnv.StringValue(), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return &Config{
+		URLs:          urls,
+		Secret:        []byte(SecretEnv.StringValue()),
+		BatchSize:     BatchSizeEnv.GetIntOrDefault(defaultBatchSize),
+		BatchInterval: BatchIntervalEnv.GetOrDefaultDuration(defaultBatchInterval),
+		Retries:       RetriesEnv.GetIntOrDefault(defaultRetries),
+		Timeout:       TimeoutEnv.GetOrDefaultDuration(defaultTimeout),
+	}
+}
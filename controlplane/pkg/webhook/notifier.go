@@ -0,0 +1,201 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook posts connection lifecycle events of NSMD to HTTP endpoints
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+// EventType - connection lifecycle event type
+type EventType string
+
+const (
+	// EventEstablished - connection is established for the first time
+	EventEstablished EventType = "established"
+	// EventUpdated - established connection is re-requested by a client
+	EventUpdated EventType = "updated"
+	// EventHealed - connection is recovered by NSMD healing
+	EventHealed EventType = "healed"
+	// EventClosed - established connection is closed
+	EventClosed EventType = "closed"
+	// EventFailed - connection failed to be established
+	EventFailed EventType = "failed"
+)
+
+// Event - connection lifecycle event posted to webhooks
+type Event struct {
+	Type           EventType         `json:"type"`
+	Time           time.Time         `json:"time"`
+	ConnectionID   string            `json:"connectionId"`
+	NetworkService string            `json:"networkService"`
+	Endpoint       string            `json:"endpoint,omitempty"`
+	Pod            string            `json:"pod,omitempty"`
+	Namespace      string            `json:"namespace,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+}
+
+// Notifier - model listener posting client connections lifecycle events to webhooks, only connections of local
+// clients are reported, remote connections are reported by NSMD of the client
+type Notifier struct {
+	model.ListenerImpl
+
+	sinks []*sink
+
+	lock    sync.RWMutex
+	stopped bool
+	// healing - connections being healed, so they are reported healed instead of updated once ready
+	healing map[string]bool
+}
+
+// NewNotifier - creates a notifier posting events to config.URLs, nil if there are no URLs
+func NewNotifier(config *Config) *Notifier {
+	if len(config.URLs) == 0 {
+		return nil
+	}
+	n := &Notifier{
+		healing: map[string]bool{},
+	}
+	for _, url := range config.URLs {
+		n.sinks = append(n.sinks, newSink(url, config))
+	}
+	return n
+}
+
+// ClientConnectionAdded - restored ready connections are reported established
+func (n *Notifier) ClientConnectionAdded(_ context.Context, cc *model.ClientConnection) {
+	if cc.ConnectionState == model.ClientConnectionReady && !isRemote(cc) {
+		n.notify(EventEstablished, cc)
+	}
+}
+
+// ClientConnectionUpdated - reports connections becoming ready
+func (n *Notifier) ClientConnectionUpdated(_ context.Context, old, new *model.ClientConnection) {
+	if isRemote(new) {
+		return
+	}
+	if new.ConnectionState == model.ClientConnectionHealingBegin {
+		n.setHealing(new.GetID(), true)
+		return
+	}
+	if new.ConnectionState != model.ClientConnectionReady {
+		return
+	}
+	switch old.ConnectionState {
+	case model.ClientConnectionRequesting:
+		n.notify(EventEstablished, new)
+	case model.ClientConnectionHealingBegin, model.ClientConnectionHealing:
+		if n.setHealing(new.GetID(), false) {
+			n.notify(EventHealed, new)
+		} else {
+			n.notify(EventUpdated, new)
+		}
+	}
+}
+
+// ClientConnectionDeleted - reports connections closed or failed to be established
+func (n *Notifier) ClientConnectionDeleted(_ context.Context, cc *model.ClientConnection) {
+	if isRemote(cc) {
+		return
+	}
+	n.setHealing(cc.GetID(), false)
+	switch cc.ConnectionState {
+	case model.ClientConnectionRequesting, model.ClientConnectionBroken:
+		n.notify(EventFailed, cc)
+	default:
+		n.notify(EventClosed, cc)
+	}
+}
+
+// Stop - stops notifier, events waiting to be posted are posted once without retries
+func (n *Notifier) Stop() {
+	n.lock.Lock()
+	if n.stopped {
+		n.lock.Unlock()
+		return
+	}
+	n.stopped = true
+	n.lock.Unlock()
+
+	for _, s := range n.sinks {
+		s.stop()
+	}
+}
+
+// setHealing - marks connection as being healed or not, returns previous mark
+func (n *Notifier) setHealing(id string, healing bool) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	rv := n.healing[id]
+	if healing {
+		n.healing[id] = true
+	} else {
+		delete(n.healing, id)
+	}
+	return rv
+}
+
+func (n *Notifier) notify(eventType EventType, cc *model.ClientConnection) {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+
+	if n.stopped {
+		return
+	}
+	event := newEvent(eventType, cc)
+	logrus.Infof("Webhook event %v of connection %v", event.Type, event.ConnectionID)
+	for _, s := range n.sinks {
+		s.push(event)
+	}
+}
+
+// isRemote - returns if connection is requested by a remote NSMD
+func isRemote(cc *model.ClientConnection) bool {
+	if src := cc.GetConnectionSource(); src != nil {
+		return src.IsRemote()
+	}
+	return cc.Request.GetConnection().IsRemote()
+}
+
+func newEvent(eventType EventType, cc *model.ClientConnection) *Event {
+	networkService := cc.GetNetworkService()
+	if networkService == "" {
+		networkService = cc.Request.GetConnection().GetNetworkService()
+	}
+	labels := cc.Request.GetConnection().GetLabels()
+	if src := cc.GetConnectionSource(); src != nil {
+		labels = src.GetLabels()
+	}
+	return &Event{
+		Type:           eventType,
+		Time:           time.Now(),
+		ConnectionID:   cc.GetID(),
+		NetworkService: networkService,
+		Endpoint:       cc.Endpoint.GetNetworkServiceEndpoint().GetName(),
+		Pod:            labels[connection.PodNameKey],
+		Namespace:      labels[connection.NamespaceKey],
+		Labels:         labels,
+	}
+}
@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/model"
+)
+
+type testWebhook struct {
+	lock     sync.Mutex
+	failures int
+	events   []*Event
+	signed   bool
+}
+
+func (w *testWebhook) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.failures > 0 {
+		w.failures--
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := ioutil.ReadAll(request.Body)
+	w.signed = request.Header.Get(SignatureHeader) == "sha256="+Sign([]byte("secret"), body)
+	b := &batch{}
+	_ = json.Unmarshal(body, b)
+	w.events = append(w.events, b.Events...)
+}
+
+func (w *testWebhook) received() (events []*Event, signed bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return append([]*Event{}, w.events...), w.signed
+}
+
+func (w *testWebhook) eventTypes() []EventType {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	var rv []EventType
+	for _, event := range w.events {
+		rv = append(rv, event.Type)
+	}
+	return rv
+}
+
+func newClientConnection(state model.ClientConnectionState) *model.ClientConnection {
+	return &model.ClientConnection{
+		ConnectionID:    "1",
+		ConnectionState: state,
+		Request: &networkservice.NetworkServiceRequest{
+			Connection: &connection.Connection{
+				NetworkService: "golden-network",
+				Labels:         map[string]string{connection.PodNameKey: "pod", connection.NamespaceKey: "default"},
+			},
+		},
+	}
+}
+
+func TestNotifierPostsLifecycleEvents(t *testing.T) {
+	g := NewWithT(t)
+
+	webhook := &testWebhook{failures: 1}
+	server := httptest.NewServer(webhook)
+	defer server.Close()
+
+	notifier := NewNotifier(&Config{
+		URLs:          []string{server.URL},
+		Secret:        []byte("secret"),
+		BatchSize:     10,
+		BatchInterval: 10 * time.Millisecond,
+		Retries:       1,
+		Timeout:       time.Second,
+	})
+	defer notifier.Stop()
+
+	ctx := context.Background()
+	ready := newClientConnection(model.ClientConnectionReady)
+	notifier.ClientConnectionUpdated(ctx, newClientConnection(model.ClientConnectionRequesting), ready)
+	notifier.ClientConnectionUpdated(ctx, ready, newClientConnection(model.ClientConnectionHealingBegin))
+	notifier.ClientConnectionUpdated(ctx, newClientConnection(model.ClientConnectionHealing), ready)
+	notifier.ClientConnectionUpdated(ctx, newClientConnection(model.ClientConnectionHealing), ready)
+	notifier.ClientConnectionDeleted(ctx, newClientConnection(model.ClientConnectionClosing))
+	notifier.ClientConnectionDeleted(ctx, newClientConnection(model.ClientConnectionRequesting))
+
+	// Remote connections are reported by NSMD of the client
+	remote := newClientConnection(model.ClientConnectionReady)
+	remote.Request.Connection.Path = &connection.Path{
+		PathSegments: []*connection.PathSegment{{Name: "nsm-1"}, {Name: "nsm-2"}},
+	}
+	notifier.ClientConnectionAdded(ctx, remote)
+	notifier.ClientConnectionUpdated(ctx, newClientConnection(model.ClientConnectionRequesting), remote)
+	notifier.ClientConnectionDeleted(ctx, remote)
+
+	g.Eventually(webhook.eventTypes, 5*time.Second).Should(Equal([]EventType{
+		EventEstablished, EventHealed, EventUpdated, EventClosed, EventFailed,
+	}))
+	g.Consistently(webhook.eventTypes, 100*time.Millisecond).Should(HaveLen(5))
+	events, signed := webhook.received()
+	g.Expect(signed).To(BeTrue())
+	g.Expect(events[0].NetworkService).To(Equal("golden-network"))
+	g.Expect(events[0].Pod).To(Equal("pod"))
+	g.Expect(events[0].Namespace).To(Equal("default"))
+}
+
+func TestNotifierIsDisabledWithoutURLs(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(NewNotifier(&Config{})).To(BeNil())
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SignatureHeader - header with hex encoded HMAC-SHA256 of a request body prefixed with "sha256="
+	SignatureHeader = "X-Nsm-Signature"
+
+	initialRetryInterval = 500 * time.Millisecond
+	maxRetryInterval     = 30 * time.Second
+)
+
+// batch - events posted at once
+type batch struct {
+	Events []*Event `json:"events"`
+}
+
+// sink - batches and posts events to a single endpoint
+type sink struct {
+	url    string
+	config *Config
+	client *http.Client
+	events chan *Event
+	// stopping - closed to stop retries and post remaining events
+	stopping chan struct{}
+	done     chan struct{}
+}
+
+func newSink(url string, config *Config) *sink {
+	s := &sink{
+		url:      url,
+		config:   config,
+		client:   &http.Client{Timeout: config.Timeout},
+		events:   make(chan *Event, queueSize),
+		stopping: make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// push - queues event, event is dropped if endpoint is too far behind
+func (s *sink) push(event *Event) {
+	select {
+	case s.events <- event:
+	default:
+		logrus.Errorf("Webhook %v is %v events behind, dropping event %v of connection %v", s.url, queueSize, event.Type, event.ConnectionID)
+	}
+}
+
+func (s *sink) stop() {
+	close(s.stopping)
+	<-s.done
+}
+
+func (s *sink) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.config.BatchInterval)
+	defer ticker.Stop()
+
+	var events []*Event
+	for {
+		select {
+		case event := <-s.events:
+			events = append(events, event)
+			if len(events) < s.config.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-s.stopping:
+			for len(s.events) > 0 {
+				events = append(events, <-s.events)
+			}
+			s.send(events)
+			return
+		}
+		s.send(events)
+		events = nil
+	}
+}
+
+// send - posts events retrying with exponential backoff, events are dropped after all retries failed
+func (s *sink) send(events []*Event) {
+	if len(events) == 0 {
+		return
+	}
+	body, err := json.Marshal(&batch{Events: events})
+	if err != nil {
+		logrus.Errorf("Webhook %v failed to marshal events: %v", s.url, err)
+		return
+	}
+
+	interval := initialRetryInterval
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(body)
+		if err == nil {
+			return
+		}
+		if !retry || attempt >= s.config.Retries {
+			logrus.Errorf("Webhook %v dropped %v events after %v attempts: %v", s.url, len(events), attempt+1, err)
+			return
+		}
+		logrus.Warnf("Webhook %v failed, retrying in %v: %v", s.url, interval, err)
+		select {
+		case <-s.stopping:
+			logrus.Errorf("Webhook %v dropped %v events on stop: %v", s.url, len(events), err)
+			return
+		case <-time.After(interval):
+		}
+		if interval *= 2; interval > maxRetryInterval {
+			interval = maxRetryInterval
+		}
+	}
+}
+
+// post - posts body once, returns if a failed post should be retried
+func (s *sink) post(body []byte) (bool, error) {
+	request, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if len(s.config.Secret) > 0 {
+		request.Header.Set(SignatureHeader, "sha256="+Sign(s.config.Secret, body))
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	_ = response.Body.Close()
+	if response.StatusCode/100 == 2 {
+		return true, nil
+	}
+	err = errors.Errorf("unexpected response status %v", response.Status)
+	// Client errors are not going to be fixed by retries, except of throttling
+	return response.StatusCode/100 != 4 || response.StatusCode == http.StatusTooManyRequests, err
+}
+
+// Sign - returns hex encoded HMAC-SHA256 of body with secret
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
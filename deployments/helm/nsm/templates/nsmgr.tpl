@@ -32,6 +32,9 @@ spec:
           volumeMounts:
             - name: kubelet-socket
               mountPath: /var/lib/kubelet/device-plugins
+            - name: kubelet-pod-resources
+              mountPath: /var/lib/kubelet/pod-resources
+              readOnly: true
             - name: nsm-socket
               mountPath: /var/lib/networkservicemesh
             - name: spire-agent-socket
@@ -106,6 +109,10 @@ spec:
             path: /var/lib/kubelet/device-plugins
             type: DirectoryOrCreate
           name: kubelet-socket
+        - hostPath:
+            path: /var/lib/kubelet/pod-resources
+            type: DirectoryOrCreate
+          name: kubelet-pod-resources
         - hostPath:
             path: /var/lib/networkservicemesh
             type: DirectoryOrCreate
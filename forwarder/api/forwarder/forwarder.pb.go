@@ -83,7 +83,7 @@ func init() {
 func init() { proto.RegisterFile("forwarder.proto", fileDescriptor_19bff53f4d11db23) }
 
 var fileDescriptor_19bff53f4d11db23 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
+	// 299 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcd, 0x4e, 0x02, 0x31,
 	0x10, 0xc7, 0xb3, 0x1a, 0x35, 0xd4, 0x03, 0xd0, 0x44, 0x43, 0x7a, 0x32, 0x9e, 0x3c, 0x15, 0x83,
 	0x47, 0x2f, 0x2a, 0xd1, 0xc4, 0x03, 0x17, 0x12, 0x8f, 0x6a, 0x4a, 0x19, 0xa0, 0xb1, 0xed, 0xd4,
@@ -102,7 +102,7 @@ var fileDescriptor_19bff53f4d11db23 = []byte{
 	0x00, 0x8d, 0x80, 0x53, 0x3e, 0x47, 0x9c, 0x6b, 0x48, 0xeb, 0x99, 0x2c, 0x67, 0xfc, 0x3e, 0xdf,
 	0xd6, 0xe0, 0x85, 0x74, 0xab, 0xde, 0x46, 0x68, 0x55, 0x44, 0x4f, 0x1f, 0x49, 0xb7, 0x30, 0x6b,
 	0x93, 0xff, 0x41, 0x60, 0x8c, 0x57, 0x5f, 0xec, 0xc7, 0xc6, 0x2f, 0xb3, 0xc9, 0xe1, 0x26, 0xfb,
-	0xea, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x88, 0x27, 0xe6, 0x88, 0x02, 0x00, 0x00,
+	0xea, 0x7b, 0x00, 0x7a, 0x88, 0x27, 0xe6, 0x88, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -117,6 +117,9 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ForwarderClient interface {
+	// Request programs the cross connect. A request for an already programmed cross connect id updates it in place:
+	// when its mechanisms are unchanged, only routes, neighbors, addresses and MTU of the connection contexts
+	// are adjusted, without recreating the interfaces.
 	Request(ctx context.Context, in *crossconnect.CrossConnect, opts ...grpc.CallOption) (*crossconnect.CrossConnect, error)
 	Close(ctx context.Context, in *crossconnect.CrossConnect, opts ...grpc.CallOption) (*empty.Empty, error)
 }
@@ -149,6 +152,9 @@ func (c *forwarderClient) Close(ctx context.Context, in *crossconnect.CrossConne
 
 // ForwarderServer is the server API for Forwarder service.
 type ForwarderServer interface {
+	// Request programs the cross connect. A request for an already programmed cross connect id updates it in place:
+	// when its mechanisms are unchanged, only routes, neighbors, addresses and MTU of the connection contexts
+	// are adjusted, without recreating the interfaces.
 	Request(context.Context, *crossconnect.CrossConnect) (*crossconnect.CrossConnect, error)
 	Close(context.Context, *crossconnect.CrossConnect) (*empty.Empty, error)
 }
@@ -18,7 +18,10 @@ package kernelforwarder
 import (
 	"github.com/pkg/errors"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/geneve"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/gre"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
 
 	"net"
@@ -26,6 +29,7 @@ import (
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/utils/fs"
 
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
@@ -44,6 +48,8 @@ const (
 	cVETHMTU    = 16000
 	cCONNECT    = true
 	cDISCONNECT = false
+	/* linkAliasPrefix marks interfaces created by the forwarder, it is followed by the cross connect name of the interface */
+	linkAliasPrefix = "nsm:"
 )
 
 type connectionConfig struct {
@@ -52,18 +58,23 @@ type connectionConfig struct {
 	dstNetNsInode string
 	srcName       string
 	dstName       string
-	srcIP         string
-	dstIP         string
+	srcIPs        []string
+	dstIPs        []string
 	srcIPVXLAN    net.IP
 	dstIPVXLAN    net.IP
 	srcRoutes     []*connectioncontext.Route
 	dstRoutes     []*connectioncontext.Route
 	neighbors     []*connectioncontext.IpNeighbor
+	policy        *connectioncontext.PolicyRoutingContext
 	vni           int
+	mtu           int
+	/* remoteMechanism is a mechanism of the remote side of the connection */
+	remoteMechanism *connection.Mechanism
 }
 
-// setupLinkInNs is responsible for configuring an interface inside a given namespace - assigns IP address, routes, etc.
-func setupLinkInNs(containerNs netns.NsHandle, ifaceName, ifaceIP string, routes []*connectioncontext.Route, neighbors []*connectioncontext.IpNeighbor, inject bool) error {
+// setupLinkInNs is responsible for configuring an interface inside a given namespace - assigns IP addresses, routes, etc.
+// Addresses and routes of a client interface are checked for conflicts with the namespace, endpoints own theirs.
+func setupLinkInNs(containerNs netns.NsHandle, ifaceName string, ifaceIPs []string, routes []*connectioncontext.Route, neighbors []*connectioncontext.IpNeighbor, policy *connectioncontext.PolicyRoutingContext, mtu int, inject, client bool) error {
 	if inject {
 		/* Get a link object for the interface */
 		ifaceLink, err := netlink.LinkByName(ifaceName)
@@ -113,25 +124,43 @@ func setupLinkInNs(containerNs netns.NsHandle, ifaceName, ifaceIP string, routes
 		return err
 	}
 	if inject {
-		var addr *netlink.Addr
-		/* Parse the IP address */
-		addr, err = netlink.ParseAddr(ifaceIP)
-		if err != nil {
-			logrus.Errorf("common: failed to parse IP %q: %v", ifaceIP, err)
-			return err
+		/* Check addresses and routes of the client do not conflict with the ones already in the namespace */
+		if client {
+			if err = checkConflicts(link, ifaceIPs, routes, policy); err != nil {
+				logrus.Errorf("common: connection of %q conflicts with the namespace: %v", ifaceName, err)
+				return err
+			}
 		}
-		/* Set IP address */
-		if err = netlink.AddrAdd(link, addr); err != nil {
-			logrus.Errorf("common: failed to set IP %q: %v", ifaceIP, err)
-			return err
+		var addrs []*netlink.Addr
+		for _, ifaceIP := range ifaceIPs {
+			var addr *netlink.Addr
+			/* Parse the IP address */
+			addr, err = netlink.ParseAddr(ifaceIP)
+			if err != nil {
+				logrus.Errorf("common: failed to parse IP %q: %v", ifaceIP, err)
+				return err
+			}
+			/* Set IP address */
+			if err = netlink.AddrAdd(link, addr); err != nil {
+				logrus.Errorf("common: failed to set IP %q: %v", ifaceIP, err)
+				return err
+			}
+			addrs = append(addrs, addr)
+		}
+		/* Set MTU - zero keeps the default one */
+		if mtu > 0 {
+			if err = netlink.LinkSetMTU(link, mtu); err != nil {
+				logrus.Errorf("common: failed to set MTU %d of %q: %v", mtu, ifaceName, err)
+				return err
+			}
 		}
 		/* Bring the interface UP */
 		if err = netlink.LinkSetUp(link); err != nil {
 			logrus.Errorf("common: failed to bring %q up: %v", ifaceName, err)
 			return err
 		}
-		/* Add routes */
-		if err = addRoutes(link, addr, routes); err != nil {
+		/* Add routes - to the policy routing table if there is one */
+		if err = addRoutes(link, addrs, routes, int(policy.GetTable())); err != nil {
 			logrus.Error("common: failed adding routes:", err)
 			return err
 		}
@@ -140,7 +169,20 @@ func setupLinkInNs(containerNs netns.NsHandle, ifaceName, ifaceIP string, routes
 			logrus.Error("common: failed adding neighbors:", err)
 			return err
 		}
+		/* Add policy routing rules - applicable only for source side */
+		for _, rule := range policyRules(policy, ifaceIPs) {
+			if err = netlink.RuleAdd(rule); err != nil {
+				logrus.Errorf("common: failed adding rule %v: %v", rule, err)
+				return err
+			}
+		}
 	} else {
+		/* Delete policy routing rules, routes go away together with the interface */
+		for _, rule := range policyRules(policy, ifaceIPs) {
+			if err = netlink.RuleDel(rule); err != nil {
+				logrus.Errorf("common: failed deleting rule %v: %v", rule, err)
+			}
+		}
 		/* Bring the interface DOWN */
 		if err = netlink.LinkSetDown(link); err != nil {
 			logrus.Errorf("common: failed to bring %q down: %v", ifaceName, err)
@@ -155,6 +197,57 @@ func setupLinkInNs(containerNs netns.NsHandle, ifaceName, ifaceIP string, routes
 	return nil
 }
 
+// inNetNs runs fn in the namespace with the inode, the OS thread must be locked by the caller
+func inNetNs(netNsInode string, fn func() error) error {
+	nsHandle, err := fs.GetNsHandleFromInode(netNsInode)
+	if err != nil {
+		logrus.Errorf("common: failed to get namespace handle - %v", err)
+		return err
+	}
+	defer func() {
+		if closeErr := nsHandle.Close(); closeErr != nil {
+			logrus.Error("common: error when closing namespace handle: ", closeErr)
+		}
+	}()
+	/* Save current network namespace */
+	hostNs, err := netns.Get()
+	if err != nil {
+		logrus.Errorf("common: failed getting host namespace: %v", err)
+		return err
+	}
+	defer func() {
+		if closeErr := hostNs.Close(); closeErr != nil {
+			logrus.Error("common: failed closing host namespace handle: ", closeErr)
+		}
+	}()
+	/* Switch to the desired namespace */
+	if err = netns.Set(nsHandle); err != nil {
+		logrus.Errorf("common: failed switching to desired namespace: %v", err)
+		return err
+	}
+	/* Don't forget to switch back to the host namespace */
+	defer func() {
+		if setErr := netns.Set(hostNs); setErr != nil {
+			logrus.Errorf("common: failed switching back to host namespace: %v", setErr)
+		}
+	}()
+	return fn()
+}
+
+// setLinkAlias marks the interface as created by the forwarder for the cross connect, so it can be found after a restart
+func setLinkAlias(ifaceName, xconName string) error {
+	link, err := netlink.LinkByName(ifaceName)
+	if err != nil {
+		logrus.Errorf("common: failed to get link for %q - %v", ifaceName, err)
+		return err
+	}
+	if err = netlink.LinkSetAlias(link, linkAliasPrefix+xconName); err != nil {
+		logrus.Errorf("common: failed to set alias of %q - %v", ifaceName, err)
+		return err
+	}
+	return nil
+}
+
 //nolint
 func newConnectionConfig(crossConnect *crossconnect.CrossConnect, connType uint8) (*connectionConfig, error) {
 	switch connType {
@@ -165,37 +258,46 @@ func newConnectionConfig(crossConnect *crossconnect.CrossConnect, connType uint8
 			dstNetNsInode: crossConnect.GetDestination().GetMechanism().GetParameters()[common.NetNsInodeKey],
 			srcName:       crossConnect.GetSource().GetMechanism().GetParameters()[common.InterfaceNameKey],
 			dstName:       crossConnect.GetDestination().GetMechanism().GetParameters()[common.InterfaceNameKey],
-			srcIP:         crossConnect.GetSource().GetContext().GetIpContext().GetSrcIpAddr(),
-			dstIP:         crossConnect.GetSource().GetContext().GetIpContext().GetDstIpAddr(),
+			srcIPs:        crossConnect.GetSource().GetContext().GetIpContext().SrcIPAddresses(),
+			dstIPs:        crossConnect.GetSource().GetContext().GetIpContext().DstIPAddresses(),
 			srcRoutes:     crossConnect.GetSource().GetContext().GetIpContext().GetDstRoutes(),
 			dstRoutes:     crossConnect.GetDestination().GetContext().GetIpContext().GetSrcRoutes(),
 			neighbors:     crossConnect.GetSource().GetContext().GetIpContext().GetIpNeighbors(),
+			policy:        crossConnect.GetSource().GetContext().GetPolicyRoutingContext(),
+			mtu:           int(crossConnect.GetSource().GetContext().GetMtu()),
 		}, nil
 	case cINCOMING:
-		vni, _ := strconv.Atoi(crossConnect.GetSource().GetMechanism().GetParameters()[vxlan.VNI])
+		vni := tunnelID(crossConnect.GetSource().GetMechanism())
 		return &connectionConfig{
 			id:            crossConnect.GetId(),
 			dstNetNsInode: crossConnect.GetDestination().GetMechanism().GetParameters()[common.NetNsInodeKey],
 			dstName:       crossConnect.GetDestination().GetMechanism().GetParameters()[common.InterfaceNameKey],
-			dstIP:         crossConnect.GetDestination().GetContext().GetIpContext().GetDstIpAddr(),
+			dstIPs:        crossConnect.GetDestination().GetContext().GetIpContext().DstIPAddresses(),
 			dstRoutes:     crossConnect.GetDestination().GetContext().GetIpContext().GetSrcRoutes(),
 			neighbors:     nil,
 			srcIPVXLAN:    net.ParseIP(crossConnect.GetSource().GetMechanism().GetParameters()[vxlan.SrcIP]),
 			dstIPVXLAN:    net.ParseIP(crossConnect.GetSource().GetMechanism().GetParameters()[vxlan.DstIP]),
 			vni:           vni,
+			mtu:           int(crossConnect.GetDestination().GetContext().GetMtu()),
+
+			remoteMechanism: crossConnect.GetSource().GetMechanism(),
 		}, nil
 	case cOUTGOING:
-		vni, _ := strconv.Atoi(crossConnect.GetDestination().GetMechanism().GetParameters()[vxlan.VNI])
+		vni := tunnelID(crossConnect.GetDestination().GetMechanism())
 		return &connectionConfig{
 			id:            crossConnect.GetId(),
 			srcNetNsInode: crossConnect.GetSource().GetMechanism().GetParameters()[common.NetNsInodeKey],
 			srcName:       crossConnect.GetSource().GetMechanism().GetParameters()[common.InterfaceNameKey],
-			srcIP:         crossConnect.GetSource().GetContext().GetIpContext().GetSrcIpAddr(),
+			srcIPs:        crossConnect.GetSource().GetContext().GetIpContext().SrcIPAddresses(),
 			srcRoutes:     crossConnect.GetSource().GetContext().GetIpContext().GetDstRoutes(),
 			neighbors:     crossConnect.GetSource().GetContext().GetIpContext().GetIpNeighbors(),
+			policy:        crossConnect.GetSource().GetContext().GetPolicyRoutingContext(),
 			srcIPVXLAN:    net.ParseIP(crossConnect.GetDestination().GetMechanism().GetParameters()[vxlan.SrcIP]),
 			dstIPVXLAN:    net.ParseIP(crossConnect.GetDestination().GetMechanism().GetParameters()[vxlan.DstIP]),
 			vni:           vni,
+			mtu:           int(crossConnect.GetSource().GetContext().GetMtu()),
+
+			remoteMechanism: crossConnect.GetDestination().GetMechanism(),
 		}, nil
 	default:
 		logrus.Error("common: connection configuration: invalid connection type")
@@ -203,48 +305,137 @@ func newConnectionConfig(crossConnect *crossconnect.CrossConnect, connType uint8
 	}
 }
 
-// addRoutes adds routes
-func addRoutes(link netlink.Link, addr *netlink.Addr, routes []*connectioncontext.Route) error {
+// routeSrc returns the first address of the same IP family as dst, or nil if there is none
+func routeSrc(addrs []*netlink.Addr, dst net.IP) net.IP {
+	for _, addr := range addrs {
+		if (addr.IP.To4() == nil) == (dst.To4() == nil) {
+			return addr.IP
+		}
+	}
+	return nil
+}
+
+// policyRules returns the netlink rules of the policy routing context, rules without a source
+// are created for every IP family of the interface addresses
+func policyRules(policy *connectioncontext.PolicyRoutingContext, ifaceIPs []string) []*netlink.Rule {
+	var families []int
+	for _, family := range []int{netlink.FAMILY_V4, netlink.FAMILY_V6} {
+		for _, ifaceIP := range ifaceIPs {
+			if ip, _, err := net.ParseCIDR(ifaceIP); err == nil && (ip.To4() == nil) == (family == netlink.FAMILY_V6) {
+				families = append(families, family)
+				break
+			}
+		}
+	}
+	if len(families) == 0 {
+		families = []int{netlink.FAMILY_V4}
+	}
+
+	var rules []*netlink.Rule
+	for _, policyRule := range policy.GetRules() {
+		rule := netlink.NewRule()
+		rule.Table = int(policy.GetTable())
+		if policyRule.GetPriority() > 0 {
+			rule.Priority = int(policyRule.GetPriority())
+		}
+		if policyRule.GetFwmark() > 0 {
+			rule.Mark = int(policyRule.GetFwmark())
+		}
+		if policyRule.GetFrom() != "" {
+			/* The family is taken from the source */
+			_, rule.Src, _ = net.ParseCIDR(policyRule.GetFrom())
+			rules = append(rules, rule)
+			continue
+		}
+		for _, family := range families {
+			familyRule := *rule
+			familyRule.Family = family
+			rules = append(rules, &familyRule)
+		}
+	}
+	return rules
+}
+
+// tunnelID returns an identifier of the tunnel of a remote mechanism - VNI or GRE key
+func tunnelID(m *connection.Mechanism) int {
+	key := vxlan.VNI
+	switch m.GetType() {
+	case gre.MECHANISM:
+		key = gre.Key
+	case geneve.MECHANISM:
+		key = geneve.VNI
+	}
+	id, _ := strconv.Atoi(m.GetParameters()[key])
+	return id
+}
+
+// addRoutes adds routes, using the address of the same family as the route as its source
+func addRoutes(link netlink.Link, addrs []*netlink.Addr, routes []*connectioncontext.Route, table int) error {
+	netlinkRoutes, err := linkRoutes(link.Attrs().Index, addrs, routes, table)
+	if err != nil {
+		return err
+	}
+	for _, route := range netlinkRoutes {
+		if err = netlink.RouteAdd(route); err != nil {
+			logrus.Error("common: failed adding routes:", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// linkRoutes returns the netlink routes of the link
+func linkRoutes(linkIndex int, addrs []*netlink.Addr, routes []*connectioncontext.Route, table int) ([]*netlink.Route, error) {
+	var rv []*netlink.Route
 	for _, route := range routes {
 		_, routeNet, err := net.ParseCIDR(route.GetPrefix())
 		if err != nil {
 			logrus.Error("common: failed parsing route CIDR:", err)
-			return err
+			return nil, err
 		}
-		route := netlink.Route{
-			LinkIndex: link.Attrs().Index,
+		rv = append(rv, &netlink.Route{
+			LinkIndex: linkIndex,
 			Dst: &net.IPNet{
 				IP:   routeNet.IP,
 				Mask: routeNet.Mask,
 			},
-			Src: addr.IP,
-		}
-		if err = netlink.RouteAdd(&route); err != nil {
-			logrus.Error("common: failed adding routes:", err)
+			Src:   routeSrc(addrs, routeNet.IP),
+			Table: table,
+		})
+	}
+	return rv, nil
+}
+
+// addNeighbors adds neighbors
+func addNeighbors(link netlink.Link, neighbors []*connectioncontext.IpNeighbor) error {
+	neighs, err := linkNeighbors(link.Attrs().Index, neighbors)
+	if err != nil {
+		return err
+	}
+	for _, neigh := range neighs {
+		if err = netlink.NeighAdd(neigh); err != nil {
+			logrus.Error("common: failed adding neighbor:", err)
 			return err
 		}
 	}
 	return nil
 }
 
-// addNeighbors adds neighbors
-func addNeighbors(link netlink.Link, neighbors []*connectioncontext.IpNeighbor) error {
+// linkNeighbors returns the netlink neighbors of the link
+func linkNeighbors(linkIndex int, neighbors []*connectioncontext.IpNeighbor) ([]*netlink.Neigh, error) {
+	var rv []*netlink.Neigh
 	for _, neighbor := range neighbors {
 		mac, err := net.ParseMAC(neighbor.GetHardwareAddress())
 		if err != nil {
 			logrus.Error("common: failed parsing the MAC address for IP neighbors:", err)
-			return err
+			return nil, err
 		}
-		neigh := netlink.Neigh{
-			LinkIndex:    link.Attrs().Index,
+		rv = append(rv, &netlink.Neigh{
+			LinkIndex:    linkIndex,
 			State:        0x02, // netlink.NUD_REACHABLE, // the constant is somehow not being found in the package in case of using a darwin based machine
 			IP:           net.ParseIP(neighbor.GetIp()),
 			HardwareAddr: mac,
-		}
-		if err = netlink.NeighAdd(&neigh); err != nil {
-			logrus.Error("common: failed adding neighbor:", err)
-			return err
-		}
+		})
 	}
-	return nil
+	return rv, nil
 }
@@ -0,0 +1,62 @@
+package kernelforwarder
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+)
+
+func TestRouteSrcMatchesFamily(t *testing.T) {
+	g := NewWithT(t)
+
+	v4, err := netlink.ParseAddr("10.20.1.1/30")
+	g.Expect(err).To(BeNil())
+	v6, err := netlink.ParseAddr("fd00::1/126")
+	g.Expect(err).To(BeNil())
+	addrs := []*netlink.Addr{v4, v6}
+
+	g.Expect(routeSrc(addrs, net.ParseIP("8.8.8.0"))).To(Equal(v4.IP))
+	g.Expect(routeSrc(addrs, net.ParseIP("fd01::"))).To(Equal(v6.IP))
+	g.Expect(routeSrc([]*netlink.Addr{v4}, net.ParseIP("fd01::"))).To(BeNil())
+}
+
+func TestPolicyRules(t *testing.T) {
+	g := NewWithT(t)
+
+	policy := &connectioncontext.PolicyRoutingContext{
+		Table: 100,
+		Rules: []*connectioncontext.PolicyRule{
+			{From: "10.20.1.1/32", Priority: 1000},
+			{Fwmark: 7},
+		},
+	}
+	rules := policyRules(policy, []string{"10.20.1.1/30", "fd00::1/126"})
+	g.Expect(rules).To(HaveLen(3))
+
+	g.Expect(rules[0].Table).To(Equal(100))
+	g.Expect(rules[0].Src.String()).To(Equal("10.20.1.1/32"))
+	g.Expect(rules[0].Priority).To(Equal(1000))
+	g.Expect(rules[0].Mark).To(Equal(-1))
+
+	g.Expect(rules[1].Family).To(Equal(netlink.FAMILY_V4))
+	g.Expect(rules[1].Mark).To(Equal(7))
+	g.Expect(rules[1].Priority).To(Equal(-1))
+	g.Expect(rules[2].Family).To(Equal(netlink.FAMILY_V6))
+	g.Expect(rules[2].Mark).To(Equal(7))
+
+	g.Expect(policyRules(nil, []string{"10.20.1.1/30"})).To(BeEmpty())
+}
+
+func TestRoutePrefix(t *testing.T) {
+	g := NewWithT(t)
+
+	_, dst, err := net.ParseCIDR("8.8.8.0/24")
+	g.Expect(err).To(BeNil())
+	g.Expect(routePrefix(&netlink.Route{Dst: dst}, netlink.FAMILY_V4)).To(Equal("8.8.8.0/24"))
+	g.Expect(routePrefix(&netlink.Route{}, netlink.FAMILY_V4)).To(Equal("0.0.0.0/0"))
+	g.Expect(routePrefix(&netlink.Route{}, netlink.FAMILY_V6)).To(Equal("::/0"))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelforwarder
+
+import (
+	"syscall"
+
+	"github.com/vishvananda/netlink"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+)
+
+// checkConflicts returns an error if addresses or routes of the connection conflict with the ones present in the
+// current namespace on other links than the connection one
+func checkConflicts(link netlink.Link, ifaceIPs []string, routes []*connectioncontext.Route, policy *connectioncontext.PolicyRoutingContext) error {
+	state, err := netNsState(link, policy.GetTable())
+	if err != nil {
+		return err
+	}
+	return state.CheckClientContext(&connectioncontext.ConnectionContext{
+		IpContext: &connectioncontext.IPContext{
+			SrcIpAddrs: ifaceIPs,
+			DstRoutes:  routes,
+		},
+		PolicyRoutingContext: policy,
+	})
+}
+
+// netNsState returns global addresses of the current namespace and routes of the routing table, 0 is the main one
+func netNsState(link netlink.Link, table uint32) (*connectioncontext.NetNsState, error) {
+	state := &connectioncontext.NetNsState{}
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range links {
+		if l.Attrs().Index == link.Attrs().Index {
+			continue
+		}
+		addrs, err := netlink.AddrList(l, netlink.FAMILY_ALL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range addrs {
+			if addrs[i].Scope == syscall.RT_SCOPE_UNIVERSE {
+				state.Addresses = append(state.Addresses, addrs[i].IPNet.String())
+			}
+		}
+	}
+	filter := &netlink.Route{Table: int(table)}
+	if table == 0 {
+		filter.Table = syscall.RT_TABLE_MAIN
+	}
+	for _, family := range []int{netlink.FAMILY_V4, netlink.FAMILY_V6} {
+		routes, err := netlink.RouteListFiltered(family, filter, netlink.RT_FILTER_TABLE)
+		if err != nil {
+			return nil, err
+		}
+		for i := range routes {
+			if routes[i].LinkIndex != link.Attrs().Index {
+				state.AddRoute(table, routePrefix(&routes[i], family))
+			}
+		}
+	}
+	return state, nil
+}
+
+// routePrefix returns the destination prefix of the route of the family, the default route has no destination
+func routePrefix(route *netlink.Route, family int) string {
+	if route.Dst != nil {
+		return route.Dst.String()
+	}
+	if family == netlink.FAMILY_V6 {
+		return "::/0"
+	}
+	return "0.0.0.0/0"
+}
@@ -17,14 +17,21 @@ package kernelforwarder
 
 import (
 	"context"
+	"strconv"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/status"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	mechanismCommon "github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/geneve"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/gre"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/subinterface"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/forwarder/api/forwarder"
@@ -36,6 +43,15 @@ import (
 type KernelForwarder struct {
 	common     *common.ForwarderConfig
 	monitoring *monitoring.Metrics
+	/* wireguardKey is a private key of WireGuard interfaces, nil if WireGuard is not supported */
+	wireguardKey []byte
+	/* store saves programmed cross connects, so they are adopted after a restart */
+	store *crossConnectStore
+	/* programming is held for writing by the link monitor, so it checks only cross connects done being programmed */
+	programming sync.RWMutex
+	linkMonitor *linkMonitor
+	/* reportedDown are sides of cross connects reported down by the link monitor */
+	reportedDown map[string]bool
 }
 
 // CreateKernelForwarder creates an instance of the KernelForwarder
@@ -56,26 +72,50 @@ func (k *KernelForwarder) CreateForwarderServer(config *common.ForwarderConfig)
 	return k
 }
 
-// Request handler for connections
+// Request handler for connections, a request for an already existing cross connect updates it in place
 func (k *KernelForwarder) Request(ctx context.Context, crossConnect *crossconnect.CrossConnect) (*crossconnect.CrossConnect, error) {
 	logrus.Infof("Request() called with %v", crossConnect)
-	err := k.connectOrDisconnect(crossConnect, cCONNECT)
+	defer k.watchLinks()
+	k.programming.RLock()
+	defer k.programming.RUnlock()
+
+	var err error
+	previous := k.store.get(crossConnect.GetId())
+	if previous != nil {
+		err = k.update(previous, crossConnect)
+	} else {
+		err = k.connectOrDisconnect(crossConnect, cCONNECT)
+	}
 	if err != nil {
 		logrus.Warn("error while handling Request() connection:", err)
+		if previous != nil && k.store.get(crossConnect.GetId()) == nil {
+			/* The previous cross connect has been deleted on a failed recreate */
+			k.common.Monitor.Delete(ctx, previous)
+		}
 		return nil, err
 	}
 	k.common.Monitor.Update(ctx, crossConnect)
-	return crossConnect, err
+	if err = k.store.put(crossConnect); err != nil {
+		logrus.Warnf("kernel-forwarder: failed to save %s: %v", crossConnect.GetId(), err)
+	}
+	return crossConnect, nil
 }
 
 // Close handler for connections
 func (k *KernelForwarder) Close(ctx context.Context, crossConnect *crossconnect.CrossConnect) (*empty.Empty, error) {
 	logrus.Infof("Close() called with %#v", crossConnect)
+	defer k.watchLinks()
+	k.programming.RLock()
+	defer k.programming.RUnlock()
+
 	err := k.connectOrDisconnect(crossConnect, cDISCONNECT)
 	if err != nil {
 		logrus.Warn("error while handling Close() connection:", err)
 	}
 	k.common.Monitor.Delete(ctx, crossConnect)
+	if err = k.store.delete(crossConnect.GetId()); err != nil {
+		logrus.Warnf("kernel-forwarder: failed to save deletion of %s: %v", crossConnect.GetId(), err)
+	}
 	return &empty.Empty{}, nil
 }
 
@@ -93,12 +133,19 @@ func (k *KernelForwarder) connectOrDisconnect(crossConnect *crossconnect.CrossCo
 		return err
 	}
 
+	/* Negotiate MTU of the connection interfaces, it is reported back in the connection contexts */
+	if connect {
+		common.SetConnectionMTU(crossConnect, common.ConnectionMTU(crossConnect, k.egressMTU(), cVETHMTU))
+	}
+
 	/* 1. Handle local connection */
-	if crossConnect.GetSource().GetMechanism().GetType() == kernel.MECHANISM && crossConnect.GetDestination().GetMechanism().GetType() == kernel.MECHANISM {
+	if crossConnect.GetSource().GetMechanism().GetType() == subinterface.MECHANISM {
+		devices, err = handleSubInterfaceConnection(crossConnect, connect)
+	} else if crossConnect.GetSource().GetMechanism().GetType() == kernel.MECHANISM && crossConnect.GetDestination().GetMechanism().GetType() == kernel.MECHANISM {
 		devices, err = handleLocalConnection(crossConnect, connect)
 	} else {
 		/* 2. Handle remote connection */
-		devices, err = handleRemoteConnection(k.common.EgressInterface, crossConnect, connect)
+		devices, err = handleRemoteConnection(k.common.EgressInterface, k.wireguardKey, crossConnect, connect)
 	}
 	if devices != nil && err == nil {
 		if connect {
@@ -114,6 +161,53 @@ func (k *KernelForwarder) connectOrDisconnect(crossConnect *crossconnect.CrossCo
 	return err
 }
 
+// update updates the cross connect in place if only its connection contexts have changed, otherwise or if the
+// in-place update fails it is recreated
+func (k *KernelForwarder) update(previous, crossConnect *crossconnect.CrossConnect) error {
+	if err := common.SanityCheckConnectionType(k.common.Mechanisms, crossConnect); err != nil {
+		return err
+	}
+	if !previous.GetSource().GetMechanism().Equals(crossConnect.GetSource().GetMechanism()) ||
+		!previous.GetDestination().GetMechanism().Equals(crossConnect.GetDestination().GetMechanism()) {
+		logrus.Infof("kernel-forwarder: mechanisms of %s have changed, recreating it", crossConnect.GetId())
+		return k.recreate(previous, crossConnect)
+	}
+	if crossConnect.GetSource().GetMechanism().GetType() == subinterface.MECHANISM {
+		/* MTU of the sub-interface has been limited by its parent interface on creation */
+		common.SetConnectionMTU(crossConnect, previous.GetSource().GetContext().GetMtu())
+	} else {
+		common.SetConnectionMTU(crossConnect, common.ConnectionMTU(crossConnect, k.egressMTU(), cVETHMTU))
+	}
+	if err := updateCrossConnect(previous, crossConnect); err != nil {
+		logrus.Warnf("kernel-forwarder: failed to update %s in place, recreating it: %v", crossConnect.GetId(), err)
+		return k.recreate(previous, crossConnect)
+	}
+	return nil
+}
+
+// recreate deletes the previous cross connect and creates the new one, the previous one is dropped from the store
+// if the new one fails to be created, so it is not updated again
+func (k *KernelForwarder) recreate(previous, crossConnect *crossconnect.CrossConnect) error {
+	if err := k.connectOrDisconnect(previous, cDISCONNECT); err != nil {
+		logrus.Warnf("kernel-forwarder: failed to delete previous %s: %v", crossConnect.GetId(), err)
+	}
+	err := k.connectOrDisconnect(crossConnect, cCONNECT)
+	if err != nil {
+		if storeErr := k.store.delete(previous.GetId()); storeErr != nil {
+			logrus.Warnf("kernel-forwarder: failed to save deletion of %s: %v", previous.GetId(), storeErr)
+		}
+	}
+	return err
+}
+
+// egressMTU returns MTU of the egress interface, zero if it is unknown
+func (k *KernelForwarder) egressMTU() int {
+	if iface := k.common.EgressInterface.Interface(); iface != nil {
+		return iface.MTU
+	}
+	return 0
+}
+
 // configureKernelForwarder setups the Kernel forwarding plane
 func (k *KernelForwarder) configureKernelForwarder() {
 	k.common.MechanismsUpdateChannel = make(chan *common.Mechanisms, 1)
@@ -130,17 +224,70 @@ func (k *KernelForwarder) configureKernelForwarder() {
 					vxlan.SrcIP: k.common.EgressInterface.SrcIPNet().IP.String(),
 				},
 			},
+			{
+				Type: gre.MECHANISM,
+				Parameters: map[string]string{
+					gre.SrcIP: k.common.EgressInterface.SrcIPNet().IP.String(),
+				},
+			},
+			{
+				Type: geneve.MECHANISM,
+				Parameters: map[string]string{
+					geneve.SrcIP: k.common.EgressInterface.SrcIPNet().IP.String(),
+				},
+			},
 		},
 	}
+	if m := newSubInterfaceMechanism(); m != nil {
+		k.common.Mechanisms.LocalMechanisms = append(k.common.Mechanisms.LocalMechanisms, m)
+	}
+	k.configureWireGuard()
+	// MTU of remote connections is limited by the smaller egress MTU of forwarders on both ends
+	if mtu := k.egressMTU(); mtu > 0 {
+		for _, m := range k.common.Mechanisms.RemoteMechanisms {
+			m.GetParameters()[mechanismCommon.SrcMTU] = strconv.Itoa(mtu)
+		}
+	}
 	// Metrics monitoring
 	if k.common.MetricsEnabled {
 		k.monitoring = monitoring.CreateMetricsMonitor(k.common.MetricsPeriod)
 		k.monitoring.Start(k.common.Monitor)
 	}
+	// Adopt cross connects programmed before a restart
+	k.store = newCrossConnectStore(k.common.NSMBaseDir)
+	k.reconcile()
+	// Link-state monitoring
+	k.reportedDown = map[string]bool{}
+	k.linkMonitor = newLinkMonitor(k.checkLinks)
+	if err := k.linkMonitor.start(); err != nil {
+		logrus.Errorf("kernel-forwarder: failed to start link monitor - %v", err)
+		k.linkMonitor = nil
+	}
+	k.watchLinks()
 	// Network Service monitoring
 	common.CreateNSMonitor(k.common.Monitor, nsmonitorCallback)
 }
 
+// configureWireGuard advertises WireGuard remote mechanism with a public key of the forwarder if it is supported
+func (k *KernelForwarder) configureWireGuard() {
+	if !wireguardSupported() {
+		return
+	}
+	privateKey, publicKey, err := loadWireGuardKey(k.common.NSMBaseDir)
+	if err != nil {
+		logrus.Errorf("kernel-forwarder: failed to load WireGuard key - %v", err)
+		return
+	}
+	k.wireguardKey = privateKey
+	k.common.Mechanisms.RemoteMechanisms = append(k.common.Mechanisms.RemoteMechanisms, &connection.Mechanism{
+		Type: wireguard.MECHANISM,
+		Parameters: map[string]string{
+			wireguard.SrcIP:        k.common.EgressInterface.SrcIPNet().IP.String(),
+			wireguard.SrcPublicKey: wireguard.EncodeKey(publicKey),
+		},
+	})
+}
+
 // MonitorMechanisms handler
 func (k *KernelForwarder) MonitorMechanisms(empty *empty.Empty, updateSrv forwarder.MechanismsMonitor_MonitorMechanismsServer) error {
 	initialUpdate := &forwarder.MechanismUpdate{
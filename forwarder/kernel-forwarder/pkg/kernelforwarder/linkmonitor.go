@@ -0,0 +1,373 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelforwarder
+
+import (
+	"context"
+	"net"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/utils/fs"
+)
+
+const (
+	/* hostNetNs is a namespace key of events of the host namespace, where underlay routes of remote connections are */
+	hostNetNs = ""
+	/* linkEventDelay is how long events are coalesced for before the namespaces they are of are checked */
+	linkEventDelay = 100 * time.Millisecond
+	/* routeResubscribeDelay is how long to wait before subscribing to route events again after a failure */
+	routeResubscribeDelay = time.Second
+)
+
+// subscriptionEnd is sent once link events of the namespace are not received anymore
+type subscriptionEnd struct {
+	netNsInode string
+	done       chan struct{}
+}
+
+// linkMonitor subscribes to link events in namespaces of the cross connect interfaces and to route events in
+// the host namespace, events are coalesced and check is called with the namespace inodes of the events
+type linkMonitor struct {
+	check         func(netNsInodes map[string]bool)
+	watchCh       chan []string
+	endCh         chan subscriptionEnd
+	subscriptions map[string]chan struct{}
+
+	/* Subscriptions only mark namespaces of events pending, so they never wait for a check to finish */
+	lock    sync.Mutex
+	pending map[string]bool
+	signal  chan struct{}
+}
+
+func newLinkMonitor(check func(netNsInodes map[string]bool)) *linkMonitor {
+	return &linkMonitor{
+		check:         check,
+		watchCh:       make(chan []string, 10),
+		endCh:         make(chan subscriptionEnd, 10),
+		subscriptions: map[string]chan struct{}{},
+		pending:       map[string]bool{},
+		signal:        make(chan struct{}, 1),
+	}
+}
+
+// start subscribes to route events in the host namespace and starts handling events
+func (m *linkMonitor) start() error {
+	routeCh, err := subscribeRoutes()
+	if err != nil {
+		return err
+	}
+	go m.readRoutes(routeCh)
+	go m.run()
+	return nil
+}
+
+func subscribeRoutes() (chan netlink.RouteUpdate, error) {
+	routeCh := make(chan netlink.RouteUpdate, 100)
+	err := netlink.RouteSubscribeWithOptions(routeCh, nil, netlink.RouteSubscribeOptions{
+		ErrorCallback: func(err error) {
+			logrus.Errorf("link monitor: route subscription failed - %v", err)
+		},
+	})
+	return routeCh, err
+}
+
+// readRoutes reports route events in the host namespace, the channel is closed if the subscription fails, e.g. once
+// the netlink socket overflows, so it subscribes again
+func (m *linkMonitor) readRoutes(routeCh chan netlink.RouteUpdate) {
+	for {
+		for range routeCh {
+			m.notify(hostNetNs)
+		}
+		logrus.Warn("link monitor: route events are not received anymore, subscribing again")
+		for {
+			var err error
+			if routeCh, err = subscribeRoutes(); err == nil {
+				break
+			}
+			logrus.Errorf("link monitor: failed to subscribe to route events - %v", err)
+			time.Sleep(routeResubscribeDelay)
+		}
+		/* Routes may have changed while the events were not received */
+		m.notify(hostNetNs)
+	}
+}
+
+// notify marks the namespace of an event pending for a check
+func (m *linkMonitor) notify(netNsInode string) {
+	m.lock.Lock()
+	m.pending[netNsInode] = true
+	m.lock.Unlock()
+
+	select {
+	case m.signal <- struct{}{}:
+	default:
+	}
+}
+
+// takePending returns namespaces of events since the last check
+func (m *linkMonitor) takePending() map[string]bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	pending := m.pending
+	m.pending = map[string]bool{}
+	return pending
+}
+
+// watch replaces namespaces link events are watched in
+func (m *linkMonitor) watch(netNsInodes []string) {
+	m.watchCh <- netNsInodes
+}
+
+func (m *linkMonitor) run() {
+	timer := time.NewTimer(linkEventDelay)
+	timer.Stop()
+	timerSet := false
+	for {
+		select {
+		case netNsInodes := <-m.watchCh:
+			m.subscribe(netNsInodes)
+		case end := <-m.endCh:
+			/* The namespace may have been subscribed to again since */
+			if m.subscriptions[end.netNsInode] == end.done {
+				delete(m.subscriptions, end.netNsInode)
+			}
+		case <-m.signal:
+			if !timerSet {
+				timer.Reset(linkEventDelay)
+				timerSet = true
+			}
+		case <-timer.C:
+			timerSet = false
+			/* Events signalled while the previous check was running may have been taken by it already */
+			if pending := m.takePending(); len(pending) > 0 {
+				m.check(pending)
+			}
+		}
+	}
+}
+
+// subscribe subscribes to link events in new namespaces and unsubscribes from the ones not watched anymore
+func (m *linkMonitor) subscribe(netNsInodes []string) {
+	watched := map[string]bool{}
+	for _, netNsInode := range netNsInodes {
+		watched[netNsInode] = true
+		if _, ok := m.subscriptions[netNsInode]; ok {
+			continue
+		}
+		done, err := m.subscribeAt(netNsInode)
+		if err != nil {
+			logrus.Errorf("link monitor: failed to subscribe to link events in namespace %s - %v", netNsInode, err)
+			continue
+		}
+		m.subscriptions[netNsInode] = done
+	}
+	for netNsInode, done := range m.subscriptions {
+		if !watched[netNsInode] {
+			close(done)
+			delete(m.subscriptions, netNsInode)
+		}
+	}
+}
+
+func (m *linkMonitor) subscribeAt(netNsInode string) (chan struct{}, error) {
+	nsHandle, err := fs.GetNsHandleFromInode(netNsInode)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := nsHandle.Close(); closeErr != nil {
+			logrus.Error("link monitor: error when closing namespace handle: ", closeErr)
+		}
+	}()
+	linkCh := make(chan netlink.LinkUpdate, 10)
+	done := make(chan struct{})
+	if err = netlink.LinkSubscribeAt(nsHandle, linkCh, done); err != nil {
+		return nil, err
+	}
+	go func() {
+		/* The channel is closed once the subscription is done or the namespace is gone */
+		for range linkCh {
+			m.notify(netNsInode)
+		}
+		m.endCh <- subscriptionEnd{netNsInode: netNsInode, done: done}
+	}()
+	return done, nil
+}
+
+// watchLinks watches links of all programmed cross connects
+func (k *KernelForwarder) watchLinks() {
+	if k.linkMonitor == nil {
+		return
+	}
+	netNsInodes := map[string]bool{}
+	var rv []string
+	for _, xcon := range k.store.list() {
+		configs, err := linkConfigs(xcon)
+		if err != nil {
+			continue
+		}
+		for _, cfg := range configs {
+			if !netNsInodes[cfg.netNsInode] {
+				netNsInodes[cfg.netNsInode] = true
+				rv = append(rv, cfg.netNsInode)
+			}
+		}
+	}
+	k.linkMonitor.watch(rv)
+}
+
+// checkLinks reports cross connects with interfaces in the namespaces deleted or down, or remote cross connects without
+// a route to the remote forwarder, if there are events of the host namespace. Sides of the cross connects are reported
+// down, so NSMgr heals or closes them, and up again once they recover.
+func (k *KernelForwarder) checkLinks(netNsInodes map[string]bool) {
+	/* Cross connects being programmed are checked once they are done */
+	k.programming.Lock()
+	defer k.programming.Unlock()
+
+	/* Lock the OS thread so we don't accidentally switch namespaces */
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	xcons := k.store.list()
+	k.pruneReportedDown(xcons)
+	for _, xcon := range xcons {
+		configs, err := linkConfigs(xcon)
+		if err != nil || !linksAffected(xcon, configs, netNsInodes) {
+			continue
+		}
+		srcState, dstState := crossConnectState(xcon, configs, linkUp, routeUp)
+		srcState = k.reportedState(xcon, "SRC-", xcon.GetSource().GetState(), srcState)
+		dstState = k.reportedState(xcon, "DST-", xcon.GetDestination().GetState(), dstState)
+		if srcState == xcon.GetSource().GetState() && dstState == xcon.GetDestination().GetState() {
+			continue
+		}
+		logrus.Infof("link monitor: cross connect %s source is %v, destination is %v", xcon.GetId(), srcState, dstState)
+		updated := proto.Clone(xcon).(*crossconnect.CrossConnect)
+		updated.GetSource().State = srcState
+		updated.GetDestination().State = dstState
+		if err = k.store.put(updated); err != nil {
+			logrus.Warnf("link monitor: failed to save %s: %v", xcon.GetId(), err)
+		}
+		k.common.Monitor.Update(context.Background(), updated)
+	}
+}
+
+// reportedState returns the state to report for the side of the cross connect, only sides reported down
+// by the link monitor are reported up again
+func (k *KernelForwarder) reportedState(xcon *crossconnect.CrossConnect, side string, current, state connection.State) connection.State {
+	key := side + xcon.GetId()
+	switch {
+	case state == connection.State_DOWN && current != connection.State_DOWN:
+		k.reportedDown[key] = true
+		return state
+	case state == connection.State_UP && current == connection.State_DOWN && k.reportedDown[key]:
+		delete(k.reportedDown, key)
+		return state
+	}
+	return current
+}
+
+// pruneReportedDown forgets sides of cross connects, which are closed
+func (k *KernelForwarder) pruneReportedDown(xcons []*crossconnect.CrossConnect) {
+	ids := map[string]bool{}
+	for _, xcon := range xcons {
+		ids[xcon.GetId()] = true
+	}
+	for key := range k.reportedDown {
+		if !ids[key[strings.Index(key, "-")+1:]] {
+			delete(k.reportedDown, key)
+		}
+	}
+}
+
+// linksAffected returns true if the cross connect has interfaces in the namespaces or it is a remote one
+// and there are events of the host namespace
+func linksAffected(xcon *crossconnect.CrossConnect, configs []*linkConfig, netNsInodes map[string]bool) bool {
+	if netNsInodes[hostNetNs] && remotePeerIP(xcon) != nil {
+		return true
+	}
+	for _, cfg := range configs {
+		if netNsInodes[cfg.netNsInode] {
+			return true
+		}
+	}
+	return false
+}
+
+// crossConnectState returns states of the source and the destination of the cross connect, a side is down
+// if its interface is deleted or down, or if it is a remote one and there is no route to the remote forwarder
+func crossConnectState(xcon *crossconnect.CrossConnect, configs []*linkConfig, linkUp func(cfg *linkConfig) bool, routeUp func(ip net.IP) bool) (srcState, dstState connection.State) {
+	srcState, dstState = connection.State_UP, connection.State_UP
+	for _, cfg := range configs {
+		if linkUp(cfg) {
+			continue
+		}
+		if strings.HasPrefix(cfg.xconName, "SRC-") {
+			srcState = connection.State_DOWN
+		} else {
+			dstState = connection.State_DOWN
+		}
+	}
+	if ip := remotePeerIP(xcon); ip != nil && !routeUp(ip) {
+		if isRemoteMechanism(xcon.GetSource().GetMechanism().GetType()) {
+			srcState = connection.State_DOWN
+		} else {
+			dstState = connection.State_DOWN
+		}
+	}
+	return srcState, dstState
+}
+
+// remotePeerIP returns IP of the remote forwarder of a remote cross connect or nil
+func remotePeerIP(xcon *crossconnect.CrossConnect) net.IP {
+	if m := xcon.GetSource().GetMechanism(); isRemoteMechanism(m.GetType()) {
+		return net.ParseIP(m.GetParameters()[common.SrcIP])
+	}
+	if m := xcon.GetDestination().GetMechanism(); isRemoteMechanism(m.GetType()) {
+		return net.ParseIP(m.GetParameters()[common.DstIP])
+	}
+	return nil
+}
+
+// linkUp returns true if the interface is present in its namespace and it is up
+func linkUp(cfg *linkConfig) bool {
+	up := false
+	_ = inNetNs(cfg.netNsInode, func() error {
+		link, err := netlink.LinkByName(cfg.name)
+		up = err == nil && link.Attrs().Flags&net.FlagUp != 0
+		return nil
+	})
+	return up
+}
+
+// routeUp returns true if there is a route to the IP in the host namespace, a default route is enough, so only
+// a missing default route is detected for remote forwarders reached through it
+func routeUp(ip net.IP) bool {
+	routes, err := netlink.RouteGet(ip)
+	return err == nil && len(routes) > 0
+}
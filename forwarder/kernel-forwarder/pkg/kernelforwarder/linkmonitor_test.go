@@ -0,0 +1,155 @@
+package kernelforwarder
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+func newOutgoingTestCrossConnect(id string) *crossconnect.CrossConnect {
+	return &crossconnect.CrossConnect{
+		Id:     id,
+		Source: newKernelTestConnection("nsm-src"+id, &connectioncontext.IPContext{SrcIpAddr: "10.20.1.1/30"}),
+		Destination: &connection.Connection{
+			Mechanism: &connection.Mechanism{
+				Type: vxlan.MECHANISM,
+				Parameters: map[string]string{
+					common.SrcIP: "192.168.0.1",
+					common.DstIP: "192.168.0.2",
+					vxlan.VNI:    "1",
+				},
+			},
+		},
+	}
+}
+
+func TestCrossConnectStateLinkDown(t *testing.T) {
+	g := NewWithT(t)
+
+	xcon := newLocalTestCrossConnect("1")
+	configs, err := linkConfigs(xcon)
+	g.Expect(err).To(BeNil())
+
+	linkDown := func(name string) func(cfg *linkConfig) bool {
+		return func(cfg *linkConfig) bool { return cfg.name != name }
+	}
+	routeUp := func(ip net.IP) bool { return true }
+
+	srcState, dstState := crossConnectState(xcon, configs, linkDown(""), routeUp)
+	g.Expect(srcState).To(Equal(connection.State_UP))
+	g.Expect(dstState).To(Equal(connection.State_UP))
+
+	srcState, dstState = crossConnectState(xcon, configs, linkDown("nsm-src1"), routeUp)
+	g.Expect(srcState).To(Equal(connection.State_DOWN))
+	g.Expect(dstState).To(Equal(connection.State_UP))
+
+	srcState, dstState = crossConnectState(xcon, configs, linkDown("nsm-dst1"), routeUp)
+	g.Expect(srcState).To(Equal(connection.State_UP))
+	g.Expect(dstState).To(Equal(connection.State_DOWN))
+}
+
+func TestCrossConnectStateNoUnderlayRoute(t *testing.T) {
+	g := NewWithT(t)
+
+	xcon := newOutgoingTestCrossConnect("1")
+	configs, err := linkConfigs(xcon)
+	g.Expect(err).To(BeNil())
+	g.Expect(remotePeerIP(xcon).String()).To(Equal("192.168.0.2"))
+
+	linkUp := func(cfg *linkConfig) bool { return true }
+	routeDown := func(ip net.IP) bool { return !ip.Equal(net.ParseIP("192.168.0.2")) }
+
+	srcState, dstState := crossConnectState(xcon, configs, linkUp, routeDown)
+	g.Expect(srcState).To(Equal(connection.State_UP))
+	g.Expect(dstState).To(Equal(connection.State_DOWN))
+}
+
+func TestLinksAffected(t *testing.T) {
+	g := NewWithT(t)
+
+	local := newLocalTestCrossConnect("1")
+	localConfigs, err := linkConfigs(local)
+	g.Expect(err).To(BeNil())
+	g.Expect(linksAffected(local, localConfigs, map[string]bool{"1": true})).To(BeTrue())
+	g.Expect(linksAffected(local, localConfigs, map[string]bool{"2": true, "3": true})).To(BeFalse())
+	g.Expect(linksAffected(local, localConfigs, map[string]bool{hostNetNs: true})).To(BeFalse())
+	g.Expect(linksAffected(local, localConfigs, map[string]bool{hostNetNs: true, "1": true})).To(BeTrue())
+
+	outgoing := newOutgoingTestCrossConnect("2")
+	outgoingConfigs, err := linkConfigs(outgoing)
+	g.Expect(err).To(BeNil())
+	g.Expect(linksAffected(outgoing, outgoingConfigs, map[string]bool{hostNetNs: true})).To(BeTrue())
+}
+
+func TestLinkMonitorCoalescesEvents(t *testing.T) {
+	g := NewWithT(t)
+
+	checked := make(chan map[string]bool, 10)
+	m := newLinkMonitor(func(netNsInodes map[string]bool) {
+		checked <- netNsInodes
+	})
+	go m.run()
+
+	for i := 0; i < 10; i++ {
+		m.notify("1")
+		m.notify(hostNetNs)
+	}
+	g.Eventually(checked).Should(Receive(Equal(map[string]bool{"1": true, hostNetNs: true})))
+	g.Consistently(checked, 3*linkEventDelay).ShouldNot(Receive())
+
+	m.notify("2")
+	g.Eventually(checked).Should(Receive(Equal(map[string]bool{"2": true})))
+}
+
+func TestLinkMonitorDoesNotBlockEventsOnCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	checked := make(chan map[string]bool, 10)
+	release := make(chan struct{})
+	m := newLinkMonitor(func(netNsInodes map[string]bool) {
+		checked <- netNsInodes
+		<-release
+	})
+	go m.run()
+
+	m.notify("1")
+	g.Eventually(checked).Should(Receive(Equal(map[string]bool{"1": true})))
+
+	/* Events keep being received while the check waits */
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 1000; i++ {
+			m.notify(hostNetNs)
+		}
+		close(done)
+	}()
+	g.Eventually(done).Should(BeClosed())
+
+	close(release)
+	g.Eventually(checked).Should(Receive(Equal(map[string]bool{hostNetNs: true})))
+}
+
+func TestReportedState(t *testing.T) {
+	g := NewWithT(t)
+
+	k := &KernelForwarder{reportedDown: map[string]bool{}}
+	xcon := newLocalTestCrossConnect("1")
+
+	/* A side down for another reason is not reported up by the link monitor */
+	g.Expect(k.reportedState(xcon, "SRC-", connection.State_DOWN, connection.State_UP)).To(Equal(connection.State_DOWN))
+
+	g.Expect(k.reportedState(xcon, "SRC-", connection.State_UP, connection.State_DOWN)).To(Equal(connection.State_DOWN))
+	g.Expect(k.reportedState(xcon, "SRC-", connection.State_DOWN, connection.State_UP)).To(Equal(connection.State_UP))
+	g.Expect(k.reportedDown).To(BeEmpty())
+
+	g.Expect(k.reportedState(xcon, "DST-", connection.State_UP, connection.State_DOWN)).To(Equal(connection.State_DOWN))
+	k.pruneReportedDown(nil)
+	g.Expect(k.reportedDown).To(BeEmpty())
+}
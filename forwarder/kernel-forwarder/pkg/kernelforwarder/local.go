@@ -91,19 +91,25 @@ func createLocalConnection(cfg *connectionConfig) (map[string]monitoring.Device,
 	logrus.Debug("local: opened destination handle: ", dstNsHandle, cfg.dstNetNsInode)
 
 	/* Create the VETH pair - host namespace */
-	if err = netlink.LinkAdd(newVETH(cfg.srcName, cfg.dstName)); err != nil {
+	if err = netlink.LinkAdd(newVETH(cfg.srcName, cfg.dstName, cfg.mtu)); err != nil {
 		logrus.Errorf("local: failed to create VETH pair - %v", err)
 		return nil, err
 	}
+	if err = setLinkAlias(cfg.srcName, "SRC-"+cfg.id); err != nil {
+		return nil, err
+	}
+	if err = setLinkAlias(cfg.dstName, "DST-"+cfg.id); err != nil {
+		return nil, err
+	}
 
 	/* Setup interface - source namespace */
-	if err = setupLinkInNs(srcNsHandle, cfg.srcName, cfg.srcIP, cfg.srcRoutes, cfg.neighbors, true); err != nil {
+	if err = setupLinkInNs(srcNsHandle, cfg.srcName, cfg.srcIPs, cfg.srcRoutes, cfg.neighbors, cfg.policy, cfg.mtu, true, true); err != nil {
 		logrus.Errorf("local: failed to setup interface - source - %q: %v", cfg.srcName, err)
 		return nil, err
 	}
 
 	/* Setup interface - destination namespace */
-	if err = setupLinkInNs(dstNsHandle, cfg.dstName, cfg.dstIP, cfg.dstRoutes, nil, true); err != nil {
+	if err = setupLinkInNs(dstNsHandle, cfg.dstName, cfg.dstIPs, cfg.dstRoutes, nil, nil, cfg.mtu, true, false); err != nil {
 		logrus.Errorf("local: failed to setup interface - destination - %q: %v", cfg.dstName, err)
 		return nil, err
 	}
@@ -151,13 +157,13 @@ func deleteLocalConnection(cfg *connectionConfig) (map[string]monitoring.Device,
 	logrus.Debug("local: opened destination handle: ", dstNsHandle, cfg.dstNetNsInode)
 
 	/* Extract interface - source namespace */
-	if err = setupLinkInNs(srcNsHandle, cfg.srcName, cfg.srcIP, nil, nil, false); err != nil {
+	if err = setupLinkInNs(srcNsHandle, cfg.srcName, cfg.srcIPs, nil, nil, cfg.policy, 0, false, true); err != nil {
 		logrus.Errorf("local: failed to extract interface - source - %q: %v", cfg.srcName, err)
 		return nil, err
 	}
 
 	/* Extract interface - destination namespace */
-	if err = setupLinkInNs(dstNsHandle, cfg.dstName, cfg.dstIP, nil, nil, false); err != nil {
+	if err = setupLinkInNs(dstNsHandle, cfg.dstName, cfg.dstIPs, nil, nil, nil, 0, false, false); err != nil {
 		logrus.Errorf("local: failed to extract interface - destination - %q: %v", cfg.dstName, err)
 		return nil, err
 	}
@@ -182,12 +188,12 @@ func deleteLocalConnection(cfg *connectionConfig) (map[string]monitoring.Device,
 }
 
 // newVETH returns a VETH interface instance
-func newVETH(srcName, dstName string) *netlink.Veth {
+func newVETH(srcName, dstName string, mtu int) *netlink.Veth {
 	/* Populate the VETH interface configuration */
 	return &netlink.Veth{
 		LinkAttrs: netlink.LinkAttrs{
 			Name: srcName,
-			MTU:  cVETHMTU,
+			MTU:  mtu,
 		},
 		PeerName: dstName,
 	}
@@ -0,0 +1,236 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelforwarder
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/forwarder/kernel-forwarder/pkg/monitoring"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+	"github.com/networkservicemesh/networkservicemesh/utils/fs"
+)
+
+// nsLink is an interface created by the forwarder found in a namespace, netNsInode is empty for the host namespace
+type nsLink struct {
+	netNsInode string
+	name       string
+	xconName   string
+}
+
+// reconcile adopts cross connects saved before the forwarder restart, whose interfaces are all still present,
+// so they are reported to NSMgr in the initial state transfer without being reprogrammed. Interfaces created
+// by the forwarder, which do not belong to any of them, are deleted.
+func (k *KernelForwarder) reconcile() {
+	saved, err := k.store.load()
+	if err != nil {
+		logrus.Errorf("reconcile: failed to load saved cross connects - %v", err)
+	}
+	links, err := findLinks()
+	if err != nil {
+		logrus.Errorf("reconcile: failed to find interfaces - %v", err)
+		return
+	}
+	adopted, dropped, orphans := adoptCrossConnects(saved, links)
+	for _, xcon := range adopted {
+		logrus.Infof("reconcile: adopting cross connect %s", xcon.GetId())
+		k.common.Monitor.Update(context.Background(), xcon)
+		if k.common.MetricsEnabled {
+			k.monitoring.GetDevices().Lock()
+			k.monitoring.GetDevices().UpdateDeviceList(crossConnectDevices(xcon), cCONNECT)
+			k.monitoring.GetDevices().Unlock()
+		}
+	}
+	if err = k.store.reset(adopted); err != nil {
+		logrus.Errorf("reconcile: failed to save adopted cross connects - %v", err)
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	for _, xcon := range dropped {
+		deletePolicyRules(xcon)
+	}
+	for _, link := range orphans {
+		deleteLink(link)
+	}
+}
+
+// adoptCrossConnects returns the saved cross connects with all their interfaces found, the saved cross connects
+// with some of them missing and the found interfaces not belonging to any adopted cross connect
+func adoptCrossConnects(saved map[string]*crossconnect.CrossConnect, links []*nsLink) (adopted, dropped []*crossconnect.CrossConnect, orphans []*nsLink) {
+	found := map[nsLink]bool{}
+	for _, link := range links {
+		found[*link] = true
+	}
+	used := map[nsLink]bool{}
+	for _, xcon := range saved {
+		configs, err := linkConfigs(xcon)
+		if err != nil {
+			logrus.Errorf("reconcile: invalid saved cross connect %s - %v", xcon.GetId(), err)
+			dropped = append(dropped, xcon)
+			continue
+		}
+		complete := true
+		for _, cfg := range configs {
+			if !found[nsLink{netNsInode: cfg.netNsInode, name: cfg.name, xconName: cfg.xconName}] {
+				complete = false
+				break
+			}
+		}
+		if !complete {
+			dropped = append(dropped, xcon)
+			continue
+		}
+		for _, cfg := range configs {
+			used[nsLink{netNsInode: cfg.netNsInode, name: cfg.name, xconName: cfg.xconName}] = true
+		}
+		adopted = append(adopted, xcon)
+	}
+	for _, link := range links {
+		if !used[*link] {
+			orphans = append(orphans, link)
+		}
+	}
+	sort.Slice(adopted, func(i, j int) bool { return adopted[i].GetId() < adopted[j].GetId() })
+	sort.Slice(dropped, func(i, j int) bool { return dropped[i].GetId() < dropped[j].GetId() })
+	return adopted, dropped, orphans
+}
+
+// crossConnectDevices returns the devices of the cross connect for metrics monitoring
+func crossConnectDevices(xcon *crossconnect.CrossConnect) map[string]monitoring.Device {
+	configs, err := linkConfigs(xcon)
+	if err != nil {
+		return nil
+	}
+	devices := map[string]monitoring.Device{}
+	for _, cfg := range configs {
+		devices[cfg.netNsInode] = monitoring.Device{Name: cfg.name, XconName: cfg.xconName}
+	}
+	return devices
+}
+
+// findLinks returns interfaces created by the forwarder in the host namespace and in namespaces of all pods
+func findLinks() ([]*nsLink, error) {
+	/* Lock the OS thread so we don't accidentally switch namespaces */
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	/* Interfaces are left in the host namespace, if the forwarder stops before injecting them */
+	links, err := listLinks("")
+	if err != nil {
+		return nil, err
+	}
+	hostInode, err := tools.GetCurrentNS()
+	if err != nil {
+		return nil, err
+	}
+	inodes, err := fs.GetAllNetNs()
+	if err != nil {
+		return nil, err
+	}
+	visited := map[string]bool{hostInode: true}
+	for _, inode := range inodes {
+		netNsInode := strconv.FormatUint(inode, 10)
+		if visited[netNsInode] {
+			continue
+		}
+		visited[netNsInode] = true
+		var nsLinks []*nsLink
+		err = inNetNs(netNsInode, func() error {
+			var listErr error
+			nsLinks, listErr = listLinks(netNsInode)
+			return listErr
+		})
+		if err != nil {
+			/* Not a pod namespace or it is already gone */
+			logrus.Debugf("reconcile: skipping namespace %s - %v", netNsInode, err)
+			continue
+		}
+		links = append(links, nsLinks...)
+	}
+	return links, nil
+}
+
+// listLinks returns interfaces created by the forwarder in the current namespace
+func listLinks(netNsInode string) ([]*nsLink, error) {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, err
+	}
+	var rv []*nsLink
+	for _, link := range links {
+		if alias := link.Attrs().Alias; strings.HasPrefix(alias, linkAliasPrefix) {
+			rv = append(rv, &nsLink{
+				netNsInode: netNsInode,
+				name:       link.Attrs().Name,
+				xconName:   strings.TrimPrefix(alias, linkAliasPrefix),
+			})
+		}
+	}
+	return rv, nil
+}
+
+// deleteLink deletes the interface, the other side of a VETH pair may be already gone together with its peer
+func deleteLink(link *nsLink) {
+	del := func() error {
+		ifaceLink, err := netlink.LinkByName(link.name)
+		if err != nil {
+			return nil
+		}
+		return netlink.LinkDel(ifaceLink)
+	}
+	var err error
+	if link.netNsInode == "" {
+		err = del()
+	} else {
+		err = inNetNs(link.netNsInode, del)
+	}
+	if err != nil {
+		logrus.Errorf("reconcile: failed to delete orphaned interface %q of %s - %v", link.name, link.xconName, err)
+		return
+	}
+	logrus.Infof("reconcile: deleted orphaned interface %q of %s", link.name, link.xconName)
+}
+
+// deletePolicyRules deletes policy routing rules of the dropped cross connect, they are not deleted together with its interfaces
+func deletePolicyRules(xcon *crossconnect.CrossConnect) {
+	configs, err := linkConfigs(xcon)
+	if err != nil {
+		return
+	}
+	for _, cfg := range configs {
+		rules := policyRules(cfg.policy, cfg.ips)
+		if len(rules) == 0 {
+			continue
+		}
+		_ = inNetNs(cfg.netNsInode, func() error {
+			for _, rule := range rules {
+				if ruleErr := netlink.RuleDel(rule); ruleErr != nil {
+					logrus.Debugf("reconcile: failed deleting rule %v: %v", rule, ruleErr)
+				}
+			}
+			return nil
+		})
+	}
+}
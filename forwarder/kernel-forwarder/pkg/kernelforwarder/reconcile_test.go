@@ -0,0 +1,69 @@
+package kernelforwarder
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+func newLocalTestCrossConnect(id string) *crossconnect.CrossConnect {
+	ipContext := &connectioncontext.IPContext{
+		SrcIpAddr: "10.20.1.1/30",
+		DstIpAddr: "10.20.1.2/30",
+	}
+	return &crossconnect.CrossConnect{
+		Id:          id,
+		Source:      newKernelTestConnection("nsm-src"+id, ipContext),
+		Destination: newKernelTestConnection("nsm-dst"+id, ipContext),
+	}
+}
+
+func TestAdoptCrossConnects(t *testing.T) {
+	g := NewWithT(t)
+
+	saved := map[string]*crossconnect.CrossConnect{
+		"1": newLocalTestCrossConnect("1"),
+		"2": newLocalTestCrossConnect("2"),
+	}
+	links := []*nsLink{
+		{netNsInode: "1", name: "nsm-src1", xconName: "SRC-1"},
+		{netNsInode: "1", name: "nsm-dst1", xconName: "DST-1"},
+		/* The destination interface of the cross connect 2 is gone */
+		{netNsInode: "1", name: "nsm-src2", xconName: "SRC-2"},
+		/* Left in the host namespace before injecting */
+		{netNsInode: "", name: "nsm-src3", xconName: "SRC-3"},
+	}
+
+	adopted, dropped, orphans := adoptCrossConnects(saved, links)
+	g.Expect(adopted).To(HaveLen(1))
+	g.Expect(adopted[0].GetId()).To(Equal("1"))
+	g.Expect(dropped).To(HaveLen(1))
+	g.Expect(dropped[0].GetId()).To(Equal("2"))
+	g.Expect(orphans).To(ConsistOf(links[2], links[3]))
+}
+
+func TestAdoptCrossConnectsNothingSaved(t *testing.T) {
+	g := NewWithT(t)
+
+	links := []*nsLink{{netNsInode: "1", name: "nsm-src1", xconName: "SRC-1"}}
+	adopted, dropped, orphans := adoptCrossConnects(nil, links)
+	g.Expect(adopted).To(BeEmpty())
+	g.Expect(dropped).To(BeEmpty())
+	g.Expect(orphans).To(Equal(links))
+}
+
+func TestCrossConnectDevices(t *testing.T) {
+	g := NewWithT(t)
+
+	xcon := newLocalTestCrossConnect("1")
+	xcon.GetDestination().GetMechanism().GetParameters()[common.NetNsInodeKey] = "2"
+	devices := crossConnectDevices(xcon)
+	g.Expect(devices).To(HaveLen(2))
+	g.Expect(devices["1"].Name).To(Equal("nsm-src1"))
+	g.Expect(devices["1"].XconName).To(Equal("SRC-1"))
+	g.Expect(devices["2"].XconName).To(Equal("DST-1"))
+}
@@ -18,8 +18,11 @@ package kernelforwarder
 import (
 	"runtime"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/geneve"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/gre"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
 
 	"github.com/pkg/errors"
 
@@ -28,26 +31,45 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/utils/fs"
 
 	"net"
+	"syscall"
 
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
 
 	"github.com/networkservicemesh/networkservicemesh/forwarder/kernel-forwarder/pkg/monitoring"
 	"github.com/networkservicemesh/networkservicemesh/forwarder/pkg/common"
 )
 
+// GENEVE link attributes
+const (
+	cGENEVE            = "geneve"
+	cIFLAGENEVEID      = 1
+	cIFLAGENEVEREMOTE  = 2
+	cIFLAGENEVEREMOTE6 = 7
+)
+
+// isRemoteMechanism checks whether a mechanism type is supported for remote connections
+func isRemoteMechanism(mechanismType string) bool {
+	switch mechanismType {
+	case vxlan.MECHANISM, gre.MECHANISM, geneve.MECHANISM, wireguard.MECHANISM:
+		return true
+	}
+	return false
+}
+
 // handleRemoteConnection handles remote connect/disconnect requests for either incoming or outgoing connections
-func handleRemoteConnection(egress common.EgressInterfaceType, crossConnect *crossconnect.CrossConnect, connect bool) (map[string]monitoring.Device, error) {
-	if crossConnect.GetSource().GetMechanism().GetType() == vxlan.MECHANISM &&
+func handleRemoteConnection(egress common.EgressInterfaceType, wireguardKey []byte, crossConnect *crossconnect.CrossConnect, connect bool) (map[string]monitoring.Device, error) {
+	if isRemoteMechanism(crossConnect.GetSource().GetMechanism().GetType()) &&
 		crossConnect.GetLocalDestination().GetMechanism().GetType() == kernel.MECHANISM {
 		/* 1. Incoming remote connection */
 		logrus.Info("remote: connection type - remote source/local destination - incoming")
-		return handleConnection(egress, crossConnect, connect, cINCOMING)
+		return handleConnection(egress, wireguardKey, crossConnect, connect, cINCOMING)
 	} else if crossConnect.GetSource().GetMechanism().GetType() == kernel.MECHANISM &&
-		crossConnect.GetDestination().GetMechanism().GetType() == vxlan.MECHANISM {
+		isRemoteMechanism(crossConnect.GetDestination().GetMechanism().GetType()) {
 		/* 2. Outgoing remote connection */
 		logrus.Info("remote: connection type - local source/remote destination - outgoing")
-		return handleConnection(egress, crossConnect, connect, cOUTGOING)
+		return handleConnection(egress, wireguardKey, crossConnect, connect, cOUTGOING)
 	}
 	err := errors.Errorf("remote: invalid connection type")
 	logrus.Errorf("%+v", err)
@@ -55,7 +77,7 @@ func handleRemoteConnection(egress common.EgressInterfaceType, crossConnect *cro
 }
 
 // handleConnection process the request to either creating or deleting a connection
-func handleConnection(egress common.EgressInterfaceType, crossConnect *crossconnect.CrossConnect, connect bool, direction uint8) (map[string]monitoring.Device, error) {
+func handleConnection(egress common.EgressInterfaceType, wireguardKey []byte, crossConnect *crossconnect.CrossConnect, connect bool, direction uint8) (map[string]monitoring.Device, error) {
 	var devices map[string]monitoring.Device
 
 	/* 1. Get the connection configuration */
@@ -65,18 +87,45 @@ func handleConnection(egress common.EgressInterfaceType, crossConnect *crossconn
 		return nil, err
 	}
 
-	nsPath, name, ifaceIP, vxlanIP, routes, xconName := modifyConfiguration(cfg, direction)
+	nsPath, name, ifaceIPs, vxlanIP, routes, xconName := modifyConfiguration(cfg, direction)
 
 	if connect {
 		/* 2. Create a connection */
-		devices, err = createRemoteConnection(nsPath, name, xconName, ifaceIP, egress.SrcIPNet().IP, vxlanIP, cfg.vni, routes, cfg.neighbors)
+		var createLink func() error
+		neighbors := cfg.neighbors
+		switch cfg.remoteMechanism.GetType() {
+		case vxlan.MECHANISM:
+			createLink = func() error {
+				return netlink.LinkAdd(newVXLAN(name, egress.SrcIPNet().IP, vxlanIP, cfg.vni))
+			}
+		case gre.MECHANISM:
+			createLink = func() error {
+				return netlink.LinkAdd(newGRE(name, egress.SrcIPNet().IP, vxlanIP, cfg.vni))
+			}
+		case geneve.MECHANISM:
+			createLink = func() error {
+				return addGENEVE(name, vxlanIP, cfg.vni)
+			}
+		case wireguard.MECHANISM:
+			var peer *wireguardPeer
+			if peer, err = newWireGuardPeer(cfg.remoteMechanism, direction); err != nil {
+				logrus.Errorf("remote: invalid WireGuard configuration - %v", err)
+				return nil, err
+			}
+			createLink = func() error {
+				return createWireGuardLink(name, wireguardKey, peer)
+			}
+			/* WireGuard interfaces are layer 3 only, so there are no neighbors */
+			neighbors = nil
+		}
+		devices, err = createRemoteConnection(nsPath, name, xconName, ifaceIPs, createLink, routes, neighbors, cfg.policy, cfg.mtu, direction == cOUTGOING)
 		if err != nil {
 			logrus.Errorf("remote: failed to create connection - %v", err)
 			devices = nil
 		}
 	} else {
 		/* 3. Delete a connection */
-		devices, err = deleteRemoteConnection(nsPath, name, xconName)
+		devices, err = deleteRemoteConnection(nsPath, name, xconName, ifaceIPs, cfg.policy)
 		if err != nil {
 			logrus.Errorf("remote: failed to delete connection - %v", err)
 			devices = nil
@@ -85,8 +134,9 @@ func handleConnection(egress common.EgressInterfaceType, crossConnect *crossconn
 	return devices, err
 }
 
-// createRemoteConnection handler for creating a remote connection
-func createRemoteConnection(nsInode, ifaceName, xconName, ifaceIP string, egressIP, remoteIP net.IP, vni int, routes []*connectioncontext.Route, neighbors []*connectioncontext.IpNeighbor) (map[string]monitoring.Device, error) {
+// createRemoteConnection handler for creating a remote connection, createLink creates the tunnel interface,
+// client is true for an outgoing connection
+func createRemoteConnection(nsInode, ifaceName, xconName string, ifaceIPs []string, createLink func() error, routes []*connectioncontext.Route, neighbors []*connectioncontext.IpNeighbor, policy *connectioncontext.PolicyRoutingContext, mtu int, client bool) (map[string]monitoring.Device, error) {
 	logrus.Info("remote: creating connection...")
 
 	/* Lock the OS thread so we don't accidentally switch namespaces */
@@ -109,13 +159,16 @@ func createRemoteConnection(nsInode, ifaceName, xconName, ifaceIP string, egress
 	logrus.Debug("remote: opened destination handle: ", dstHandle, nsInode)
 
 	/* Create interface - host namespace */
-	if err = netlink.LinkAdd(newVXLAN(ifaceName, egressIP, remoteIP, vni)); err != nil {
-		logrus.Errorf("remote: failed to create VXLAN interface - %v", err)
+	if err = createLink(); err != nil {
+		logrus.Errorf("remote: failed to create interface - %v", err)
+		return nil, err
+	}
+	if err = setLinkAlias(ifaceName, xconName); err != nil {
 		return nil, err
 	}
 
 	/* Setup interface - inject from host to destination namespace */
-	if err = setupLinkInNs(dstHandle, ifaceName, ifaceIP, routes, neighbors, true); err != nil {
+	if err = setupLinkInNs(dstHandle, ifaceName, ifaceIPs, routes, neighbors, policy, mtu, true, client); err != nil {
 		logrus.Errorf("remote: failed to setup interface - destination - %q: %v", ifaceName, err)
 		return nil, err
 	}
@@ -124,7 +177,7 @@ func createRemoteConnection(nsInode, ifaceName, xconName, ifaceIP string, egress
 }
 
 // deleteRemoteConnection handler for deleting a remote connection
-func deleteRemoteConnection(nsInode, ifaceName, xconName string) (map[string]monitoring.Device, error) {
+func deleteRemoteConnection(nsInode, ifaceName, xconName string, ifaceIPs []string, policy *connectioncontext.PolicyRoutingContext) (map[string]monitoring.Device, error) {
 	logrus.Info("remote: deleting connection...")
 
 	/* Lock the OS thread so we don't accidentally switch namespaces */
@@ -147,7 +200,7 @@ func deleteRemoteConnection(nsInode, ifaceName, xconName string) (map[string]mon
 	logrus.Debug("remote: opened destination handle: ", dstHandle, nsInode)
 
 	/* Setup interface - extract from destination to host namespace */
-	if err = setupLinkInNs(dstHandle, ifaceName, "", nil, nil, false); err != nil {
+	if err = setupLinkInNs(dstHandle, ifaceName, ifaceIPs, nil, nil, policy, 0, false, false); err != nil {
 		logrus.Errorf("remote: failed to setup interface - destination -  %q: %v", ifaceName, err)
 		return nil, err
 	}
@@ -159,9 +212,9 @@ func deleteRemoteConnection(nsInode, ifaceName, xconName string) (map[string]mon
 		return nil, err
 	}
 
-	/* Delete the VXLAN interface - host namespace */
+	/* Delete the interface - host namespace */
 	if err := netlink.LinkDel(ifaceLink); err != nil {
-		logrus.Errorf("remote: failed to delete VXLAN interface - %v", err)
+		logrus.Errorf("remote: failed to delete interface - %v", err)
 		return nil, err
 	}
 	logrus.Infof("remote: deletion completed for device - %s", ifaceName)
@@ -169,11 +222,47 @@ func deleteRemoteConnection(nsInode, ifaceName, xconName string) (map[string]mon
 }
 
 // modifyConfiguration swaps the values based on the direction of the connection - incoming or outgoing
-func modifyConfiguration(cfg *connectionConfig, direction uint8) (string, string, string, net.IP, []*connectioncontext.Route, string) {
+func modifyConfiguration(cfg *connectionConfig, direction uint8) (string, string, []string, net.IP, []*connectioncontext.Route, string) {
 	if direction == cINCOMING {
-		return cfg.dstNetNsInode, cfg.dstName, cfg.dstIP, cfg.srcIPVXLAN, cfg.dstRoutes, "DST-" + cfg.id
+		return cfg.dstNetNsInode, cfg.dstName, cfg.dstIPs, cfg.srcIPVXLAN, cfg.dstRoutes, "DST-" + cfg.id
+	}
+	return cfg.srcNetNsInode, cfg.srcName, cfg.srcIPs, cfg.dstIPVXLAN, cfg.srcRoutes, "SRC-" + cfg.id
+}
+
+// newGRE returns a GRE interface instance, ethernet frames are tunneled to keep connections layer 2 as with VXLAN
+func newGRE(ifaceName string, egressIP, remoteIP net.IP, key int) *netlink.Gretap {
+	/* Populate the GRE interface configuration */
+	return &netlink.Gretap{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: ifaceName,
+		},
+		IKey:   uint32(key),
+		OKey:   uint32(key),
+		Local:  egressIP,
+		Remote: remoteIP,
+	}
+}
+
+// addGENEVE creates a GENEVE interface, the netlink library does not support GENEVE links, so the request is built here
+func addGENEVE(ifaceName string, remoteIP net.IP, vni int) error {
+	req := nl.NewNetlinkRequest(syscall.RTM_NEWLINK, syscall.NLM_F_CREATE|syscall.NLM_F_EXCL|syscall.NLM_F_ACK)
+	req.AddData(nl.NewIfInfomsg(syscall.AF_UNSPEC))
+	req.AddData(nl.NewRtAttr(syscall.IFLA_IFNAME, nl.ZeroTerminated(ifaceName)))
+
+	/* Populate the GENEVE interface configuration, see include/uapi/linux/if_link.h */
+	linkInfo := nl.NewRtAttr(syscall.IFLA_LINKINFO, nil)
+	nl.NewRtAttrChild(linkInfo, nl.IFLA_INFO_KIND, nl.NonZeroTerminated(cGENEVE))
+	data := nl.NewRtAttrChild(linkInfo, nl.IFLA_INFO_DATA, nil)
+	nl.NewRtAttrChild(data, cIFLAGENEVEID, nl.Uint32Attr(uint32(vni)))
+	if ip := remoteIP.To4(); ip != nil {
+		nl.NewRtAttrChild(data, cIFLAGENEVEREMOTE, ip)
+	} else {
+		nl.NewRtAttrChild(data, cIFLAGENEVEREMOTE6, remoteIP.To16())
 	}
-	return cfg.srcNetNsInode, cfg.srcName, cfg.srcIP, cfg.dstIPVXLAN, cfg.srcRoutes, "SRC-" + cfg.id
+	req.AddData(linkInfo)
+
+	_, err := req.Execute(syscall.NETLINK_ROUTE, 0)
+	return err
 }
 
 // newVXLAN returns a VXLAN interface instance
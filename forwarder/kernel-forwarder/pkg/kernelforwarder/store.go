@@ -0,0 +1,128 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelforwarder
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+/* crossConnectsFile is a file in the NSM base directory, cross connects programmed by the forwarder are saved to */
+const crossConnectsFile = "kernel-forwarder.crossconnects"
+
+// crossConnectStore saves cross connects programmed by the forwarder to a file, so they survive its restart
+type crossConnectStore struct {
+	sync.Mutex
+	path  string
+	xcons map[string]*crossconnect.CrossConnect
+}
+
+func newCrossConnectStore(baseDir string) *crossConnectStore {
+	return &crossConnectStore{
+		path:  path.Join(baseDir, crossConnectsFile),
+		xcons: map[string]*crossconnect.CrossConnect{},
+	}
+}
+
+// get returns the saved cross connect or nil
+func (s *crossConnectStore) get(id string) *crossconnect.CrossConnect {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.xcons[id]
+}
+
+// list returns the saved cross connects
+func (s *crossConnectStore) list() []*crossconnect.CrossConnect {
+	s.Lock()
+	defer s.Unlock()
+
+	xcons := make([]*crossconnect.CrossConnect, 0, len(s.xcons))
+	for _, xcon := range s.xcons {
+		xcons = append(xcons, xcon)
+	}
+	return xcons
+}
+
+// put saves the cross connect
+func (s *crossConnectStore) put(xcon *crossconnect.CrossConnect) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.xcons[xcon.GetId()] = xcon
+	return s.write()
+}
+
+// delete deletes the saved cross connect
+func (s *crossConnectStore) delete(id string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.xcons, id)
+	return s.write()
+}
+
+// reset replaces the saved cross connects with the given ones
+func (s *crossConnectStore) reset(xcons []*crossconnect.CrossConnect) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.xcons = map[string]*crossconnect.CrossConnect{}
+	for _, xcon := range xcons {
+		s.xcons[xcon.GetId()] = xcon
+	}
+	return s.write()
+}
+
+func (s *crossConnectStore) write() error {
+	data, err := proto.Marshal(&crossconnect.CrossConnectEvent{CrossConnects: s.xcons})
+	if err != nil {
+		return err
+	}
+	/* Write to a temporary file first, so a crash does not leave a partially written file behind */
+	tmpPath := s.path + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write %s", tmpPath)
+	}
+	return os.Rename(tmpPath, s.path)
+}
+
+// load returns the saved cross connects, there are none if nothing has been saved yet
+func (s *crossConnectStore) load() (map[string]*crossconnect.CrossConnect, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", s.path)
+	}
+	event := &crossconnect.CrossConnectEvent{}
+	if err = proto.Unmarshal(data, event); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", s.path)
+	}
+	return event.GetCrossConnects(), nil
+}
@@ -0,0 +1,42 @@
+package kernelforwarder
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+func TestCrossConnectStore(t *testing.T) {
+	g := NewWithT(t)
+
+	baseDir, err := ioutil.TempDir("", "kernel-forwarder")
+	g.Expect(err).To(BeNil())
+	defer func() { _ = os.RemoveAll(baseDir) }()
+
+	store := newCrossConnectStore(baseDir)
+	xcons, err := store.load()
+	g.Expect(err).To(BeNil())
+	g.Expect(xcons).To(BeEmpty())
+
+	g.Expect(store.put(newLocalTestCrossConnect("1"))).To(Succeed())
+	g.Expect(store.put(newLocalTestCrossConnect("2"))).To(Succeed())
+	g.Expect(store.delete("1")).To(Succeed())
+	g.Expect(store.get("1")).To(BeNil())
+	g.Expect(store.get("2").GetId()).To(Equal("2"))
+
+	/* A new store after a restart loads the saved cross connects */
+	xcons, err = newCrossConnectStore(baseDir).load()
+	g.Expect(err).To(BeNil())
+	g.Expect(xcons).To(HaveLen(1))
+	g.Expect(xcons["2"].GetSource().GetMechanism().GetParameters()).To(Equal(newLocalTestCrossConnect("2").GetSource().GetMechanism().GetParameters()))
+
+	g.Expect(store.reset([]*crossconnect.CrossConnect{newLocalTestCrossConnect("3")})).To(Succeed())
+	xcons, err = newCrossConnectStore(baseDir).load()
+	g.Expect(err).To(BeNil())
+	g.Expect(xcons).To(HaveKey("3"))
+	g.Expect(xcons).To(HaveLen(1))
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelforwarder
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/subinterface"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/forwarder/kernel-forwarder/pkg/monitoring"
+	"github.com/networkservicemesh/networkservicemesh/forwarder/pkg/common"
+	"github.com/networkservicemesh/networkservicemesh/utils"
+	"github.com/networkservicemesh/networkservicemesh/utils/fs"
+)
+
+const (
+	// SubInterfaceParentEnv - name of the host interface sub-interface mechanism creates sub-interfaces on,
+	// the mechanism is not advertised if not set
+	SubInterfaceParentEnv = utils.EnvVar("SUB_INTERFACE_PARENT")
+	// SubInterfaceKindEnv - default kind of sub-interfaces, macvlan or ipvlan
+	SubInterfaceKindEnv = utils.EnvVar("SUB_INTERFACE_KIND")
+	// SubInterfaceModeEnv - default mode of sub-interfaces
+	SubInterfaceModeEnv = utils.EnvVar("SUB_INTERFACE_MODE")
+)
+
+var macvlanModes = map[string]netlink.MacvlanMode{
+	"bridge":   netlink.MACVLAN_MODE_BRIDGE,
+	"private":  netlink.MACVLAN_MODE_PRIVATE,
+	"vepa":     netlink.MACVLAN_MODE_VEPA,
+	"passthru": netlink.MACVLAN_MODE_PASSTHRU,
+}
+
+var ipvlanModes = map[string]netlink.IPVlanMode{
+	"l2":  netlink.IPVLAN_MODE_L2,
+	"l3":  netlink.IPVLAN_MODE_L3,
+	"l3s": netlink.IPVLAN_MODE_L3S,
+}
+
+// newSubInterfaceMechanism returns the sub-interface local mechanism configured by environment, nil if the parent
+// interface is not configured or is invalid
+func newSubInterfaceMechanism() *connection.Mechanism {
+	parent := SubInterfaceParentEnv.StringValue()
+	if parent == "" {
+		return nil
+	}
+	m := &connection.Mechanism{
+		Type: subinterface.MECHANISM,
+		Parameters: map[string]string{
+			subinterface.Parent: parent,
+			subinterface.Kind:   SubInterfaceKindEnv.GetStringOrDefault(subinterface.KindMacvlan),
+			subinterface.Mode:   SubInterfaceModeEnv.StringValue(),
+		},
+	}
+	if err := m.IsValid(); err != nil {
+		logrus.Errorf("kernel-forwarder: invalid sub-interface configuration - %v", err)
+		return nil
+	}
+	if _, err := netlink.LinkByName(parent); err != nil {
+		logrus.Errorf("kernel-forwarder: failed to get sub-interface parent %q - %v", parent, err)
+		return nil
+	}
+	return m
+}
+
+// newSubInterface returns a macvlan or ipvlan sub-interface instance of the parent interface of the mechanism
+func newSubInterface(m *connection.Mechanism, name string, parentIndex int) (netlink.Link, error) {
+	helper := subinterface.ToMechanism(m)
+	if helper == nil {
+		return nil, errors.Errorf("subinterface: invalid mechanism type %v", m.GetType())
+	}
+	kind, err := helper.Kind()
+	if err != nil {
+		return nil, err
+	}
+	mode, err := helper.Mode()
+	if err != nil {
+		return nil, err
+	}
+	attrs := netlink.LinkAttrs{
+		Name:        name,
+		ParentIndex: parentIndex,
+	}
+	if kind == subinterface.KindIpvlan {
+		return &netlink.IPVlan{LinkAttrs: attrs, Mode: ipvlanModes[mode]}, nil
+	}
+	return &netlink.Macvlan{LinkAttrs: attrs, Mode: macvlanModes[mode]}, nil
+}
+
+// handleSubInterfaceConnection either creates or deletes sub-interfaces of the same parent interface in the client
+// and the endpoint namespaces
+func handleSubInterfaceConnection(crossConnect *crossconnect.CrossConnect, connect bool) (map[string]monitoring.Device, error) {
+	logrus.Info("subinterface: connection type - sub-interface source/local destination")
+	/* 1. Get the connection configuration */
+	cfg, err := newConnectionConfig(crossConnect, cLOCAL)
+	if err != nil {
+		logrus.Errorf("subinterface: failed to get connection configuration - %v", err)
+		return nil, err
+	}
+	/* Lock the OS thread so we don't accidentally switch namespaces */
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	/* Get namespace handler - source */
+	srcNsHandle, err := fs.GetNsHandleFromInode(cfg.srcNetNsInode)
+	if err != nil {
+		logrus.Errorf("subinterface: failed to get source namespace handle - %v", err)
+		return nil, err
+	}
+	/* If successful, don't forget to close the handler upon exit */
+	defer func() {
+		if closeErr := srcNsHandle.Close(); closeErr != nil {
+			logrus.Error("subinterface: error when closing source handle: ", closeErr)
+		}
+	}()
+
+	/* Get namespace handler - destination */
+	dstNsHandle, err := fs.GetNsHandleFromInode(cfg.dstNetNsInode)
+	if err != nil {
+		logrus.Errorf("subinterface: failed to get destination namespace handle - %v", err)
+		return nil, err
+	}
+	defer func() {
+		if closeErr := dstNsHandle.Close(); closeErr != nil {
+			logrus.Error("subinterface: error when closing destination handle: ", closeErr)
+		}
+	}()
+
+	if connect {
+		/* 2. Create sub-interfaces and inject them into the source and the destination namespaces */
+		err = createSubInterfaces(srcNsHandle, dstNsHandle, crossConnect, cfg)
+	} else {
+		/* 3. Extract the sub-interfaces from the namespaces and delete them */
+		err = deleteSubInterface(srcNsHandle, cfg.srcName, cfg.srcIPs, cfg.policy)
+		if dstErr := deleteSubInterface(dstNsHandle, cfg.dstName, cfg.dstIPs, nil); err == nil {
+			err = dstErr
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	srcDevice := monitoring.Device{Name: cfg.srcName, XconName: "SRC-" + cfg.id}
+	dstDevice := monitoring.Device{Name: cfg.dstName, XconName: "DST-" + cfg.id}
+	return map[string]monitoring.Device{cfg.srcNetNsInode: srcDevice, cfg.dstNetNsInode: dstDevice}, nil
+}
+
+// createSubInterfaces creates sub-interfaces of the parent interface for the source and the destination, MTU of the
+// sub-interfaces is limited by MTU of the parent interface
+func createSubInterfaces(srcNsHandle, dstNsHandle netns.NsHandle, crossConnect *crossconnect.CrossConnect, cfg *connectionConfig) error {
+	m := crossConnect.GetSource().GetMechanism()
+	parentName, err := subinterface.ToMechanism(m).Parent()
+	if err != nil {
+		return err
+	}
+	parent, err := netlink.LinkByName(parentName)
+	if err != nil {
+		logrus.Errorf("subinterface: failed to get parent interface %q - %v", parentName, err)
+		return err
+	}
+	if parentMTU := parent.Attrs().MTU; cfg.mtu == 0 || cfg.mtu > parentMTU {
+		cfg.mtu = parentMTU
+		common.SetConnectionMTU(crossConnect, uint32(parentMTU))
+	}
+	err = createSubInterface(srcNsHandle, m, parent, cfg.srcName, "SRC-"+cfg.id, func() error {
+		return setupLinkInNs(srcNsHandle, cfg.srcName, cfg.srcIPs, cfg.srcRoutes, cfg.neighbors, cfg.policy, cfg.mtu, true, true)
+	})
+	if err != nil {
+		logrus.Errorf("subinterface: failed to setup interface - source - %q: %v", cfg.srcName, err)
+		return err
+	}
+	err = createSubInterface(dstNsHandle, m, parent, cfg.dstName, "DST-"+cfg.id, func() error {
+		return setupLinkInNs(dstNsHandle, cfg.dstName, cfg.dstIPs, cfg.dstRoutes, nil, nil, cfg.mtu, true, false)
+	})
+	if err != nil {
+		logrus.Errorf("subinterface: failed to setup interface - destination - %q: %v", cfg.dstName, err)
+		if delErr := deleteSubInterface(srcNsHandle, cfg.srcName, cfg.srcIPs, cfg.policy); delErr != nil {
+			logrus.Errorf("subinterface: failed to delete %q - %v", cfg.srcName, delErr)
+		}
+		return err
+	}
+	logrus.Infof("subinterface: creation completed for devices - source: %s, destination: %s", cfg.srcName, cfg.dstName)
+	return nil
+}
+
+// createSubInterface creates a sub-interface of the parent interface in the host namespace and sets it up in the
+// namespace, the sub-interface is deleted if it fails
+func createSubInterface(nsHandle netns.NsHandle, m *connection.Mechanism, parent netlink.Link, name, alias string, setup func() error) error {
+	link, err := newSubInterface(m, name, parent.Attrs().Index)
+	if err != nil {
+		return err
+	}
+	if err = netlink.LinkAdd(link); err != nil {
+		logrus.Errorf("subinterface: failed to create %s sub-interface of %q - %v", link.Type(), parent.Attrs().Name, err)
+		return err
+	}
+	if err = setLinkAlias(name, alias); err == nil {
+		err = setup()
+	}
+	if err != nil {
+		deleteSubInterfaceLink(nsHandle, name)
+	}
+	return err
+}
+
+// deleteSubInterfaceLink deletes a sub-interface left by a failed setup, it may have been injected into the namespace
+func deleteSubInterfaceLink(nsHandle netns.NsHandle, name string) {
+	del := func() error {
+		link, err := netlink.LinkByName(name)
+		if err != nil {
+			return err
+		}
+		return netlink.LinkDel(link)
+	}
+	if del() == nil {
+		return
+	}
+	nsLinkHandle, err := netlink.NewHandleAt(nsHandle)
+	if err != nil {
+		logrus.Errorf("subinterface: failed to delete %q - %v", name, err)
+		return
+	}
+	defer nsLinkHandle.Delete()
+	link, err := nsLinkHandle.LinkByName(name)
+	if err == nil {
+		err = nsLinkHandle.LinkDel(link)
+	}
+	if err != nil {
+		logrus.Errorf("subinterface: failed to delete %q - %v", name, err)
+	}
+}
+
+// deleteSubInterface extracts the sub-interface from the namespace and deletes it
+func deleteSubInterface(nsHandle netns.NsHandle, name string, ips []string, policy *connectioncontext.PolicyRoutingContext) error {
+	if err := setupLinkInNs(nsHandle, name, ips, nil, nil, policy, 0, false, false); err != nil {
+		logrus.Errorf("subinterface: failed to extract interface %q: %v", name, err)
+		return err
+	}
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		logrus.Errorf("subinterface: failed to get link for %q - %v", name, err)
+		return err
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		logrus.Errorf("subinterface: failed to delete %q - %v", name, err)
+		return err
+	}
+	logrus.Infof("subinterface: deletion completed for device: %s", name)
+	return nil
+}
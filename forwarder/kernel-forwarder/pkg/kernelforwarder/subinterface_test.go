@@ -0,0 +1,74 @@
+package kernelforwarder
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/subinterface"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/forwarder/pkg/common"
+)
+
+func newSubInterfaceTestMechanism(kind, mode string) *connection.Mechanism {
+	return &connection.Mechanism{
+		Type: subinterface.MECHANISM,
+		Parameters: map[string]string{
+			subinterface.Parent: "eth1",
+			subinterface.Kind:   kind,
+			subinterface.Mode:   mode,
+		},
+	}
+}
+
+func TestSubInterfaceMacvlan(t *testing.T) {
+	g := NewWithT(t)
+
+	link, err := newSubInterface(newSubInterfaceTestMechanism(subinterface.KindMacvlan, "vepa"), "nsm0", 2)
+	g.Expect(err).To(BeNil())
+	g.Expect(link.Type()).To(Equal("macvlan"))
+	g.Expect(link.Attrs().Name).To(Equal("nsm0"))
+	g.Expect(link.Attrs().ParentIndex).To(Equal(2))
+	g.Expect(link.(*netlink.Macvlan).Mode).To(Equal(netlink.MACVLAN_MODE_VEPA))
+}
+
+func TestSubInterfaceIpvlanDefaultMode(t *testing.T) {
+	g := NewWithT(t)
+
+	link, err := newSubInterface(newSubInterfaceTestMechanism(subinterface.KindIpvlan, ""), "nsm0", 2)
+	g.Expect(err).To(BeNil())
+	g.Expect(link.Type()).To(Equal("ipvlan"))
+	g.Expect(link.(*netlink.IPVlan).Mode).To(Equal(netlink.IPVLAN_MODE_L2))
+}
+
+func TestSubInterfaceInvalidMode(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := newSubInterface(newSubInterfaceTestMechanism(subinterface.KindIpvlan, "bridge"), "nsm0", 2)
+	g.Expect(err).NotTo(BeNil())
+	_, err = newSubInterface(newSubInterfaceTestMechanism("vlan", ""), "nsm0", 2)
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestSubInterfaceRequiresLocalDestination(t *testing.T) {
+	g := NewWithT(t)
+
+	mechanisms := &common.Mechanisms{
+		LocalMechanisms:  []*connection.Mechanism{{Type: kernel.MECHANISM}, newSubInterfaceTestMechanism(subinterface.KindMacvlan, "")},
+		RemoteMechanisms: []*connection.Mechanism{{Type: vxlan.MECHANISM}},
+	}
+	source := &connection.Connection{Mechanism: newSubInterfaceTestMechanism(subinterface.KindMacvlan, "")}
+
+	g.Expect(common.SanityCheckConnectionType(mechanisms, &crossconnect.CrossConnect{
+		Source:      source,
+		Destination: &connection.Connection{Mechanism: &connection.Mechanism{Type: kernel.MECHANISM}},
+	})).To(BeNil())
+	g.Expect(common.SanityCheckConnectionType(mechanisms, &crossconnect.CrossConnect{
+		Source:      source,
+		Destination: &connection.Connection{Mechanism: &connection.Mechanism{Type: vxlan.MECHANISM}},
+	})).NotTo(BeNil())
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelforwarder
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/subinterface"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+// linkConfig is a configuration of a connection interface placed in a namespace
+type linkConfig struct {
+	netNsInode string
+	name       string
+	xconName   string
+	ips        []string
+	routes     []*connectioncontext.Route
+	neighbors  []*connectioncontext.IpNeighbor
+	policy     *connectioncontext.PolicyRoutingContext
+	mtu        int
+	/* client is true for an interface of the client, only its configuration is checked for conflicts */
+	client bool
+}
+
+// updateCrossConnect updates the connection interfaces of the cross connect in place - their addresses, routes,
+// neighbors, policy routing rules and MTU are adjusted to the new connection contexts without recreating them
+func updateCrossConnect(previous, crossConnect *crossconnect.CrossConnect) error {
+	oldLinks, err := linkConfigs(previous)
+	if err != nil {
+		return err
+	}
+	newLinks, err := linkConfigs(crossConnect)
+	if err != nil {
+		return err
+	}
+	if len(oldLinks) != len(newLinks) {
+		return errors.Errorf("update: connection type of %s has changed", crossConnect.GetId())
+	}
+	/* Lock the OS thread so we don't accidentally switch namespaces */
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	for i := range newLinks {
+		if err = updateLinkInNs(oldLinks[i], newLinks[i]); err != nil {
+			logrus.Errorf("update: failed to update interface %q: %v", newLinks[i].name, err)
+			return err
+		}
+	}
+	logrus.Infof("update: update completed for cross connect %s", crossConnect.GetId())
+	return nil
+}
+
+// linkConfigs returns configurations of the connection interfaces of the cross connect placed in namespaces
+func linkConfigs(crossConnect *crossconnect.CrossConnect) ([]*linkConfig, error) {
+	srcType := crossConnect.GetSource().GetMechanism().GetType()
+	dstType := crossConnect.GetDestination().GetMechanism().GetType()
+	switch {
+	case (srcType == kernel.MECHANISM || srcType == subinterface.MECHANISM) && dstType == kernel.MECHANISM:
+		cfg, err := newConnectionConfig(crossConnect, cLOCAL)
+		if err != nil {
+			return nil, err
+		}
+		return []*linkConfig{srcLinkConfig(cfg), dstLinkConfig(cfg)}, nil
+	case isRemoteMechanism(srcType) && dstType == kernel.MECHANISM:
+		cfg, err := newConnectionConfig(crossConnect, cINCOMING)
+		if err != nil {
+			return nil, err
+		}
+		return []*linkConfig{dstLinkConfig(cfg)}, nil
+	case srcType == kernel.MECHANISM && isRemoteMechanism(dstType):
+		cfg, err := newConnectionConfig(crossConnect, cOUTGOING)
+		if err != nil {
+			return nil, err
+		}
+		link := srcLinkConfig(cfg)
+		if cfg.remoteMechanism.GetType() == wireguard.MECHANISM {
+			/* WireGuard interfaces are layer 3 only, so there are no neighbors */
+			link.neighbors = nil
+		}
+		return []*linkConfig{link}, nil
+	}
+	return nil, errors.New("update: invalid connection type")
+}
+
+func srcLinkConfig(cfg *connectionConfig) *linkConfig {
+	return &linkConfig{
+		netNsInode: cfg.srcNetNsInode,
+		name:       cfg.srcName,
+		xconName:   "SRC-" + cfg.id,
+		ips:        cfg.srcIPs,
+		routes:     cfg.srcRoutes,
+		neighbors:  cfg.neighbors,
+		policy:     cfg.policy,
+		mtu:        cfg.mtu,
+		client:     true,
+	}
+}
+
+func dstLinkConfig(cfg *connectionConfig) *linkConfig {
+	return &linkConfig{
+		netNsInode: cfg.dstNetNsInode,
+		name:       cfg.dstName,
+		xconName:   "DST-" + cfg.id,
+		ips:        cfg.dstIPs,
+		routes:     cfg.dstRoutes,
+		mtu:        cfg.mtu,
+	}
+}
+
+// updateLinkInNs switches to the namespace of the interface and applies differences between its old and new configuration
+func updateLinkInNs(oldCfg, newCfg *linkConfig) error {
+	return inNetNs(newCfg.netNsInode, func() error {
+		link, err := netlink.LinkByName(newCfg.name)
+		if err != nil {
+			logrus.Errorf("update: failed to lookup %q, %v", newCfg.name, err)
+			return err
+		}
+		/* Check the new addresses and routes of the client do not conflict with the rest of the namespace */
+		if newCfg.client {
+			if err = checkConflicts(link, newCfg.ips, newCfg.routes, newCfg.policy); err != nil {
+				return err
+			}
+		}
+		return updateLink(link, oldCfg, newCfg)
+	})
+}
+
+// updateLink removes the old configuration missing in the new one first and then adds the new configuration missing
+// in the old one, so the interface and the configuration present in both stay untouched
+func updateLink(link netlink.Link, oldCfg, newCfg *linkConfig) error {
+	oldAddrs, err := parseAddrs(oldCfg.ips)
+	if err != nil {
+		return err
+	}
+	newAddrs, err := parseAddrs(newCfg.ips)
+	if err != nil {
+		return err
+	}
+	oldRoutes, err := linkRoutes(link.Attrs().Index, oldAddrs, oldCfg.routes, int(oldCfg.policy.GetTable()))
+	if err != nil {
+		return err
+	}
+	newRoutes, err := linkRoutes(link.Attrs().Index, newAddrs, newCfg.routes, int(newCfg.policy.GetTable()))
+	if err != nil {
+		return err
+	}
+	oldNeighs, err := linkNeighbors(link.Attrs().Index, oldCfg.neighbors)
+	if err != nil {
+		return err
+	}
+	newNeighs, err := linkNeighbors(link.Attrs().Index, newCfg.neighbors)
+	if err != nil {
+		return err
+	}
+	oldRules := policyRules(oldCfg.policy, oldCfg.ips)
+	newRules := policyRules(newCfg.policy, newCfg.ips)
+
+	/* 1. Delete stale rules, routes and neighbors - a failure leaves just an unused entry behind */
+	for _, i := range missingKeys(ruleKeys(oldRules), ruleKeys(newRules)) {
+		if err = netlink.RuleDel(oldRules[i]); err != nil {
+			logrus.Errorf("update: failed deleting rule %v: %v", oldRules[i], err)
+		}
+	}
+	for _, i := range missingKeys(routeKeys(oldRoutes), routeKeys(newRoutes)) {
+		if err = netlink.RouteDel(oldRoutes[i]); err != nil {
+			logrus.Errorf("update: failed deleting route %v: %v", oldRoutes[i], err)
+		}
+	}
+	for _, i := range missingKeys(neighborKeys(oldNeighs), neighborKeys(newNeighs)) {
+		if err = netlink.NeighDel(oldNeighs[i]); err != nil {
+			logrus.Errorf("update: failed deleting neighbor %v: %v", oldNeighs[i], err)
+		}
+	}
+	/* 2. Replace addresses */
+	for _, i := range missingKeys(addrKeys(oldAddrs), addrKeys(newAddrs)) {
+		if err = netlink.AddrDel(link, oldAddrs[i]); err != nil {
+			logrus.Errorf("update: failed deleting IP %v: %v", oldAddrs[i], err)
+			return err
+		}
+	}
+	for _, i := range missingKeys(addrKeys(newAddrs), addrKeys(oldAddrs)) {
+		if err = netlink.AddrAdd(link, newAddrs[i]); err != nil {
+			logrus.Errorf("update: failed to set IP %v: %v", newAddrs[i], err)
+			return err
+		}
+	}
+	/* 3. Set MTU - zero keeps the current one */
+	if newCfg.mtu > 0 && newCfg.mtu != link.Attrs().MTU {
+		if err = netlink.LinkSetMTU(link, newCfg.mtu); err != nil {
+			logrus.Errorf("update: failed to set MTU %d of %q: %v", newCfg.mtu, newCfg.name, err)
+			return err
+		}
+	}
+	/* 4. Add new routes, neighbors and rules */
+	for _, i := range missingKeys(routeKeys(newRoutes), routeKeys(oldRoutes)) {
+		if err = netlink.RouteAdd(newRoutes[i]); err != nil {
+			logrus.Errorf("update: failed adding route %v: %v", newRoutes[i], err)
+			return err
+		}
+	}
+	for _, i := range missingKeys(neighborKeys(newNeighs), neighborKeys(oldNeighs)) {
+		if err = netlink.NeighAdd(newNeighs[i]); err != nil {
+			logrus.Errorf("update: failed adding neighbor %v: %v", newNeighs[i], err)
+			return err
+		}
+	}
+	for _, i := range missingKeys(ruleKeys(newRules), ruleKeys(oldRules)) {
+		if err = netlink.RuleAdd(newRules[i]); err != nil {
+			logrus.Errorf("update: failed adding rule %v: %v", newRules[i], err)
+			return err
+		}
+	}
+	return nil
+}
+
+// parseAddrs parses the interface addresses in format <address>/<prefix>
+func parseAddrs(ifaceIPs []string) ([]*netlink.Addr, error) {
+	var addrs []*netlink.Addr
+	for _, ifaceIP := range ifaceIPs {
+		addr, err := netlink.ParseAddr(ifaceIP)
+		if err != nil {
+			logrus.Errorf("update: failed to parse IP %q: %v", ifaceIP, err)
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// missingKeys returns indices of the keys, which are not present in the other keys
+func missingKeys(keys, otherKeys []string) []int {
+	present := make(map[string]bool, len(otherKeys))
+	for _, key := range otherKeys {
+		present[key] = true
+	}
+	var rv []int
+	for i, key := range keys {
+		if !present[key] {
+			rv = append(rv, i)
+		}
+	}
+	return rv
+}
+
+func addrKeys(addrs []*netlink.Addr) []string {
+	var keys []string
+	for _, addr := range addrs {
+		keys = append(keys, addr.IPNet.String())
+	}
+	return keys
+}
+
+func routeKeys(routes []*netlink.Route) []string {
+	var keys []string
+	for _, route := range routes {
+		keys = append(keys, fmt.Sprintf("%v table %d src %v", route.Dst, route.Table, route.Src))
+	}
+	return keys
+}
+
+func neighborKeys(neighbors []*netlink.Neigh) []string {
+	var keys []string
+	for _, neighbor := range neighbors {
+		keys = append(keys, fmt.Sprintf("%v lladdr %v", neighbor.IP, neighbor.HardwareAddr))
+	}
+	return keys
+}
+
+func ruleKeys(rules []*netlink.Rule) []string {
+	var keys []string
+	for _, rule := range rules {
+		keys = append(keys, fmt.Sprintf("family %d priority %d from %v fwmark %d table %d", rule.Family, rule.Priority, rule.Src, rule.Mark, rule.Table))
+	}
+	return keys
+}
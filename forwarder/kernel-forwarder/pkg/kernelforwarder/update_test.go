@@ -0,0 +1,142 @@
+package kernelforwarder
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	forwarder "github.com/networkservicemesh/networkservicemesh/forwarder/pkg/common"
+)
+
+// testEgressInterface is an egress interface of unknown MTU
+type testEgressInterface struct {
+	forwarder.EgressInterfaceType
+}
+
+func (e *testEgressInterface) Interface() *net.Interface {
+	return nil
+}
+
+func newKernelTestConnection(name string, ipContext *connectioncontext.IPContext) *connection.Connection {
+	return &connection.Connection{
+		Mechanism: &connection.Mechanism{
+			Type: kernel.MECHANISM,
+			Parameters: map[string]string{
+				common.InterfaceNameKey: name,
+				common.NetNsInodeKey:    "1",
+			},
+		},
+		Context: &connectioncontext.ConnectionContext{
+			IpContext: ipContext,
+			Mtu:       1450,
+		},
+	}
+}
+
+func TestLinkConfigsLocal(t *testing.T) {
+	g := NewWithT(t)
+
+	ipContext := &connectioncontext.IPContext{
+		SrcIpAddr: "10.20.1.1/30",
+		DstIpAddr: "10.20.1.2/30",
+		DstRoutes: []*connectioncontext.Route{{Prefix: "8.8.8.0/24"}},
+		IpNeighbors: []*connectioncontext.IpNeighbor{
+			{Ip: "10.20.1.2", HardwareAddress: "aa:bb:cc:dd:ee:ff"},
+		},
+	}
+	links, err := linkConfigs(&crossconnect.CrossConnect{
+		Id:          "1",
+		Source:      newKernelTestConnection("nsm-src", ipContext),
+		Destination: newKernelTestConnection("nsm-dst", ipContext),
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(links).To(HaveLen(2))
+
+	g.Expect(links[0].name).To(Equal("nsm-src"))
+	g.Expect(links[0].ips).To(Equal([]string{"10.20.1.1/30"}))
+	g.Expect(links[0].routes).To(HaveLen(1))
+	g.Expect(links[0].neighbors).To(HaveLen(1))
+	g.Expect(links[0].mtu).To(Equal(1450))
+	g.Expect(links[0].client).To(BeTrue())
+
+	g.Expect(links[1].name).To(Equal("nsm-dst"))
+	g.Expect(links[1].ips).To(Equal([]string{"10.20.1.2/30"}))
+	g.Expect(links[1].neighbors).To(BeEmpty())
+	g.Expect(links[1].client).To(BeFalse())
+}
+
+func TestLinkConfigsInvalidType(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := linkConfigs(&crossconnect.CrossConnect{
+		Id:     "1",
+		Source: newKernelTestConnection("nsm-src", nil),
+	})
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestUpdateRouteKeys(t *testing.T) {
+	g := NewWithT(t)
+
+	addr, err := netlink.ParseAddr("10.20.1.1/30")
+	g.Expect(err).To(BeNil())
+	oldRoutes, err := linkRoutes(1, []*netlink.Addr{addr}, []*connectioncontext.Route{{Prefix: "8.8.8.0/24"}, {Prefix: "8.8.4.0/24"}}, 0)
+	g.Expect(err).To(BeNil())
+	newRoutes, err := linkRoutes(1, []*netlink.Addr{addr}, []*connectioncontext.Route{{Prefix: "8.8.4.0/24"}, {Prefix: "1.1.1.0/24"}}, 0)
+	g.Expect(err).To(BeNil())
+
+	g.Expect(missingKeys(routeKeys(oldRoutes), routeKeys(newRoutes))).To(Equal([]int{0}))
+	g.Expect(missingKeys(routeKeys(newRoutes), routeKeys(oldRoutes))).To(Equal([]int{1}))
+
+	/* The same prefix in another routing table is a different route */
+	tableRoutes, err := linkRoutes(1, []*netlink.Addr{addr}, []*connectioncontext.Route{{Prefix: "8.8.4.0/24"}}, 100)
+	g.Expect(err).To(BeNil())
+	g.Expect(missingKeys(routeKeys(tableRoutes), routeKeys(newRoutes))).To(Equal([]int{0}))
+}
+
+func TestUpdateNeighborKeys(t *testing.T) {
+	g := NewWithT(t)
+
+	oldNeighs, err := linkNeighbors(1, []*connectioncontext.IpNeighbor{{Ip: "10.20.1.2", HardwareAddress: "aa:bb:cc:dd:ee:ff"}})
+	g.Expect(err).To(BeNil())
+	newNeighs, err := linkNeighbors(1, []*connectioncontext.IpNeighbor{{Ip: "10.20.1.2", HardwareAddress: "aa:bb:cc:dd:ee:00"}})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(missingKeys(neighborKeys(oldNeighs), neighborKeys(newNeighs))).To(Equal([]int{0}))
+	g.Expect(missingKeys(neighborKeys(oldNeighs), neighborKeys(oldNeighs))).To(BeEmpty())
+}
+
+func TestUpdateFailureDropsCrossConnect(t *testing.T) {
+	g := NewWithT(t)
+
+	baseDir, err := ioutil.TempDir("", "kernel-forwarder")
+	g.Expect(err).To(BeNil())
+	defer func() { _ = os.RemoveAll(baseDir) }()
+
+	k := &KernelForwarder{
+		common: &forwarder.ForwarderConfig{
+			EgressInterface: &testEgressInterface{},
+			Mechanisms: &forwarder.Mechanisms{
+				LocalMechanisms: []*connection.Mechanism{{Type: kernel.MECHANISM}},
+			},
+		},
+		store: newCrossConnectStore(baseDir),
+	}
+	previous := newLocalTestCrossConnect("1")
+	g.Expect(k.store.put(previous)).To(BeNil())
+
+	/* Interfaces of the previous cross connect are missing, so it is recreated and fails to be created */
+	updated := newLocalTestCrossConnect("1")
+	updated.GetSource().GetContext().GetIpContext().SrcIpAddr = "10.20.1.5/30"
+	g.Expect(k.update(previous, updated)).NotTo(BeNil())
+	g.Expect(k.store.get("1")).To(BeNil())
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelforwarder
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
+)
+
+// WireGuard generic netlink API, see include/uapi/linux/wireguard.h
+const (
+	wireguardLinkType    = "wireguard"
+	wireguardGenlName    = "wireguard"
+	wireguardGenlVersion = 1
+
+	wgCmdSetDevice = 1
+
+	wgDeviceAIfname     = 2
+	wgDeviceAPrivateKey = 3
+	wgDeviceAFlags      = 5
+	wgDeviceAListenPort = 6
+	wgDeviceAPeers      = 8
+
+	wgDeviceFReplacePeers = 1
+
+	wgPeerAPublicKey                   = 1
+	wgPeerAFlags                       = 3
+	wgPeerAEndpoint                    = 4
+	wgPeerAPersistentKeepaliveInterval = 5
+	wgPeerAAllowedIPs                  = 9
+
+	wgPeerFReplaceAllowedIPs = 2
+
+	wgAllowedIPAFamily   = 1
+	wgAllowedIPAIPAddr   = 2
+	wgAllowedIPACidrMask = 3
+
+	/* Keepalives keep NAT and conntrack entries between nodes open for idle connections */
+	wgPersistentKeepaliveInterval = 25
+	wgProbeLinkName               = "nsm-wg-probe"
+
+	/* wireguardKeyFile is a file in the NSM base directory, the private key of the forwarder is saved to */
+	wireguardKeyFile = "kernel-forwarder.wireguard-key"
+	wireguardKeyLen  = 32
+)
+
+// wireguardPeer is a configuration of a WireGuard interface of a connection and of its only peer
+type wireguardPeer struct {
+	listenPort int
+	publicKey  []byte
+	endpoint   *net.UDPAddr
+	allowedIPs []*net.IPNet
+}
+
+// loadWireGuardKey loads a private key of the forwarder saved in the base directory or generates and saves a new one,
+// so the key advertised to remote forwarders survives a restart, public key is advertised with the remote mechanism
+func loadWireGuardKey(baseDir string) (privateKey, publicKey []byte, err error) {
+	keyPath := path.Join(baseDir, wireguardKeyFile)
+	data, err := ioutil.ReadFile(keyPath)
+	switch {
+	case err == nil && len(data) == wireguardKeyLen:
+		privateKey, publicKey = wireguardKeyPair(data)
+		return privateKey, publicKey, nil
+	case err == nil:
+		logrus.Warnf("wireguard: saved key %s is not valid, generating a new one - %d bytes long", keyPath, len(data))
+	case !os.IsNotExist(err):
+		return nil, nil, errors.Wrapf(err, "failed to read %s", keyPath)
+	}
+	key := make([]byte, wireguardKeyLen)
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, err
+	}
+	/* Write to a temporary file first, so a crash does not leave a partially written key behind */
+	tmpPath := keyPath + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, key, 0600); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to write %s", tmpPath)
+	}
+	if err = os.Rename(tmpPath, keyPath); err != nil {
+		return nil, nil, err
+	}
+	privateKey, publicKey = wireguardKeyPair(key)
+	return privateKey, publicKey, nil
+}
+
+// wireguardKeyPair clamps a Curve25519 private key the way WireGuard does and computes its public key
+func wireguardKeyPair(key []byte) (privateKey, publicKey []byte) {
+	var private, public [wireguardKeyLen]byte
+	copy(private[:], key)
+	private[0] &= 248
+	private[31] &= 127
+	private[31] |= 64
+	curve25519.ScalarBaseMult(&public, &private)
+	return private[:], public[:]
+}
+
+// wireguardSupported checks whether the kernel is able to create WireGuard interfaces
+func wireguardSupported() bool {
+	link := &netlink.GenericLink{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: wgProbeLinkName,
+		},
+		LinkType: wireguardLinkType,
+	}
+	if err := netlink.LinkAdd(link); err != nil {
+		logrus.Infof("wireguard: interfaces are not supported - %v", err)
+		return false
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		logrus.Errorf("wireguard: failed to delete probe interface - %v", err)
+	}
+	return true
+}
+
+// newWireGuardPeer returns a configuration of the local end of the connection based on the direction
+func newWireGuardPeer(m *connection.Mechanism, direction uint8) (*wireguardPeer, error) {
+	wg := wireguard.ToMechanism(m)
+	if wg == nil {
+		return nil, errors.Errorf("wireguard: invalid mechanism type %v", m.GetType())
+	}
+	listenPort, remotePort := wg.SrcPort, wg.DstPort
+	publicKey, remoteIP := wg.DstPublicKey, wg.DstIP
+	if direction == cINCOMING {
+		listenPort, remotePort = wg.DstPort, wg.SrcPort
+		publicKey, remoteIP = wg.SrcPublicKey, wg.SrcIP
+	}
+
+	peer := &wireguardPeer{}
+	var err error
+	if peer.listenPort, err = listenPort(); err != nil {
+		return nil, err
+	}
+	if peer.publicKey, err = publicKey(); err != nil {
+		return nil, err
+	}
+	if peer.allowedIPs, err = wg.AllowedIPs(); err != nil {
+		return nil, err
+	}
+	ip, err := remoteIP()
+	if err != nil {
+		return nil, err
+	}
+	port, err := remotePort()
+	if err != nil {
+		return nil, err
+	}
+	if peer.endpoint, err = net.ResolveUDPAddr("udp", net.JoinHostPort(ip, strconv.Itoa(port))); err != nil {
+		return nil, err
+	}
+	return peer, nil
+}
+
+// createWireGuardLink creates a WireGuard interface in the current namespace, so its UDP socket stays in the host
+// namespace after the interface is injected into the container namespace
+func createWireGuardLink(ifaceName string, privateKey []byte, peer *wireguardPeer) error {
+	link := &netlink.GenericLink{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: ifaceName,
+		},
+		LinkType: wireguardLinkType,
+	}
+	if err := netlink.LinkAdd(link); err != nil {
+		logrus.Errorf("wireguard: failed to create interface - %v", err)
+		return err
+	}
+	if err := configureWireGuardLink(ifaceName, privateKey, peer); err != nil {
+		logrus.Errorf("wireguard: failed to configure interface %q - %v", ifaceName, err)
+		if delErr := netlink.LinkDel(link); delErr != nil {
+			logrus.Errorf("wireguard: failed to delete interface %q - %v", ifaceName, delErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// configureWireGuardLink sets the private key, the listen port and replaces peers of the interface with peer
+func configureWireGuardLink(ifaceName string, privateKey []byte, peer *wireguardPeer) error {
+	family, err := netlink.GenlFamilyGet(wireguardGenlName)
+	if err != nil {
+		return err
+	}
+
+	req := nl.NewNetlinkRequest(int(family.ID), syscall.NLM_F_ACK)
+	req.AddData(&nl.Genlmsg{
+		Command: wgCmdSetDevice,
+		Version: wireguardGenlVersion,
+	})
+	req.AddData(nl.NewRtAttr(wgDeviceAIfname, nl.ZeroTerminated(ifaceName)))
+	req.AddData(nl.NewRtAttr(wgDeviceAPrivateKey, privateKey))
+	req.AddData(nl.NewRtAttr(wgDeviceAListenPort, nl.Uint16Attr(uint16(peer.listenPort))))
+	req.AddData(nl.NewRtAttr(wgDeviceAFlags, nl.Uint32Attr(wgDeviceFReplacePeers)))
+
+	peers := nl.NewRtAttr(wgDeviceAPeers|syscall.NLA_F_NESTED, nil)
+	peerAttr := nl.NewRtAttrChild(peers, syscall.NLA_F_NESTED, nil)
+	nl.NewRtAttrChild(peerAttr, wgPeerAPublicKey, peer.publicKey)
+	nl.NewRtAttrChild(peerAttr, wgPeerAFlags, nl.Uint32Attr(wgPeerFReplaceAllowedIPs))
+	nl.NewRtAttrChild(peerAttr, wgPeerAEndpoint, sockaddr(peer.endpoint))
+	nl.NewRtAttrChild(peerAttr, wgPeerAPersistentKeepaliveInterval, nl.Uint16Attr(wgPersistentKeepaliveInterval))
+	allowedIPs := nl.NewRtAttrChild(peerAttr, wgPeerAAllowedIPs|syscall.NLA_F_NESTED, nil)
+	for _, ipNet := range peer.allowedIPs {
+		ipFamily := nl.GetIPFamily(ipNet.IP)
+		ip := ipNet.IP.To16()
+		if ipFamily == nl.FAMILY_V4 {
+			ip = ipNet.IP.To4()
+		}
+		ones, _ := ipNet.Mask.Size()
+
+		allowedIP := nl.NewRtAttrChild(allowedIPs, syscall.NLA_F_NESTED, nil)
+		nl.NewRtAttrChild(allowedIP, wgAllowedIPAFamily, nl.Uint16Attr(uint16(ipFamily)))
+		nl.NewRtAttrChild(allowedIP, wgAllowedIPAIPAddr, ip)
+		nl.NewRtAttrChild(allowedIP, wgAllowedIPACidrMask, nl.Uint8Attr(uint8(ones)))
+	}
+	req.AddData(peers)
+
+	_, err = req.Execute(syscall.NETLINK_GENERIC, 0)
+	return err
+}
+
+// sockaddr returns addr encoded as struct sockaddr_in or struct sockaddr_in6
+func sockaddr(addr *net.UDPAddr) []byte {
+	if ip := addr.IP.To4(); ip != nil {
+		b := make([]byte, syscall.SizeofSockaddrInet4)
+		nl.NativeEndian().PutUint16(b[0:2], syscall.AF_INET)
+		binary.BigEndian.PutUint16(b[2:4], uint16(addr.Port))
+		copy(b[4:8], ip)
+		return b
+	}
+	b := make([]byte, syscall.SizeofSockaddrInet6)
+	nl.NativeEndian().PutUint16(b[0:2], syscall.AF_INET6)
+	binary.BigEndian.PutUint16(b[2:4], uint16(addr.Port))
+	copy(b[8:24], addr.IP.To16())
+	return b
+}
@@ -0,0 +1,97 @@
+package kernelforwarder
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
+)
+
+func newWireGuardTestMechanism() *connection.Mechanism {
+	srcKey := make([]byte, wireguard.KeyLength)
+	dstKey := make([]byte, wireguard.KeyLength)
+	dstKey[0] = 1
+	return &connection.Mechanism{
+		Type: wireguard.MECHANISM,
+		Parameters: map[string]string{
+			wireguard.SrcIP:        "10.0.0.1",
+			wireguard.DstIP:        "10.0.0.2",
+			wireguard.SrcPort:      "51820",
+			wireguard.DstPort:      "51821",
+			wireguard.SrcPublicKey: wireguard.EncodeKey(srcKey),
+			wireguard.DstPublicKey: wireguard.EncodeKey(dstKey),
+		},
+	}
+}
+
+func TestWireGuardPeerOutgoing(t *testing.T) {
+	g := NewWithT(t)
+
+	peer, err := newWireGuardPeer(newWireGuardTestMechanism(), cOUTGOING)
+	g.Expect(err).To(BeNil())
+	g.Expect(peer.listenPort).To(Equal(51820))
+	g.Expect(peer.publicKey[0]).To(Equal(byte(1)))
+	g.Expect(peer.endpoint.String()).To(Equal("10.0.0.2:51821"))
+	g.Expect(len(peer.allowedIPs)).To(Equal(2))
+}
+
+func TestWireGuardPeerIncoming(t *testing.T) {
+	g := NewWithT(t)
+
+	peer, err := newWireGuardPeer(newWireGuardTestMechanism(), cINCOMING)
+	g.Expect(err).To(BeNil())
+	g.Expect(peer.listenPort).To(Equal(51821))
+	g.Expect(peer.publicKey[0]).To(Equal(byte(0)))
+	g.Expect(peer.endpoint.String()).To(Equal("10.0.0.1:51820"))
+}
+
+func TestWireGuardPeerInvalidKey(t *testing.T) {
+	g := NewWithT(t)
+
+	m := newWireGuardTestMechanism()
+	m.Parameters[wireguard.DstPublicKey] = "invalid"
+	_, err := newWireGuardPeer(m, cOUTGOING)
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestWireGuardKey(t *testing.T) {
+	g := NewWithT(t)
+
+	baseDir, err := ioutil.TempDir("", "kernel-forwarder")
+	g.Expect(err).To(BeNil())
+	defer func() { _ = os.RemoveAll(baseDir) }()
+
+	privateKey, publicKey, err := loadWireGuardKey(baseDir)
+	g.Expect(err).To(BeNil())
+	g.Expect(len(privateKey)).To(Equal(wireguard.KeyLength))
+	g.Expect(len(publicKey)).To(Equal(wireguard.KeyLength))
+
+	/* The key survives a restart */
+	loadedPrivateKey, loadedPublicKey, err := loadWireGuardKey(baseDir)
+	g.Expect(err).To(BeNil())
+	g.Expect(loadedPrivateKey).To(Equal(privateKey))
+	g.Expect(loadedPublicKey).To(Equal(publicKey))
+
+	info, err := os.Stat(path.Join(baseDir, wireguardKeyFile))
+	g.Expect(err).To(BeNil())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+}
+
+func TestSockaddr(t *testing.T) {
+	g := NewWithT(t)
+
+	b := sockaddr(&net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 51820})
+	g.Expect(len(b)).To(Equal(16))
+	g.Expect(b[2:8]).To(Equal([]byte{0xca, 0x6c, 10, 0, 0, 1}))
+
+	b = sockaddr(&net.UDPAddr{IP: net.ParseIP("fd00::1"), Port: 51820})
+	g.Expect(len(b)).To(Equal(28))
+	g.Expect(b[2:4]).To(Equal([]byte{0xca, 0x6c}))
+	g.Expect(b[23]).To(Equal(byte(1)))
+}
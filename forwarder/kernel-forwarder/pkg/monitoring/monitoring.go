@@ -16,7 +16,6 @@
 package monitoring
 
 import (
-	"fmt"
 	"runtime"
 	"sync"
 	"time"
@@ -104,7 +103,7 @@ func collectMetrics(devices *RegisteredDevices) (map[string]*crossconnect.Metric
 				failedDevices[namespace] = append(failedDevices[namespace], device)
 			} else {
 				logrus.Infof("metrics: device %s@%s, metrics - %v", device.Name, namespace, metrics)
-				stats[generateMetricsName(device)] = &crossconnect.Metrics{Metrics: metrics}
+				stats[generateMetricsName(device)] = metrics
 			}
 		}
 	}
@@ -123,7 +122,7 @@ func collectMetrics(devices *RegisteredDevices) (map[string]*crossconnect.Metric
 }
 
 // getDeviceMetrics returns metrics for device in specific namespace
-func getDeviceMetrics(device, nsInode string) (map[string]string, error) {
+func getDeviceMetrics(device, nsInode string) (*crossconnect.Metrics, error) {
 	/* Lock the OS thread so we don't accidentally switch namespaces */
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -178,16 +177,21 @@ func getDeviceMetrics(device, nsInode string) (map[string]string, error) {
 		logrus.Errorf("metrics: failed to lookup %q, %v", device, err)
 		return nil, err
 	}
-	/* 6. Save statistics in metrics map */
-	metrics := make(map[string]string)
-	metrics["rx_bytes"] = fmt.Sprint(link.Attrs().Statistics.RxBytes)
-	metrics["tx_bytes"] = fmt.Sprint(link.Attrs().Statistics.TxBytes)
-	metrics["rx_packets"] = fmt.Sprint(link.Attrs().Statistics.RxPackets)
-	metrics["tx_packets"] = fmt.Sprint(link.Attrs().Statistics.TxPackets)
-	metrics["rx_error_packets"] = fmt.Sprint(link.Attrs().Statistics.RxErrors)
-	metrics["tx_error_packets"] = fmt.Sprint(link.Attrs().Statistics.TxErrors)
-
-	return metrics, nil
+	/* 6. Save statistics in metrics */
+	stats := link.Attrs().Statistics
+	rx := &crossconnect.TrafficCounters{
+		Bytes:   stats.RxBytes,
+		Packets: stats.RxPackets,
+		Errors:  stats.RxErrors,
+		Drops:   stats.RxDropped,
+	}
+	tx := &crossconnect.TrafficCounters{
+		Bytes:   stats.TxBytes,
+		Packets: stats.TxPackets,
+		Errors:  stats.TxErrors,
+		Drops:   stats.TxDropped,
+	}
+	return crossconnect.NewMetrics(rx, tx, time.Now()), nil
 }
 
 // UpdateDeviceList keeps track of the devices being handled by the Kernel forwarding plane
@@ -0,0 +1,249 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	monitor_crossconnect "github.com/networkservicemesh/networkservicemesh/sdk/monitor/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const (
+	// DatapathProbeEnabledEnv - enables probing of cross connects datapath
+	DatapathProbeEnabledEnv      = utils.EnvVar("DATAPATH_PROBE_ENABLED")
+	datapathProbeIntervalEnv     = utils.EnvVar("DATAPATH_PROBE_INTERVAL")
+	datapathProbeIntervalDefault = 5 * time.Second
+	datapathProbeTimeoutEnv      = utils.EnvVar("DATAPATH_PROBE_TIMEOUT")
+	datapathProbeTimeoutDefault  = time.Second
+	datapathProbeFailuresEnv     = utils.EnvVar("DATAPATH_PROBE_FAILURES")
+	datapathProbeFailuresDefault = 3
+
+	// ProbeMetricsPrefix - prefix of metrics names with datapath probe results, followed by a cross connect id
+	ProbeMetricsPrefix = "PROBE-"
+)
+
+// probeFunc sends a probe from srcIP to dstIP in a network namespace with netNsInode, returns round trip time
+type probeFunc func(netNsInode string, srcIP, dstIP net.IP, timeout time.Duration) (time.Duration, error)
+
+// DatapathProber periodically probes datapath of cross connects from their local source to destination IP and marks
+// destination DOWN if too many probes in a row are lost, so NSMD heals the connection
+type DatapathProber struct {
+	crossConnectServer  monitor_crossconnect.MonitorServer
+	crossConnects       map[string]*crossconnect.CrossConnect
+	crossConnectEventCh chan *crossconnect.CrossConnectEvent
+	probes              map[string]*crossconnect.DatapathProbe
+	probe               probeFunc
+	interval            time.Duration
+	timeout             time.Duration
+	failures            uint32
+}
+
+// CreateDatapathProber creates a new DatapathProber probing cross connects of crossConnectServer
+func CreateDatapathProber(crossConnectServer monitor_crossconnect.MonitorServer) *DatapathProber {
+	rv := newDatapathProber(crossConnectServer, icmpProbe)
+	crossConnectServer.AddRecipient(rv)
+	go rv.run()
+	return rv
+}
+
+func newDatapathProber(crossConnectServer monitor_crossconnect.MonitorServer, probe probeFunc) *DatapathProber {
+	return &DatapathProber{
+		crossConnectServer:  crossConnectServer,
+		crossConnects:       map[string]*crossconnect.CrossConnect{},
+		crossConnectEventCh: make(chan *crossconnect.CrossConnectEvent, 10),
+		probes:              map[string]*crossconnect.DatapathProbe{},
+		probe:               probe,
+		interval:            datapathProbeIntervalEnv.GetOrDefaultDuration(datapathProbeIntervalDefault),
+		timeout:             datapathProbeTimeoutEnv.GetOrDefaultDuration(datapathProbeTimeoutDefault),
+		failures:            uint32(datapathProbeFailuresEnv.GetIntOrDefault(datapathProbeFailuresDefault)),
+	}
+}
+
+// SendMsg receives cross connect events
+func (p *DatapathProber) SendMsg(msg interface{}) error {
+	event, ok := msg.(*crossconnect.CrossConnectEvent)
+	if !ok {
+		return errors.New("datapath prober: wrong type of msg, crossConnectEvent is needed")
+	}
+	p.crossConnectEventCh <- event
+	return nil
+}
+
+func (p *DatapathProber) run() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.probeAll()
+		case event := <-p.crossConnectEventCh:
+			p.handleEvent(event)
+		}
+	}
+}
+
+func (p *DatapathProber) handleEvent(event *crossconnect.CrossConnectEvent) {
+	switch event.Type {
+	case crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER:
+		p.crossConnects = map[string]*crossconnect.CrossConnect{}
+		fallthrough
+	case crossconnect.CrossConnectEventType_UPDATE:
+		for _, xcon := range event.GetCrossConnects() {
+			p.crossConnects[xcon.GetId()] = xcon
+			if probe, ok := p.probes[xcon.GetId()]; ok && xcon.GetDestination().GetState() == connection.State_UP {
+				// Healed or re-requested cross connect is probed from scratch
+				probe.ConsecutiveFailures = 0
+			}
+		}
+	case crossconnect.CrossConnectEventType_DELETE:
+		for _, xcon := range event.GetCrossConnects() {
+			delete(p.crossConnects, xcon.GetId())
+			delete(p.probes, xcon.GetId())
+		}
+	}
+}
+
+func (p *DatapathProber) handlePendingEvents() {
+	for {
+		select {
+		case event := <-p.crossConnectEventCh:
+			p.handleEvent(event)
+		default:
+			return
+		}
+	}
+}
+
+type probeTarget struct {
+	xcon       *crossconnect.CrossConnect
+	netNsInode string
+	srcIP      net.IP
+	dstIP      net.IP
+}
+
+type probeResult struct {
+	xcon *crossconnect.CrossConnect
+	rtt  time.Duration
+	err  error
+}
+
+// probeAll probes all cross connects at once and handles results
+func (p *DatapathProber) probeAll() {
+	var targets []*probeTarget
+	for _, xcon := range p.crossConnects {
+		if target := getProbeTarget(xcon); target != nil {
+			targets = append(targets, target)
+		}
+	}
+	if len(targets) == 0 {
+		return
+	}
+
+	results := make([]*probeResult, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target *probeTarget) {
+			defer wg.Done()
+			rtt, err := p.probe(target.netNsInode, target.srcIP, target.dstIP, p.timeout)
+			results[i] = &probeResult{xcon: target.xcon, rtt: rtt, err: err}
+		}(i, target)
+	}
+	wg.Wait()
+
+	// Cross connects might be deleted while probing
+	p.handlePendingEvents()
+
+	metrics := map[string]*crossconnect.Metrics{}
+	for _, result := range results {
+		if _, ok := p.crossConnects[result.xcon.GetId()]; !ok {
+			continue
+		}
+		probe := p.handleResult(result)
+		metrics[ProbeMetricsPrefix+result.xcon.GetId()] = &crossconnect.Metrics{
+			Probe:     probe,
+			Timestamp: ptypes.TimestampNow(),
+		}
+	}
+	p.crossConnectServer.HandleMetrics(metrics)
+}
+
+func (p *DatapathProber) handleResult(result *probeResult) *crossconnect.DatapathProbe {
+	xcon := result.xcon
+	probe, ok := p.probes[xcon.GetId()]
+	if !ok {
+		probe = &crossconnect.DatapathProbe{}
+		p.probes[xcon.GetId()] = probe
+	}
+	probe.Sent++
+	if result.err == nil {
+		probe.ConsecutiveFailures = 0
+		probe.Rtt = ptypes.DurationProto(result.rtt)
+		return proto.Clone(probe).(*crossconnect.DatapathProbe)
+	}
+
+	probe.Lost++
+	probe.ConsecutiveFailures++
+	logrus.Warnf("datapath prober: probe of cross connect %v failed (%v in a row): %v", xcon.GetId(), probe.ConsecutiveFailures, result.err)
+	if probe.ConsecutiveFailures == p.failures {
+		logrus.Errorf("datapath prober: cross connect %v datapath is broken, destination is down", xcon.GetId())
+		down := proto.Clone(xcon).(*crossconnect.CrossConnect)
+		down.GetDestination().State = connection.State_DOWN
+		p.crossConnects[xcon.GetId()] = down
+		p.crossConnectServer.Update(context.Background(), down)
+	}
+	return proto.Clone(probe).(*crossconnect.DatapathProbe)
+}
+
+// getProbeTarget returns a target to probe cross connect datapath with, nil if the cross connect can't be probed
+func getProbeTarget(xcon *crossconnect.CrossConnect) *probeTarget {
+	src := xcon.GetLocalSource()
+	if src == nil || src.GetMechanism().GetType() != kernel.MECHANISM || src.GetState() != connection.State_UP ||
+		xcon.GetDestination().GetState() != connection.State_UP {
+		return nil
+	}
+	ipContext := src.GetContext().GetIpContext()
+	srcIP, dstIP := parseIP(ipContext.GetSrcIpAddr()), parseIP(ipContext.GetDstIpAddr())
+	if srcIP == nil || dstIP == nil {
+		return nil
+	}
+	return &probeTarget{
+		xcon:       xcon,
+		netNsInode: kernel.ToMechanism(src.GetMechanism()).GetNetNsInode(),
+		srcIP:      srcIP,
+		dstIP:      dstIP,
+	}
+}
+
+func parseIP(address string) net.IP {
+	if ip, _, err := net.ParseCIDR(address); err == nil {
+		return ip
+	}
+	return net.ParseIP(address)
+}
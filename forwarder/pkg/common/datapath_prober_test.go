@@ -0,0 +1,92 @@
+package common
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	monitor_crossconnect "github.com/networkservicemesh/networkservicemesh/sdk/monitor/crossconnect"
+)
+
+func newProbedCrossConnect(id, dstIP string) *crossconnect.CrossConnect {
+	return &crossconnect.CrossConnect{
+		Id: id,
+		Source: &connection.Connection{
+			Id:    id,
+			State: connection.State_UP,
+			Mechanism: &connection.Mechanism{
+				Type:       kernel.MECHANISM,
+				Parameters: map[string]string{common.NetNsInodeKey: "12345"},
+			},
+			Context: &connectioncontext.ConnectionContext{
+				IpContext: &connectioncontext.IPContext{
+					SrcIpAddr: "10.0.0.1/30",
+					DstIpAddr: dstIP,
+				},
+			},
+		},
+		Destination: &connection.Connection{
+			Id:    id,
+			State: connection.State_UP,
+		},
+	}
+}
+
+func TestDatapathProberMarksBrokenDestinationDown(t *testing.T) {
+	g := NewWithT(t)
+
+	server := monitor_crossconnect.NewMonitorServer()
+	healthy, broken := newProbedCrossConnect("1", "10.0.0.2/30"), newProbedCrossConnect("2", "10.0.0.6/30")
+	var lock sync.Mutex
+	var probed []string
+	prober := newDatapathProber(server, func(netNsInode string, srcIP, dstIP net.IP, _ time.Duration) (time.Duration, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		probed = append(probed, netNsInode+":"+srcIP.String()+"->"+dstIP.String())
+		if dstIP.String() == "10.0.0.6" {
+			return 0, errors.New("timeout")
+		}
+		return time.Millisecond, nil
+	})
+	prober.handleEvent(&crossconnect.CrossConnectEvent{
+		Type: crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER,
+		CrossConnects: map[string]*crossconnect.CrossConnect{
+			healthy.GetId(): healthy,
+			broken.GetId():  broken,
+		},
+	})
+
+	for i := 0; i < int(prober.failures); i++ {
+		prober.probeAll()
+	}
+	g.Expect(probed).To(ContainElement("12345:10.0.0.1->10.0.0.2"))
+	g.Expect(probed).To(ContainElement("12345:10.0.0.1->10.0.0.6"))
+	g.Expect(prober.probes).To(HaveLen(2))
+	failed, passed := prober.probes[broken.GetId()], prober.probes[healthy.GetId()]
+	g.Expect(failed.GetConsecutiveFailures()).To(Equal(prober.failures))
+	g.Expect(failed.Loss()).To(Equal(1.0))
+	g.Expect(passed.Loss()).To(BeZero())
+	g.Expect(passed.GetRtt().GetNanos()).To(Equal(int32(time.Millisecond)))
+
+	g.Eventually(func() connection.State {
+		xcon, ok := server.Entities()[broken.GetId()].(*crossconnect.CrossConnect)
+		if !ok {
+			return connection.State_UP
+		}
+		return xcon.GetDestination().GetState()
+	}, time.Second, 10*time.Millisecond).Should(Equal(connection.State_DOWN))
+
+	// Cross connect with DOWN destination is not probed any more
+	probed = nil
+	prober.probeAll()
+	g.Expect(probed).To(HaveLen(1))
+}
@@ -31,6 +31,8 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/subinterface"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/forwarder/api/forwarder"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
@@ -194,6 +196,11 @@ func CreateForwarder(ctx context.Context, dp NSMForwarder, forwarderGoals *Forwa
 		span.Logger().Fatalf("Forwarder initialization failed: %s ", err)
 	}
 
+	if DatapathProbeEnabledEnv.GetBooleanOrDefault(false) {
+		span.Logger().Info("Starting datapath prober...")
+		CreateDatapathProber(config.Monitor)
+	}
+
 	// Verify the configuration is populated
 	if !sanityCheckConfig(config) {
 		span.Logger().Fatalf("Forwarder configuration sanity check failed: %s ", err)
@@ -256,5 +263,10 @@ func SanityCheckConnectionType(mechanisms *Mechanisms, crossConnect *crossconnec
 	if !localFound && !remoteFound {
 		return errors.New("connection mechanism type not supported by the forwarding plane")
 	}
+	/* Sub-interfaces connect a client only to a local endpoint through the same parent interface */
+	if crossConnect.GetSource().GetMechanism().GetType() == subinterface.MECHANISM &&
+		crossConnect.GetLocalDestination().GetMechanism().GetType() != kernel.MECHANISM {
+		return errors.Errorf("sub-interface connection mechanism requires a local %s destination", kernel.MECHANISM)
+	}
 	return nil
 }
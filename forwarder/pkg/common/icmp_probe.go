@@ -0,0 +1,127 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"net"
+	"os"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netns"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/networkservicemesh/networkservicemesh/utils/fs"
+)
+
+const (
+	icmpV4Protocol = 1
+	icmpV6Protocol = 58
+	icmpProbeData  = "nsm-datapath-probe"
+)
+
+var icmpProbeSeq uint32
+
+// icmpProbe sends ICMP echo from srcIP to dstIP in a network namespace with netNsInode, returns round trip time
+func icmpProbe(netNsInode string, srcIP, dstIP net.IP, timeout time.Duration) (time.Duration, error) {
+	network, protocol := "ip4:icmp", icmpV4Protocol
+	var echoType, replyType icmp.Type = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	if dstIP.To4() == nil {
+		network, protocol = "ip6:ipv6-icmp", icmpV6Protocol
+		echoType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+
+	conn, err := listenPacketInNetNs(netNsInode, network, srcIP.String())
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	id := os.Getpid() & 0xffff
+	seq := int(atomic.AddUint32(&icmpProbeSeq, 1) & 0xffff)
+	request, err := (&icmp.Message{
+		Type: echoType,
+		Body: &icmp.Echo{ID: id, Seq: seq, Data: []byte(icmpProbeData)},
+	}).Marshal(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	if err = conn.SetDeadline(start.Add(timeout)); err != nil {
+		return 0, err
+	}
+	if _, err = conn.WriteTo(request, &net.IPAddr{IP: dstIP}); err != nil {
+		return 0, errors.Wrapf(err, "failed to send ICMP echo to %v", dstIP)
+	}
+
+	reply := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(reply)
+		if err != nil {
+			return 0, errors.Wrapf(err, "no ICMP echo reply from %v", dstIP)
+		}
+		msg, err := icmp.ParseMessage(protocol, reply[:n])
+		if err != nil || msg.Type != replyType {
+			continue
+		}
+		if echo, ok := msg.Body.(*icmp.Echo); ok && echo.ID == id && echo.Seq == seq &&
+			peer.(*net.IPAddr).IP.Equal(dstIP) {
+			return time.Since(start), nil
+		}
+	}
+}
+
+// listenPacketInNetNs opens a socket in a network namespace with netNsInode, socket keeps working in that namespace
+// after a thread switches back to the host namespace
+func listenPacketInNetNs(netNsInode, network, address string) (*icmp.PacketConn, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	hostNs, err := netns.Get()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get host namespace")
+	}
+	defer func() { _ = hostNs.Close() }()
+
+	targetNs, err := fs.GetNsHandleFromInode(netNsInode)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get namespace handle of %v", netNsInode)
+	}
+	defer func() { _ = targetNs.Close() }()
+
+	if err = netns.Set(targetNs); err != nil {
+		return nil, errors.Wrapf(err, "failed to switch to namespace %v", netNsInode)
+	}
+	conn, listenErr := icmp.ListenPacket(network, address)
+	if err = netns.Set(hostNs); err != nil {
+		// Thread is left in a wrong namespace, so it should not be reused
+		runtime.LockOSThread()
+		if listenErr == nil {
+			_ = conn.Close()
+		}
+		return nil, errors.Wrap(err, "failed to switch back to host namespace")
+	}
+	if listenErr != nil {
+		return nil, errors.Wrapf(listenErr, "failed to listen %v on %v", network, address)
+	}
+	return conn, nil
+}
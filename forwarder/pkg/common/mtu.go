@@ -0,0 +1,114 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/geneve"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/gre"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/srv6"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+// Encapsulation overheads of remote mechanisms with IPv4 outer header
+const (
+	/* outer IPv4 20 + UDP 8 + VXLAN 8 + inner ethernet 14 */
+	vxlanOverhead = 50
+	/* outer IPv4 20 + UDP 8 + GENEVE without options 8 + inner ethernet 14 */
+	geneveOverhead = 50
+	/* outer IPv4 20 + GRE with key 8 + inner ethernet 14 */
+	greOverhead = 42
+	/* outer IPv4 20 + UDP 8 + WireGuard 32 */
+	wireguardOverhead = 60
+	/* outer IPv6 40 + SRH with two segments 40 + inner ethernet 14 */
+	srv6Overhead = 94
+	/* IPv6 outer header is 20 bytes longer than IPv4 one */
+	ipv6Overhead = 20
+)
+
+// EncapsulationOverhead returns the number of bytes remote mechanism adds to packets of the connection
+func EncapsulationOverhead(m *connection.Mechanism) int {
+	overhead := 0
+	switch m.GetType() {
+	case vxlan.MECHANISM:
+		overhead = vxlanOverhead
+	case geneve.MECHANISM:
+		overhead = geneveOverhead
+	case gre.MECHANISM:
+		overhead = greOverhead
+	case wireguard.MECHANISM:
+		overhead = wireguardOverhead
+	case srv6.MECHANISM:
+		return srv6Overhead
+	default:
+		return 0
+	}
+	if ip := net.ParseIP(m.GetParameters()[common.SrcIP]); ip != nil && ip.To4() == nil {
+		overhead += ipv6Overhead
+	}
+	return overhead
+}
+
+// ConnectionMTU returns MTU of interfaces of the cross connect - MTU requested by connections limited by localMTU for
+// local connections or by the smaller MTU of the egress interfaces of forwarders on both ends without encapsulation
+// overhead for remote ones, so both ends get the same MTU. The limit is used if connections do not request MTU.
+func ConnectionMTU(crossConnect *crossconnect.CrossConnect, egressMTU, localMTU int) uint32 {
+	requested := 0
+	for _, conn := range []*connection.Connection{crossConnect.GetSource(), crossConnect.GetDestination()} {
+		if mtu := int(conn.GetContext().GetMtu()); mtu != 0 && (requested == 0 || mtu < requested) {
+			requested = mtu
+		}
+	}
+
+	limit := localMTU
+	for _, conn := range []*connection.Connection{crossConnect.GetRemoteSource(), crossConnect.GetRemoteDestination()} {
+		if conn != nil {
+			limit = remoteEgressMTU(conn.GetMechanism(), egressMTU) - EncapsulationOverhead(conn.GetMechanism())
+		}
+	}
+	if limit <= 0 || (requested != 0 && requested < limit) {
+		return uint32(requested)
+	}
+	return uint32(limit)
+}
+
+// remoteEgressMTU returns the smallest of egressMTU and MTUs of egress interfaces of forwarders on both ends
+// advertised with the remote mechanism, zero if none is known
+func remoteEgressMTU(m *connection.Mechanism, egressMTU int) int {
+	rv := egressMTU
+	for _, key := range []string{common.SrcMTU, common.DstMTU} {
+		if mtu, err := strconv.Atoi(m.GetParameters()[key]); err == nil && mtu > 0 && (rv <= 0 || mtu < rv) {
+			rv = mtu
+		}
+	}
+	return rv
+}
+
+// SetConnectionMTU reports MTU of interfaces in contexts of both connections of the cross connect
+func SetConnectionMTU(crossConnect *crossconnect.CrossConnect, mtu uint32) {
+	for _, conn := range []*connection.Connection{crossConnect.GetSource(), crossConnect.GetDestination()} {
+		if conn.GetContext() != nil {
+			conn.GetContext().Mtu = mtu
+		}
+	}
+}
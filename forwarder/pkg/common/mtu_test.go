@@ -0,0 +1,96 @@
+package common
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/kernel"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/vxlan"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/wireguard"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+func newMTUCrossConnect(srcMTU, dstMTU uint32, remoteMechanism *connection.Mechanism) *crossconnect.CrossConnect {
+	xcon := &crossconnect.CrossConnect{
+		Source: &connection.Connection{
+			Mechanism: &connection.Mechanism{Type: kernel.MECHANISM},
+			Context:   &connectioncontext.ConnectionContext{Mtu: srcMTU},
+		},
+		Destination: &connection.Connection{
+			Mechanism: &connection.Mechanism{Type: kernel.MECHANISM},
+			Context:   &connectioncontext.ConnectionContext{Mtu: dstMTU},
+		},
+	}
+	if remoteMechanism != nil {
+		xcon.Destination.Mechanism = remoteMechanism
+		xcon.Destination.Path = &connection.Path{
+			PathSegments: []*connection.PathSegment{{Name: "nsmgr-1"}, {Name: "nsmgr-2"}},
+		}
+	}
+	return xcon
+}
+
+func TestConnectionMTULocal(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ConnectionMTU(newMTUCrossConnect(0, 0, nil), 1500, 16000)).To(Equal(uint32(16000)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(9000, 0, nil), 1500, 16000)).To(Equal(uint32(9000)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(9000, 1400, nil), 1500, 16000)).To(Equal(uint32(1400)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(20000, 0, nil), 1500, 16000)).To(Equal(uint32(16000)))
+}
+
+func TestConnectionMTURemote(t *testing.T) {
+	g := NewWithT(t)
+
+	m := &connection.Mechanism{
+		Type:       vxlan.MECHANISM,
+		Parameters: map[string]string{common.SrcIP: "10.0.0.1"},
+	}
+	g.Expect(ConnectionMTU(newMTUCrossConnect(0, 0, m), 1500, 16000)).To(Equal(uint32(1450)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(9000, 0, m), 1500, 16000)).To(Equal(uint32(1450)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(1300, 0, m), 1500, 16000)).To(Equal(uint32(1300)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(1300, 0, m), 0, 16000)).To(Equal(uint32(1300)))
+
+	m.Parameters[common.SrcIP] = "fd00::1"
+	g.Expect(ConnectionMTU(newMTUCrossConnect(0, 0, m), 1500, 16000)).To(Equal(uint32(1430)))
+}
+
+func TestConnectionMTURemoteEnds(t *testing.T) {
+	g := NewWithT(t)
+
+	m := &connection.Mechanism{
+		Type: vxlan.MECHANISM,
+		Parameters: map[string]string{
+			common.SrcIP:  "10.0.0.1",
+			common.SrcMTU: "9000",
+			common.DstMTU: "1500",
+		},
+	}
+	/* Forwarders on both ends get the same MTU limited by the smaller egress MTU */
+	g.Expect(ConnectionMTU(newMTUCrossConnect(0, 0, m), 9000, 16000)).To(Equal(uint32(1450)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(0, 0, m), 1500, 16000)).To(Equal(uint32(1450)))
+	g.Expect(ConnectionMTU(newMTUCrossConnect(0, 0, m), 0, 16000)).To(Equal(uint32(1450)))
+}
+
+func TestEncapsulationOverhead(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(EncapsulationOverhead(&connection.Mechanism{Type: kernel.MECHANISM})).To(Equal(0))
+	g.Expect(EncapsulationOverhead(&connection.Mechanism{
+		Type:       wireguard.MECHANISM,
+		Parameters: map[string]string{common.SrcIP: "fd00::1"},
+	})).To(Equal(80))
+}
+
+func TestSetConnectionMTU(t *testing.T) {
+	g := NewWithT(t)
+
+	xcon := newMTUCrossConnect(9000, 0, nil)
+	SetConnectionMTU(xcon, 1400)
+	g.Expect(xcon.GetSource().GetContext().GetMtu()).To(Equal(uint32(1400)))
+	g.Expect(xcon.GetDestination().GetContext().GetMtu()).To(Equal(uint32(1400)))
+}
@@ -19,7 +19,9 @@ type clearMechanisms struct {
 	baseDir string
 }
 
-//ClearMechanisms sends clear datachange request if crossconnect monitor has entity with request cross conenect id.
+//ClearMechanisms clears configuration of the previous cross connect, if crossconnect monitor has entity with request cross conenect id.
+// Only configuration missing in the new data change is deleted, so routes, neighbors and addresses of the cross connect are
+// updated in place. Remote mechanisms are cleared only if they have changed.
 func ClearMechanisms(baseDir string) forwarder.ForwarderServer {
 	return &clearMechanisms{
 		baseDir: baseDir,
@@ -40,19 +42,48 @@ func (c *clearMechanisms) Request(ctx context.Context, crossConnect *crossconnec
 		logrus.Infof("montir has not entry with id %v", crossConnect.GetId())
 		return nextRequest(ctx, crossConnect)
 	}
-	clearDataChange, err := converter.NewCrossConnectConverter(entity.(*crossconnect.CrossConnect), conversionParameters).MechanismsToDataRequest(nil, false)
-	if err == nil && clearDataChange != nil {
-		logrus.Infof("Sending clearing DataChange to vppagent: %v", proto.MarshalTextString(clearDataChange))
-		client := ConfiguratorClient(ctx)
-		if client == nil {
-			return nil, errors.New("configuration client is not passed for clear mechanism")
+	previous := entity.(*crossconnect.CrossConnect)
+	client := ConfiguratorClient(ctx)
+	if client == nil {
+		return nil, errors.New("configuration client is not passed for clear mechanism")
+	}
+	if err := c.clearStaleConfig(ctx, client, previous, conversionParameters); err != nil {
+		logrus.Warnf("Stale configuration was not cleared properly before updating: %s", err.Error())
+	}
+	if mechanismsChanged(previous, crossConnect) {
+		clearDataChange, err := converter.NewCrossConnectConverter(previous, conversionParameters).MechanismsToDataRequest(nil, false)
+		if err == nil && clearDataChange != nil {
+			logrus.Infof("Sending clearing DataChange to vppagent: %v", proto.MarshalTextString(clearDataChange))
+			_, err = client.Delete(ctx, &configurator.DeleteRequest{Delete: clearDataChange})
+		}
+		if err != nil {
+			logrus.Warnf("Connection Mechanism was not cleared properly before updating: %s", err.Error())
 		}
-		_, err = client.Delete(ctx, &configurator.DeleteRequest{Delete: clearDataChange})
 	}
+	return nextRequest(ctx, crossConnect)
+}
+
+func (c *clearMechanisms) clearStaleConfig(ctx context.Context, client configurator.ConfiguratorClient, previous *crossconnect.CrossConnect, conversionParameters *converter.CrossConnectConversionParameters) error {
+	dataChange := DataChange(ctx)
+	if dataChange == nil {
+		return nil
+	}
+	previousDataChange, err := converter.NewCrossConnectConverter(previous, conversionParameters).ToDataRequest(nil, false)
 	if err != nil {
-		logrus.Warnf("Connection Mechanism was not cleared properly before updating: %s", err.Error())
+		return err
 	}
-	return nextRequest(ctx, crossConnect)
+	staleDataChange := converter.StaleConfig(previousDataChange, dataChange)
+	if staleDataChange == nil {
+		return nil
+	}
+	logrus.Infof("Sending stale DataChange to vppagent: %v", proto.MarshalTextString(staleDataChange))
+	_, err = client.Delete(ctx, &configurator.DeleteRequest{Delete: staleDataChange})
+	return err
+}
+
+func mechanismsChanged(previous, crossConnect *crossconnect.CrossConnect) bool {
+	return !previous.GetRemoteSource().GetMechanism().Equals(crossConnect.GetRemoteSource().GetMechanism()) ||
+		!previous.GetRemoteDestination().GetMechanism().Equals(crossConnect.GetRemoteDestination().GetMechanism())
 }
 
 func (c *clearMechanisms) Close(ctx context.Context, crossConnect *crossconnect.CrossConnect) (*empty.Empty, error) {
@@ -0,0 +1,42 @@
+package vppagent
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+	"github.com/networkservicemesh/networkservicemesh/forwarder/api/forwarder"
+	"github.com/networkservicemesh/networkservicemesh/forwarder/pkg/common"
+)
+
+// UseMTU returns Forwarder Server negotiating MTU of interfaces of cross connects, MTU of remote connections is limited
+// by egressMTU without encapsulation overhead, MTU of local ones by localMTU
+func UseMTU(egressMTU, localMTU int) forwarder.ForwarderServer {
+	return &mtuNegotiator{
+		egressMTU: egressMTU,
+		localMTU:  localMTU,
+	}
+}
+
+type mtuNegotiator struct {
+	egressMTU int
+	localMTU  int
+}
+
+func (c *mtuNegotiator) Request(ctx context.Context, crossConnect *crossconnect.CrossConnect) (*crossconnect.CrossConnect, error) {
+	mtu := common.ConnectionMTU(crossConnect, c.egressMTU, c.localMTU)
+	Logger(ctx).Infof("MTU of cross connect %v: %v", crossConnect.GetId(), mtu)
+	common.SetConnectionMTU(crossConnect, mtu)
+	if next := Next(ctx); next != nil {
+		return next.Request(ctx, crossConnect)
+	}
+	return crossConnect, nil
+}
+
+func (c *mtuNegotiator) Close(ctx context.Context, crossConnect *crossconnect.CrossConnect) (*empty.Empty, error) {
+	if next := Next(ctx); next != nil {
+		return next.Close(ctx, crossConnect)
+	}
+	return new(empty.Empty), nil
+}
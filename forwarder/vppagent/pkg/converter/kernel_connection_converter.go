@@ -62,13 +62,13 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 	var ipAddresses []string
 	var mac string
 	if c.conversionParameters.Side == DESTINATION {
-		ipAddresses = []string{c.Connection.GetContext().GetIpContext().GetDstIpAddr()}
+		ipAddresses = c.Connection.GetContext().GetIpContext().DstIPAddresses()
 		if !c.GetContext().IsEthernetContextEmtpy() {
 			mac = c.GetContext().EthernetContext.DstMac
 		}
 	}
 	if c.conversionParameters.Side == SOURCE {
-		ipAddresses = []string{c.Connection.GetContext().GetIpContext().GetSrcIpAddr()}
+		ipAddresses = c.Connection.GetContext().GetIpContext().SrcIPAddresses()
 		if !c.GetContext().IsEthernetContextEmtpy() {
 			mac = c.GetContext().EthernetContext.SrcMac
 		}
@@ -76,6 +76,9 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 
 	logrus.Infof("m.GetParameters()[%s]: %s", common.InterfaceNameKey, m.GetParameters()[common.InterfaceNameKey])
 
+	// MTU of all interfaces on the path, zero keeps the defaults
+	mtu := c.GetContext().GetMtu()
+
 	// If we have access to /dev/vhost-net, we can use tapv2.  Otherwise fall back to
 	// veth pairs
 	if useVHostNet() {
@@ -86,6 +89,7 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 			Name:    c.conversionParameters.Name,
 			Type:    vpp_interfaces.Interface_TAP,
 			Enabled: true,
+			Mtu:     mtu,
 			Link: &vpp_interfaces.Interface_Tap{
 				Tap: &vpp_interfaces.TapLink{
 					Version: 2,
@@ -100,6 +104,7 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 			Name:        c.conversionParameters.Name,
 			Type:        linux_interfaces.Interface_TAP_TO_VPP,
 			Enabled:     true,
+			Mtu:         mtu,
 			IpAddresses: ipAddresses,
 			PhysAddress: mac,
 			HostIfName:  m.GetParameters()[common.InterfaceNameKey],
@@ -119,6 +124,7 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 			Name:       c.conversionParameters.Name + "-veth",
 			Type:       linux_interfaces.Interface_VETH,
 			Enabled:    true,
+			Mtu:        mtu,
 			HostIfName: c.conversionParameters.Name + "-veth",
 			Link: &linux_interfaces.Interface_Veth{
 				Veth: &linux_interfaces.VethLink{
@@ -130,6 +136,7 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 			Name:        c.conversionParameters.Name,
 			Type:        linux_interfaces.Interface_VETH,
 			Enabled:     true,
+			Mtu:         mtu,
 			IpAddresses: ipAddresses,
 			PhysAddress: mac,
 			HostIfName:  m.GetParameters()[common.InterfaceNameKey],
@@ -147,6 +154,7 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 			Name:    c.conversionParameters.Name,
 			Type:    vpp_interfaces.Interface_AF_PACKET,
 			Enabled: true,
+			Mtu:     mtu,
 			Link: &vpp_interfaces.Interface_Afpacket{
 				Afpacket: &vpp_interfaces.AfpacketLink{
 					HostIfName: c.conversionParameters.Name + "-veth",
@@ -172,7 +180,7 @@ func (c *KernelConnectionConverter) ToDataRequest(rv *configurator.Config, conne
 				DstNetwork:        route.Prefix,
 				OutgoingInterface: c.conversionParameters.Name,
 				Scope:             linux_l3.Route_GLOBAL,
-				GwAddr:            extractCleanIPAddress(c.Connection.GetContext().GetIpContext().GetDstIpAddr()),
+				GwAddr:            gatewayAddress(c.Connection.GetContext().GetIpContext(), route.Prefix),
 			})
 		}
 	}
@@ -68,10 +68,10 @@ func (c *MemifInterfaceConverter) ToDataRequest(rv *configurator.Config, connect
 
 	var ipAddresses []string
 	if c.conversionParameters.Terminate && c.conversionParameters.Side == DESTINATION {
-		ipAddresses = []string{c.Connection.GetContext().GetIpContext().GetDstIpAddr()}
+		ipAddresses = c.Connection.GetContext().GetIpContext().DstIPAddresses()
 	}
 	if c.conversionParameters.Terminate && c.conversionParameters.Side == SOURCE {
-		ipAddresses = []string{c.Connection.GetContext().GetIpContext().GetSrcIpAddr()}
+		ipAddresses = c.Connection.GetContext().GetIpContext().SrcIPAddresses()
 	}
 
 	if c.conversionParameters.Name == "" {
@@ -82,6 +82,7 @@ func (c *MemifInterfaceConverter) ToDataRequest(rv *configurator.Config, connect
 		Name:        c.conversionParameters.Name,
 		Type:        vpp_interfaces.Interface_MEMIF,
 		Enabled:     true,
+		Mtu:         c.GetContext().GetMtu(),
 		IpAddresses: ipAddresses,
 		Link: &vpp_interfaces.Interface_Memif{
 			Memif: &vpp_interfaces.MemifLink{
@@ -105,7 +106,7 @@ func (c *MemifInterfaceConverter) ToDataRequest(rv *configurator.Config, connect
 		route := &vpp.Route{
 			Type:              vpp_l3.Route_INTER_VRF,
 			DstNetwork:        route.Prefix,
-			NextHopAddr:       extractCleanIPAddress(c.Connection.GetContext().GetIpContext().GetDstIpAddr()),
+			NextHopAddr:       gatewayAddress(c.Connection.GetContext().GetIpContext(), route.Prefix),
 			OutgoingInterface: c.conversionParameters.Name,
 		}
 		rv.VppConfig.Routes = append(rv.VppConfig.Routes, route)
@@ -132,3 +132,28 @@ func TestTerminateDestinationSideConverter(t *testing.T) {
 
 	os.RemoveAll(baseDir)
 }
+
+func TestDualStackConverter(t *testing.T) {
+	g := NewWithT(t)
+	conversionParameters := &ConnectionConversionParameters{
+		Terminate: true,
+		Side:      SOURCE,
+		Name:      interfaceName,
+		BaseDir:   baseDir,
+	}
+	conn := createTestConnection()
+	conn.Context.IpContext.SrcIpAddrs = []string{"fd00::1/126"}
+	conn.Context.IpContext.DstIpAddrs = []string{"fd00::2/126"}
+	conn.Context.IpContext.DstRoutes = []*connectioncontext.Route{
+		{Prefix: "8.8.8.0/24"},
+		{Prefix: "fd01::/64"},
+	}
+	converter := NewMemifInterfaceConverter(conn, conversionParameters)
+	dataRequest, err := converter.ToDataRequest(nil, true)
+	g.Expect(err).To(BeNil())
+
+	g.Expect(dataRequest.VppConfig.Interfaces[0].IpAddresses).To(Equal([]string{srcIp, "fd00::1/126"}))
+	g.Expect(dataRequest.VppConfig.Routes).To(HaveLen(2))
+	g.Expect(dataRequest.VppConfig.Routes[0].NextHopAddr).To(Equal("10.30.1.2"))
+	g.Expect(dataRequest.VppConfig.Routes[1].NextHopAddr).To(Equal("fd00::2"))
+}
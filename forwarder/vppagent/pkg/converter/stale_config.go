@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ligato/vpp-agent/api/configurator"
+	"github.com/ligato/vpp-agent/pkg/models"
+)
+
+// StaleConfig returns items of the old configuration, which are not present in the new one, or nil if there are none.
+// Items are compared by their vpp-agent keys, so an item changed by the new configuration is not stale.
+func StaleConfig(oldConfig, newConfig *configurator.Config) *configurator.Config {
+	keys := map[string]bool{}
+	filterConfig(newConfig, func(item proto.Message) bool {
+		if key, err := models.GetKey(item); err == nil {
+			keys[key] = true
+		}
+		return false
+	})
+	stale := false
+	rv := filterConfig(oldConfig, func(item proto.Message) bool {
+		key, err := models.GetKey(item)
+		if err != nil || keys[key] {
+			return false
+		}
+		stale = true
+		return true
+	})
+	if !stale {
+		return nil
+	}
+	return rv
+}
+
+// filterConfig returns a configuration of the items fn returns true for
+func filterConfig(config *configurator.Config, fn func(item proto.Message) bool) *configurator.Config {
+	rv := &configurator.Config{}
+	if config == nil {
+		return rv
+	}
+	src := reflect.ValueOf(config).Elem()
+	dst := reflect.ValueOf(rv).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		/* VppConfig, LinuxConfig, ... */
+		data := src.Field(i)
+		if data.Kind() != reflect.Ptr || data.IsNil() || data.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		filtered := reflect.New(data.Elem().Type())
+		for j := 0; j < data.Elem().NumField(); j++ {
+			field := data.Elem().Field(j)
+			switch field.Kind() {
+			case reflect.Slice:
+				for k := 0; k < field.Len(); k++ {
+					if item, ok := field.Index(k).Interface().(proto.Message); ok && fn(item) {
+						filteredField := filtered.Elem().Field(j)
+						filteredField.Set(reflect.Append(filteredField, field.Index(k)))
+					}
+				}
+			case reflect.Ptr:
+				if item, ok := field.Interface().(proto.Message); ok && !field.IsNil() && fn(item) {
+					filtered.Elem().Field(j).Set(field)
+				}
+			}
+		}
+		dst.Field(i).Set(filtered)
+	}
+	return rv
+}
@@ -0,0 +1,51 @@
+package converter_test
+
+import (
+	"testing"
+
+	"github.com/ligato/vpp-agent/api/configurator"
+	"github.com/ligato/vpp-agent/api/models/linux"
+	linux_l3 "github.com/ligato/vpp-agent/api/models/linux/l3"
+	"github.com/ligato/vpp-agent/api/models/vpp"
+	vpp_interfaces "github.com/ligato/vpp-agent/api/models/vpp/interfaces"
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/forwarder/vppagent/pkg/converter"
+)
+
+func staleTestConfig(routes ...string) *configurator.Config {
+	config := &configurator.Config{
+		VppConfig: &vpp.ConfigData{
+			Interfaces: []*vpp_interfaces.Interface{{Name: "src-1", Mtu: 1500}},
+		},
+		LinuxConfig: &linux.ConfigData{},
+	}
+	for _, route := range routes {
+		config.LinuxConfig.Routes = append(config.LinuxConfig.Routes, &linux_l3.Route{
+			OutgoingInterface: "src-1",
+			DstNetwork:        route,
+		})
+	}
+	return config
+}
+
+func TestStaleConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	oldConfig := staleTestConfig("10.0.0.0/24", "10.0.1.0/24")
+	newConfig := staleTestConfig("10.0.1.0/24", "10.0.2.0/24")
+	newConfig.VppConfig.Interfaces[0].Mtu = 9000
+
+	stale := converter.StaleConfig(oldConfig, newConfig)
+	g.Expect(stale).NotTo(BeNil())
+	g.Expect(stale.GetVppConfig().GetInterfaces()).To(BeEmpty())
+	g.Expect(stale.GetLinuxConfig().GetRoutes()).To(HaveLen(1))
+	g.Expect(stale.GetLinuxConfig().GetRoutes()[0].GetDstNetwork()).To(Equal("10.0.0.0/24"))
+}
+
+func TestStaleConfigNothingStale(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(converter.StaleConfig(staleTestConfig("10.0.0.0/24"), staleTestConfig("10.0.0.0/24"))).To(BeNil())
+	g.Expect(converter.StaleConfig(nil, staleTestConfig("10.0.0.0/24"))).To(BeNil())
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection/mechanisms/common"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
 	"github.com/networkservicemesh/networkservicemesh/utils/fs"
 )
 
@@ -72,6 +73,20 @@ func extractCleanIPAddress(addr string) string {
 	return addr
 }
 
+// gatewayAddress returns the destination address of the same IP family as the route prefix,
+// falling back to the primary destination address
+func gatewayAddress(ipContext *connectioncontext.IPContext, prefix string) string {
+	_, dst, err := net.ParseCIDR(prefix)
+	if err == nil {
+		for _, addr := range ipContext.DstIPAddresses() {
+			if ip, _, parseErr := net.ParseCIDR(addr); parseErr == nil && (ip.To4() == nil) == (dst.IP.To4() == nil) {
+				return ip.String()
+			}
+		}
+	}
+	return extractCleanIPAddress(ipContext.GetDstIpAddr())
+}
+
 func netNsFileName(m *connection.Mechanism) (string, error) {
 	if m == nil {
 		return "", errors.New("mechanism cannot be nil")
@@ -2,7 +2,6 @@ package vppagent
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"time"
 
@@ -79,14 +78,19 @@ func (m *MetricsCollector) handleNotifications(monitor metrics.MetricsMonitor, s
 
 func convertStatistics(state *interfaces.InterfaceState) map[string]*crossconnect.Metrics {
 	stats := state.Statistics
-	metrics := make(map[string]string)
-	metrics["rx_bytes"] = fmt.Sprint(stats.InBytes)
-	metrics["tx_bytes"] = fmt.Sprint(stats.OutBytes)
-	metrics["rx_packets"] = fmt.Sprint(stats.InPackets)
-	metrics["tx_packets"] = fmt.Sprint(stats.OutPackets)
-	metrics["rx_error_packets"] = fmt.Sprint(stats.InErrorPackets)
-	metrics["tx_error_packets"] = fmt.Sprint(stats.OutErrorPackets)
+	rx := &crossconnect.TrafficCounters{
+		Bytes:   stats.GetInBytes(),
+		Packets: stats.GetInPackets(),
+		Errors:  stats.GetInErrorPackets(),
+		Drops:   stats.GetInNobufPackets() + stats.GetInMissPackets(),
+	}
+	tx := &crossconnect.TrafficCounters{
+		Bytes:   stats.GetOutBytes(),
+		Packets: stats.GetOutPackets(),
+		Errors:  stats.GetOutErrorPackets(),
+		Drops:   stats.GetDropPackets(),
+	}
 	return map[string]*crossconnect.Metrics{
-		state.Name: {Metrics: metrics},
+		state.Name: crossconnect.NewMetrics(rx, tx, time.Now()),
 	}
 }
@@ -63,8 +63,14 @@ func CreateVPPAgent() *VPPAgent {
 
 //CreateForwarderServer creates ForwarderServer handler
 func (v *VPPAgent) CreateForwarderServer(config *common.ForwarderConfig) forwarder.ForwarderServer {
+	egressMTU := 0
+	if iface := config.EgressInterface.Interface(); iface != nil {
+		egressMTU = iface.MTU
+	}
 	return sdk.ChainOf(
 		sdk.RequestValidator(),
+		// MTU of local connections is limited by the egress interface too, it is a safe default for VPP interfaces
+		sdk.UseMTU(egressMTU, egressMTU),
 		sdk.UseCrossConnectMonitor(config.Monitor),
 		sdk.DirectMemifInterfaces(config.NSMBaseDir),
 		sdk.Connect(v.endpoint()),
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,6 +17,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	metricspkg "github.com/networkservicemesh/networkservicemesh/controlplane/pkg/metrics"
 	"github.com/networkservicemesh/networkservicemesh/k8s/pkg/networkservice/clientset/versioned"
@@ -25,6 +25,8 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 )
 
+const streamRetryInterval = time.Second
+
 var closing = false
 var managers = map[string]string{}
 
@@ -39,19 +41,36 @@ func monitorCrossConnects(address string, continuousMonitor bool) {
 	defer conn.Close()
 	forwarderClient := crossconnect.NewMonitorCrossConnectClient(conn)
 
-	// Looping indefinitely or until grpc returns an error indicating the other end closed connection.
-	stream, err := forwarderClient.MonitorCrossConnects(context.Background(), &empty.Empty{})
+	// Looping indefinitely, a broken stream is resumed from the last received revision, so only missed events
+	// are sent again.
+	var revision uint64
+	for !closing {
+		if revision, err = monitorStream(forwarderClient, address, revision, continuousMonitor); err == nil {
+			return
+		}
+		logrus.Errorf("Error: %+v, resuming from revision %v", err, revision)
+		<-time.After(streamRetryInterval)
+	}
+}
+
+func monitorStream(forwarderClient crossconnect.MonitorCrossConnectClient, address string, revision uint64, continuousMonitor bool) (uint64, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
+	stream, err := forwarderClient.MonitorCrossConnects(ctx, &connection.MonitorScopeSelector{
+		ResumeFromRevision: revision,
+	})
 	if err != nil {
-		logrus.Warningf("Error: %+v.", err)
-		return
+		return revision, err
 	}
 	t := proto.TextMarshaler{}
 	for {
 		event, err := stream.Recv()
 		if err != nil {
-			logrus.Errorf("Error: %+v.", err)
-			return
+			return revision, err
+		}
+		if event.GetRevision() != 0 {
+			revision = event.GetRevision()
 		}
 		data := fmt.Sprintf("\u001b[31m*** %s\n\u001b[0m", event.Type)
 		data += fmt.Sprintf("\u001b[31m*** %s\n\u001b[0m", address)
@@ -64,11 +83,15 @@ func monitorCrossConnects(address string, continuousMonitor bool) {
 
 		prom, err := tools.ReadEnvBool(metricspkg.PrometheusEnv, metricspkg.PrometheusDefault)
 		if err == nil && prom && event.Type == crossconnect.CrossConnectEventType_UPDATE {
-			metrics, metricsIdentifiers, errm := getMetrics(event)
+			metricName, metrics, metricsIdentifiers, errm := getMetrics(event)
 			if errm != nil {
 				logrus.Infof("failed to get metrics: %v", err)
 			} else {
-				servePrometheus(metricsIdentifiers, metrics)
+				servePrometheus(metricName, metricsIdentifiers, metrics)
+			}
+		} else if err == nil && prom && event.Type == crossconnect.CrossConnectEventType_DELETE {
+			for _, cc := range event.CrossConnects {
+				metricspkg.ForgetCrossConnectMetrics(cc.GetId())
 			}
 		} else {
 			logrus.Infof("failed to serve prometheus: env PROMETHEUS=%t, err: %v", prom, err)
@@ -76,12 +99,12 @@ func monitorCrossConnects(address string, continuousMonitor bool) {
 		if !continuousMonitor {
 			logrus.Infof("Monitoring of server: %s. is complete...", address)
 			delete(managers, address)
-			return
+			return revision, nil
 		}
 	}
 }
 
-func servePrometheus(metricsIdentifiers map[string]string, metricsData *crossconnect.Metrics) {
+func servePrometheus(metricName string, metricsIdentifiers map[string]string, metricsData *crossconnect.Metrics) {
 	srcPod := metricsIdentifiers[metricspkg.SrcPodKey]
 	srcNamespace := metricsIdentifiers[metricspkg.SrcNamespaceKey]
 	dstPod := metricsIdentifiers[metricspkg.DstPodKey]
@@ -91,21 +114,26 @@ func servePrometheus(metricsIdentifiers map[string]string, metricsData *crosscon
 			srcPod, srcNamespace, dstPod, dstNamespace)
 	} else {
 		prometheusCtx := metricspkg.BuildPrometheusMetricsContext(srcPod, srcNamespace, dstPod, dstNamespace)
+		prometheusCtx.Name = metricName
 		prometheusMetrics := metricspkg.BuildPrometheusMetrics()
 		metricspkg.CollectAllMetrics(prometheusCtx, prometheusMetrics, metricsData)
 	}
 }
 
-func getMetrics(event *crossconnect.CrossConnectEvent) (*crossconnect.Metrics, map[string]string, error) {
+func getMetrics(event *crossconnect.CrossConnectEvent) (string, *crossconnect.Metrics, map[string]string, error) {
 	// event Metrics contain single key-value of type
 	// SRC/DTS + cross connect Id and metrics map
 	for metricName, metrics := range event.Metrics {
+		if metrics.GetProbe() != nil {
+			// Datapath probe results have no traffic counters
+			continue
+		}
 		// Specifying cross connect by `crossConnectID`, parsed from `metricName`.
 		// `communicationSide` can be 'SRC' or 'DST' in order to apply metrics
 		// data to the cross connection source or destination respectively.
 		crossConnectID, communicationSide, err := parseMetricName(metricName)
 		if err != nil {
-			return nil, nil, errors.Errorf("failed to get metrics: %v", err)
+			return "", nil, nil, errors.Errorf("failed to get metrics: %v", err)
 		}
 
 		metricsIdentifiers := map[string]string{}
@@ -118,7 +146,7 @@ func getMetrics(event *crossconnect.CrossConnectEvent) (*crossconnect.Metrics, m
 			}
 		}
 		if len(metricsIdentifiers) == 0 {
-			return nil, nil, errors.Errorf("failed to find crossConnect for metrics: %s", metricName)
+			return "", nil, nil, errors.Errorf("failed to find crossConnect for metrics: %s", metricName)
 		}
 
 		switch communicationSide {
@@ -138,11 +166,11 @@ func getMetrics(event *crossconnect.CrossConnectEvent) (*crossconnect.Metrics, m
 			metricsIdentifiers[metricspkg.DstPodKey] = originalMetricsIdentifiers[metricspkg.SrcPodKey]
 			metricsIdentifiers[metricspkg.DstNamespaceKey] = originalMetricsIdentifiers[metricspkg.SrcNamespaceKey]
 		default:
-			return nil, nil, errors.Errorf("error: communication side should be 'SRC' or 'DST', but got %s", communicationSide)
+			return "", nil, nil, errors.Errorf("error: communication side should be 'SRC' or 'DST', but got %s", communicationSide)
 		}
-		return metrics, metricsIdentifiers, nil
+		return metricName, metrics, metricsIdentifiers, nil
 	}
-	return nil, nil, errors.Errorf("no metrics available in event: %v", event)
+	return "", nil, nil, errors.Errorf("no metrics available in event: %v", event)
 }
 
 func parseMetricName(metricName string) (string, string, error) {
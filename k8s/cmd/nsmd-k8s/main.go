@@ -7,6 +7,9 @@ import (
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
 
 	"github.com/networkservicemesh/networkservicemesh/k8s/pkg/registryserver"
 	k8s_utils "github.com/networkservicemesh/networkservicemesh/k8s/pkg/utils"
@@ -47,13 +50,19 @@ func main() {
 	span.LogValue("NODE_NAME", nsmName)
 	span.Logger().Println("Starting NSMD Kubernetes on " + address + " with NsmName " + nsmName)
 
-	nsmClientSet, _, err := k8s_utils.NewClientSet()
+	nsmClientSet, config, err := k8s_utils.NewClientSet()
+	if err != nil {
+		span.LogError(err)
+		span.Logger().Fatalln("Fail to start NSMD Kubernetes service", err)
+	}
+	kubeClientSet, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		span.LogError(err)
 		span.Logger().Fatalln("Fail to start NSMD Kubernetes service", err)
 	}
 
 	server := registryserver.New(span.Context(), nsmClientSet, nsmName)
+	registry.RegisterPodEventRecorderServer(server, registryserver.NewPodEventRecorder(kubeClientSet, nsmName))
 
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
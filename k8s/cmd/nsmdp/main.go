@@ -24,8 +24,11 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools/spanhelper"
 
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/nsmd"
+	k8s_utils "github.com/networkservicemesh/networkservicemesh/k8s/pkg/utils"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 )
 
@@ -47,7 +50,7 @@ func main() {
 	span.LogObject("registry.at", serviceRegistry.GetPublicAPI())
 	defer span.Finish()
 
-	err := NewNSMDeviceServer(span.Context(), serviceRegistry)
+	err := NewNSMDeviceServer(span.Context(), serviceRegistry, newPodsGetter())
 
 	if err != nil {
 		span.LogError(err)
@@ -59,3 +62,18 @@ func main() {
 	span.Finish()
 	<-c
 }
+
+// newPodsGetter - returns a client of Kubernetes pods API, or nil if it is not available
+func newPodsGetter() typedcorev1.PodsGetter {
+	_, config, err := k8s_utils.NewClientSet()
+	if err != nil {
+		logrus.Warnf("Kubernetes API is not available, pod UIDs will not be resolved: %v", err)
+		return nil
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		logrus.Warnf("Kubernetes API is not available, pod UIDs will not be resolved: %v", err)
+		return nil
+	}
+	return clientset.CoreV1()
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	pluginapi "k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
@@ -59,6 +60,7 @@ type nsmClientEndpoints struct {
 	mutext          sync.Mutex
 	clientId        int
 	insecure        bool
+	pods            typedcorev1.PodsGetter
 }
 
 func (n *nsmClientEndpoints) Allocate(ctx context.Context, reqs *pluginapi.AllocateRequest) (*pluginapi.AllocateResponse, error) {
@@ -77,10 +79,17 @@ func (n *nsmClientEndpoints) Allocate(ctx context.Context, reqs *pluginapi.Alloc
 		})
 	}
 
+	var allocated []string
 	for _, req := range reqs.ContainerRequests {
 		id := req.DevicesIDs[0]
 		span.Logger().Infof("Requesting Workspace, device ID: %s", id)
-		workspace, err := nsmd.RequestWorkspace(span.Context(), n.serviceRegistry, id)
+		workspace, err := nsmd.RequestWorkspace(span.Context(), n.serviceRegistry, id, &nsmdapi.WorkspaceMetadata{
+			Containers: []*nsmdapi.ContainerInfo{
+				{
+					DeviceIds: req.DevicesIDs,
+				},
+			},
+		})
 		span.Logger().Infof("Received Workspace %v", workspace)
 		if err != nil {
 			span.Logger().Errorf("error talking to nsmd: %v", err)
@@ -106,8 +115,12 @@ func (n *nsmClientEndpoints) Allocate(ctx context.Context, reqs *pluginapi.Alloc
 				Envs:   envs,
 			})
 			span.LogObject("responses", responses)
+			allocated = append(allocated, workspace.Workspace)
 		}
 	}
+	if len(allocated) > 0 {
+		go n.refreshWorkspaceMetadata(context.Background(), allocated)
+	}
 	span.Logger().Infof("AllocateResponse: %v", responses)
 	return responses, nil
 }
@@ -240,8 +253,9 @@ func waitForNsmdAvailable(ctx context.Context) {
 	}
 }
 
-// NewNSMDeviceServer registers and starts Kubelet's device plugin
-func NewNSMDeviceServer(ctx context.Context, serviceRegistry serviceregistry.ServiceRegistry) error {
+// NewNSMDeviceServer registers and starts Kubelet's device plugin, pods are used to resolve UIDs of pods workspaces are
+// allocated for and could be nil
+func NewNSMDeviceServer(ctx context.Context, serviceRegistry serviceregistry.ServiceRegistry, pods typedcorev1.PodsGetter) error {
 	span := spanhelper.FromContext(ctx, "start.device.server")
 	defer span.Finish()
 	waitForNsmdAvailable(span.Context())
@@ -256,6 +270,7 @@ func NewNSMDeviceServer(ctx context.Context, serviceRegistry serviceregistry.Ser
 		resp:            new(pluginapi.ListAndWatchResponse),
 		devs:            map[string]*pluginapi.Device{},
 		insecure:        insecure,
+		pods:            pods,
 	}
 
 	for i := 0; i < DeviceBuffer; i++ {
@@ -339,6 +354,7 @@ func (n *nsmClientEndpoints) receiveWorkspaces(ctx context.Context) {
 			}
 		}
 		n.mutext.Unlock()
+		n.updateWorkspaceMetadata(span.Context(), reply)
 		// Be sure we have enought deviced ids allocated.
 		for len(n.resp.Devices) < len(reply.GetWorkspace())+DeviceBuffer {
 			n.addClientDevice()
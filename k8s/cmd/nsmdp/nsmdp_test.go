@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	podresourcesapi "k8s.io/kubernetes/pkg/kubelet/apis/podresources/v1alpha1"
+	pluginapi "k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/limits"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/pkg/tests"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+	"github.com/networkservicemesh/networkservicemesh/sdk/common"
+)
+
+type fakePodResources struct {
+	pods []*podresourcesapi.PodResources
+}
+
+func (f *fakePodResources) List(context.Context, *podresourcesapi.ListPodResourcesRequest) (*podresourcesapi.ListPodResourcesResponse, error) {
+	return &podresourcesapi.ListPodResourcesResponse{PodResources: f.pods}, nil
+}
+
+func startFakePodResources(g *WithT, dir string, pods ...*podresourcesapi.PodResources) *grpc.Server {
+	podResourcesSocket = path.Join(dir, "kubelet.sock")
+	listener, err := net.Listen("unix", podResourcesSocket)
+	g.Expect(err).To(BeNil())
+
+	server := grpc.NewServer()
+	podresourcesapi.RegisterPodResourcesListerServer(server, &fakePodResources{pods: pods})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return server
+}
+
+func TestAllocateAppliesNamespaceLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	dir, err := ioutil.TempDir("", "nsmdp_test")
+	g.Expect(err).To(BeNil())
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	config := path.Join(dir, "limits.json")
+	g.Expect(ioutil.WriteFile(config, []byte(`{"namespaces": {"limited": {"maxConnections": 1}}}`), 0600)).To(BeNil())
+	_ = os.Setenv(limits.ConfigFileEnv.Name(), config)
+	defer func() { _ = os.Unsetenv(limits.ConfigFileEnv.Name()) }()
+	_ = os.Setenv(tools.InsecureEnv, "true")
+
+	kubelet := startFakePodResources(g, dir, &podresourcesapi.PodResources{
+		Name:      "client",
+		Namespace: "limited",
+		Containers: []*podresourcesapi.ContainerResources{
+			{
+				Name: "app",
+				Devices: []*podresourcesapi.ContainerDevices{
+					{
+						ResourceName: resourceName,
+						DeviceIds:    []string{"nsm-1"},
+					},
+				},
+			},
+		},
+	})
+	defer kubelet.Stop()
+
+	pods := fake.NewSimpleClientset(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "limited", UID: "client-uid"},
+	})
+
+	srv := tests.NewNSMDFullServer(tests.Master, tests.NewSharedStorage())
+	defer srv.Stop()
+	srv.AddFakeForwarder("test_data_plane", "tcp:some_addr")
+	srv.TestModel.AddEndpoint(context.Background(), srv.RegisterFakeEndpoint("golden_network", "test", tests.Master))
+
+	plugin := &nsmClientEndpoints{
+		serviceRegistry: srv.ServiceRegistry(),
+		pods:            pods.CoreV1(),
+	}
+	response, err := plugin.Allocate(context.Background(), &pluginapi.AllocateRequest{
+		ContainerRequests: []*pluginapi.ContainerAllocateRequest{
+			{
+				DevicesIDs: []string{"nsm-1"},
+			},
+		},
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(response.GetContainerResponses()).To(HaveLen(1))
+
+	apiClient, apiConn, err := srv.ServiceRegistry().NSMDApiClient(context.Background())
+	g.Expect(err).To(BeNil())
+	defer func() { _ = apiConn.Close() }()
+	g.Eventually(func() *nsmdapi.WorkspaceMetadata {
+		reply, err := apiClient.EnumConnection(context.Background(), &nsmdapi.EnumConnectionRequest{})
+		g.Expect(err).To(BeNil())
+		for _, w := range reply.GetWorkspaces() {
+			if w.GetName() == "nsm-1" {
+				return w.GetMetadata()
+			}
+		}
+		return nil
+	}, 5*time.Second, 100*time.Millisecond).Should(And(
+		WithTransform((*nsmdapi.WorkspaceMetadata).GetNamespace, Equal("limited")),
+		WithTransform((*nsmdapi.WorkspaceMetadata).GetPodUid, Equal("client-uid")),
+	))
+
+	container := response.GetContainerResponses()[0]
+	mount := container.GetMounts()[len(container.GetMounts())-1]
+	socket := path.Join(mount.GetHostPath(), strings.TrimPrefix(container.GetEnvs()[common.NsmServerSocketEnv], mount.GetContainerPath()))
+	conn, err := tools.DialUnix(socket)
+	g.Expect(err).To(BeNil())
+	defer func() { _ = conn.Close() }()
+	nsmClient := networkservice.NewNetworkServiceClient(conn)
+
+	_, err = nsmClient.Request(context.Background(), tests.CreateRequest())
+	g.Expect(err).To(BeNil())
+	_, err = nsmClient.Request(context.Background(), tests.CreateRequest())
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+}
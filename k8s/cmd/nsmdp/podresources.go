@@ -0,0 +1,175 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/kubelet/apis/podresources"
+	podresourcesapi "k8s.io/kubernetes/pkg/kubelet/apis/podresources/v1alpha1"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/nsmdapi"
+	"github.com/networkservicemesh/networkservicemesh/pkg/tools/spanhelper"
+)
+
+const (
+	// PodResourcesPath defines the location of kubelet pod resources socket
+	PodResourcesPath = "/var/lib/kubelet/pod-resources"
+
+	podResourcesTimeout    = 10 * time.Second
+	podResourcesMaxMsgSize = 16 * 1024 * 1024
+
+	// Kubelet assigns devices to a pod only after Allocate returns, so metadata of new workspaces is polled for a while.
+	metadataRefreshDelay    = time.Second
+	metadataRefreshAttempts = 30
+)
+
+// podResourcesSocket - kubelet pod resources API socket
+var podResourcesSocket = path.Join(PodResourcesPath, podresources.Socket+".sock")
+
+// listWorkspaceMetadata - asks kubelet for pods our devices are allocated to, device ids are equal to workspace names.
+func listWorkspaceMetadata(ctx context.Context) (map[string]*nsmdapi.WorkspaceMetadata, error) {
+	span := spanhelper.FromContext(ctx, "listWorkspaceMetadata")
+	defer span.Finish()
+
+	client, conn, err := podresources.GetClient(podResourcesSocket, podResourcesTimeout, podResourcesMaxMsgSize)
+	if err != nil {
+		span.LogError(err)
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	listCtx, cancel := context.WithTimeout(span.Context(), podResourcesTimeout)
+	defer cancel()
+	resp, err := client.List(listCtx, &podresourcesapi.ListPodResourcesRequest{})
+	if err != nil {
+		span.LogError(err)
+		return nil, err
+	}
+
+	result := map[string]*nsmdapi.WorkspaceMetadata{}
+	for _, pod := range resp.GetPodResources() {
+		for _, container := range pod.GetContainers() {
+			for _, devices := range container.GetDevices() {
+				if devices.GetResourceName() != resourceName {
+					continue
+				}
+				for _, id := range devices.GetDeviceIds() {
+					result[id] = &nsmdapi.WorkspaceMetadata{
+						PodName:   pod.GetName(),
+						Namespace: pod.GetNamespace(),
+						Containers: []*nsmdapi.ContainerInfo{
+							{
+								Name:      container.GetName(),
+								DeviceIds: devices.GetDeviceIds(),
+							},
+						},
+					}
+				}
+			}
+		}
+	}
+	span.LogObject("metadata", result)
+	return result, nil
+}
+
+// updateWorkspaceMetadata - provides nsmd with pods workspaces are allocated for, if nsmd does not know them yet.
+func (n *nsmClientEndpoints) updateWorkspaceMetadata(ctx context.Context, reply *nsmdapi.EnumConnectionReply) {
+	var unknown []string
+	for _, w := range reply.GetWorkspaces() {
+		if !n.isMetadataComplete(w.GetMetadata()) {
+			unknown = append(unknown, w.GetName())
+		}
+	}
+	if len(unknown) > 0 {
+		n.resolveWorkspaceMetadata(ctx, unknown)
+	}
+}
+
+// refreshWorkspaceMetadata - waits for kubelet to assign newly allocated workspaces to a pod and provides nsmd with it.
+func (n *nsmClientEndpoints) refreshWorkspaceMetadata(ctx context.Context, workspaces []string) {
+	for attempt := 0; len(workspaces) > 0 && attempt < metadataRefreshAttempts; attempt++ {
+		<-time.After(metadataRefreshDelay)
+		workspaces = n.resolveWorkspaceMetadata(ctx, workspaces)
+	}
+	if len(workspaces) > 0 {
+		logrus.Warnf("Failed to resolve pods for workspaces %v, will retry on next device update", workspaces)
+	}
+}
+
+// resolveWorkspaceMetadata - provides nsmd with pods workspaces are allocated for, returns workspaces not resolved yet.
+func (n *nsmClientEndpoints) resolveWorkspaceMetadata(ctx context.Context, workspaces []string) []string {
+	span := spanhelper.FromContext(ctx, "resolveWorkspaceMetadata")
+	defer span.Finish()
+	span.LogObject("workspaces", workspaces)
+
+	metadata, err := listWorkspaceMetadata(span.Context())
+	if err != nil {
+		span.Logger().Warnf("Failed to receive pod resources from kubelet: %v", err)
+		return workspaces
+	}
+
+	client, con, err := n.serviceRegistry.NSMDApiClient(span.Context())
+	if err != nil {
+		span.LogError(err)
+		return workspaces
+	}
+	defer con.Close()
+
+	var unresolved []string
+	for _, w := range workspaces {
+		if _, ok := metadata[w]; !ok {
+			unresolved = append(unresolved, w)
+			continue
+		}
+		n.fillPodUID(span.Context(), metadata[w])
+		_, err := client.UpdateClientConnection(span.Context(), &nsmdapi.UpdateConnectionRequest{
+			Workspace: w,
+			Metadata:  metadata[w],
+		})
+		if err != nil {
+			span.Logger().Errorf("Failed to update workspace %s metadata: %v", w, err)
+			unresolved = append(unresolved, w)
+			continue
+		}
+		if !n.isMetadataComplete(metadata[w]) {
+			unresolved = append(unresolved, w)
+		}
+	}
+	return unresolved
+}
+
+// fillPodUID - asks Kubernetes API for a UID of the pod, kubelet pod resources API does not provide it.
+func (n *nsmClientEndpoints) fillPodUID(ctx context.Context, metadata *nsmdapi.WorkspaceMetadata) {
+	if n.pods == nil {
+		return
+	}
+	pod, err := n.pods.Pods(metadata.GetNamespace()).Get(metadata.GetPodName(), metav1.GetOptions{})
+	if err != nil {
+		spanhelper.GetSpanHelper(ctx).Logger().Warnf("Failed to get pod %s/%s: %v", metadata.GetNamespace(), metadata.GetPodName(), err)
+		return
+	}
+	metadata.PodUid = string(pod.GetUID())
+}
+
+func (n *nsmClientEndpoints) isMetadataComplete(metadata *nsmdapi.WorkspaceMetadata) bool {
+	return metadata.GetPodName() != "" && (n.pods == nil || metadata.GetPodUid() != "")
+}
@@ -11,6 +11,8 @@ const (
 	RUNNING = "RUNNING"
 	PAUSED  = "PAUSED"
 	ERROR   = "ERROR"
+	// DRAINING - NetworkServiceManager is going to shutdown and does not accept new connections
+	DRAINING = "DRAINING"
 )
 
 // +genclient
@@ -18,6 +18,11 @@ const PodNameEnv = utils.EnvVar("POD_NAME")
 const PodUidEnv = utils.EnvVar("POD_UID")
 
 func mapNsmToCustomResource(nsm *registry.NetworkServiceManager) *v1.NetworkServiceManager {
+	state := v1.State(v1.RUNNING)
+	if nsm.IsDraining() {
+		state = v1.DRAINING
+	}
+
 	nsmCr := &v1.NetworkServiceManager{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: nsm.GetName(),
@@ -27,7 +32,7 @@ func mapNsmToCustomResource(nsm *registry.NetworkServiceManager) *v1.NetworkServ
 			ExpirationTime: metav1.Time{Time: time.Now()},
 		},
 		Status: v1.NetworkServiceManagerStatus{
-			State: v1.RUNNING,
+			State: state,
 		},
 	}
 
@@ -0,0 +1,76 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryserver
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+)
+
+const (
+	podEventsComponent = "nsmd-k8s"
+	// maxPodEventMessageLength - event messages are truncated to keep them readable in `kubectl describe pod`
+	maxPodEventMessageLength = 1024
+)
+
+type podEventRecorderService struct {
+	recorder record.EventRecorder
+}
+
+// NewPodEventRecorder - creates a service recording pod events reported by NSMD as Kubernetes Events
+func NewPodEventRecorder(clientset kubernetes.Interface, nsmName string) registry.PodEventRecorderServer {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	return newPodEventRecorderService(broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{
+		Component: podEventsComponent,
+		Host:      nsmName,
+	}))
+}
+
+func newPodEventRecorderService(recorder record.EventRecorder) *podEventRecorderService {
+	return &podEventRecorderService{
+		recorder: recorder,
+	}
+}
+
+func (rs *podEventRecorderService) RecordPodEvent(ctx context.Context, event *registry.PodEvent) (*empty.Empty, error) {
+	if event.GetPodName() == "" || event.GetNamespace() == "" || event.GetReason() == "" {
+		return nil, errors.Errorf("pod event should have pod name, namespace and reason: %v", event)
+	}
+	message := event.GetMessage()
+	if len(message) > maxPodEventMessageLength {
+		message = message[:maxPodEventMessageLength-3] + "..."
+	}
+	rs.recorder.Event(&v1.ObjectReference{
+		Kind:       "Pod",
+		APIVersion: "v1",
+		Name:       event.GetPodName(),
+		Namespace:  event.GetNamespace(),
+		UID:        types.UID(event.GetPodUid()),
+	}, v1.EventTypeWarning, event.GetReason(), message)
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,40 @@
+package registryserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
+)
+
+func TestPodEventIsRecordedAsWarning(t *testing.T) {
+	g := NewWithT(t)
+
+	recorder := record.NewFakeRecorder(1)
+	_, err := newPodEventRecorderService(recorder).RecordPodEvent(context.Background(), &registry.PodEvent{
+		PodName:   "nsc",
+		Namespace: "default",
+		Reason:    "NoMatchingEndpoint",
+		Message:   strings.Repeat("x", 2000),
+	})
+	g.Expect(err).To(BeNil())
+
+	event := <-recorder.Events
+	g.Expect(event).To(HavePrefix("Warning NoMatchingEndpoint xxx"))
+	g.Expect(event).To(HaveLen(len("Warning NoMatchingEndpoint ") + maxPodEventMessageLength))
+}
+
+func TestPodEventWithoutPodIsRejected(t *testing.T) {
+	g := NewWithT(t)
+
+	recorder := record.NewFakeRecorder(1)
+	_, err := newPodEventRecorderService(recorder).RecordPodEvent(context.Background(), &registry.PodEvent{
+		Reason: "HealFailed",
+	})
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(recorder.Events).To(BeEmpty())
+}
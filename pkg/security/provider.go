@@ -19,8 +19,14 @@ package security
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 )
 
+// Provider - provides SPIFFE identity of a workload and trust bundles to verify identities of peers
 type Provider interface {
 	GetTLSConfig(ctx context.Context) (*tls.Config, error)
+	// GetCertificate returns X.509 SVID of a workload with its private key
+	GetCertificate(ctx context.Context) (*tls.Certificate, error)
+	// GetRoots returns trusted CA certificates mapped by trust domain ID
+	GetRoots(ctx context.Context) (map[string]*x509.CertPool, error)
 }
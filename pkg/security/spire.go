@@ -72,3 +72,17 @@ func NewSpireProvider(addr string) (Provider, error) {
 func (p *spireProvider) GetTLSConfig(ctx context.Context) (*tls.Config, error) {
 	return p.peer.GetConfig(ctx, spiffe.ExpectAnyPeer())
 }
+
+func (p *spireProvider) GetCertificate(ctx context.Context) (*tls.Certificate, error) {
+	if err := p.peer.WaitUntilReady(ctx); err != nil {
+		return nil, err
+	}
+	return p.peer.GetCertificate()
+}
+
+func (p *spireProvider) GetRoots(ctx context.Context) (map[string]*x509.CertPool, error) {
+	if err := p.peer.WaitUntilReady(ctx); err != nil {
+		return nil, err
+	}
+	return p.peer.GetRoots()
+}
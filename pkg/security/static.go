@@ -0,0 +1,158 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spiffe/go-spiffe/spiffe"
+)
+
+type staticProvider struct {
+	cert  *tls.Certificate
+	roots map[string]*x509.CertPool
+}
+
+// NewStaticProvider - creates a provider with fixed SVID and trust bundles
+func NewStaticProvider(cert *tls.Certificate, roots map[string]*x509.CertPool) Provider {
+	return &staticProvider{
+		cert:  cert,
+		roots: roots,
+	}
+}
+
+func (p *staticProvider) GetTLSConfig(ctx context.Context) (*tls.Config, error) {
+	return &tls.Config{
+		Certificates:       []tls.Certificate{*p.cert},
+		ClientAuth:         tls.RequireAnyClientCert,
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			chain := make([]*x509.Certificate, 0, len(rawCerts))
+			for _, raw := range rawCerts {
+				cert, err := x509.ParseCertificate(raw)
+				if err != nil {
+					return err
+				}
+				chain = append(chain, cert)
+			}
+			_, err := spiffe.VerifyPeerCertificate(chain, p.roots, spiffe.ExpectAnyPeer())
+			return err
+		},
+	}, nil
+}
+
+func (p *staticProvider) GetCertificate(ctx context.Context) (*tls.Certificate, error) {
+	return p.cert, nil
+}
+
+func (p *staticProvider) GetRoots(ctx context.Context) (map[string]*x509.CertPool, error) {
+	return p.roots, nil
+}
+
+// SelfSignedCA - issues SVIDs of a single trust domain, intended for tests and development setups without SPIRE
+type SelfSignedCA struct {
+	trustDomain string
+	cert        *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+// NewSelfSignedCA - creates a CA with a newly generated key for trust domain
+func NewSelfSignedCA(trustDomain string) (*SelfSignedCA, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: trustDomain},
+		URIs:                  []*url.URL{spiffe.TrustDomainURI(trustDomain)},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CA certificate")
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &SelfSignedCA{
+		trustDomain: trustDomain,
+		cert:        cert,
+		key:         key,
+	}, nil
+}
+
+// Roots - returns trust bundle of CA
+func (ca *SelfSignedCA) Roots() map[string]*x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return map[string]*x509.CertPool{
+		spiffe.TrustDomainID(ca.trustDomain): pool,
+	}
+}
+
+// NewSVID - issues SVID with SPIFFE ID spiffe://<trust domain>/<path>
+func (ca *SelfSignedCA) NewSVID(path string) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		URIs:         []*url.URL{{Scheme: "spiffe", Host: ca.trustDomain, Path: "/" + path}},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create SVID")
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, nil
+}
+
+// NewProvider - creates a provider with newly issued SVID spiffe://<trust domain>/<path>
+func (ca *SelfSignedCA) NewProvider(path string) (Provider, error) {
+	cert, err := ca.NewSVID(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaticProvider(cert, ca.Roots()), nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/spiffe/go-spiffe/spiffe"
+)
+
+const (
+	// x5cHeader - JWT header carrying X.509 SVID chain of a signer
+	x5cHeader = "x5c"
+	// maxChainLength - max amount of tokens in obo chain accepted by VerifyChain
+	maxChainLength = 32
+)
+
+// PathClaims - claims of a token a hop signs its path segment with, subject is SPIFFE ID of a hop
+type PathClaims struct {
+	jwt.StandardClaims
+	// Obo - token of a previous hop, the token is signed on behalf of
+	Obo string `json:"obo,omitempty"`
+	// Segment - name of a path segment the token is signed for
+	Segment string `json:"seg,omitempty"`
+}
+
+// GenerateToken - signs claims with private key of SVID, sets subject of claims to SPIFFE ID of SVID
+func GenerateToken(cert *tls.Certificate, claims *PathClaims) (string, error) {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return "", errors.New("no certificate to sign token with")
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse certificate")
+	}
+	id, err := SpiffeID(leaf)
+	if err != nil {
+		return "", err
+	}
+	method, err := signingMethod(cert)
+	if err != nil {
+		return "", err
+	}
+
+	claims.Subject = id
+	token := jwt.NewWithClaims(method, claims)
+	x5c := make([]string, 0, len(cert.Certificate))
+	for _, der := range cert.Certificate {
+		x5c = append(x5c, base64.StdEncoding.EncodeToString(der))
+	}
+	token.Header[x5cHeader] = x5c
+	return token.SignedString(cert.PrivateKey)
+}
+
+// VerifyToken - checks token is signed by SVID trusted by roots, subject of token matches SPIFFE ID of SVID and
+// token is not expired
+func VerifyToken(token string, roots map[string]*x509.CertPool) (*PathClaims, error) {
+	claims := &PathClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		switch t.Method.(type) {
+		case *jwt.SigningMethodECDSA, *jwt.SigningMethodRSA:
+		default:
+			return nil, errors.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		chain, err := parseX5C(t.Header[x5cHeader])
+		if err != nil {
+			return nil, err
+		}
+		peerID := ""
+		if _, err := spiffe.VerifyPeerCertificate(chain, roots, func(id string, _ [][]*x509.Certificate) error {
+			peerID = id
+			return nil
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to verify SVID")
+		}
+		if claims.Subject != peerID {
+			return nil, errors.Errorf("token subject %s does not match SVID %s", claims.Subject, peerID)
+		}
+		return chain[0].PublicKey, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid token")
+	}
+	if claims.ExpiresAt == 0 {
+		return nil, errors.New("invalid token: token has no expiration")
+	}
+	return claims, nil
+}
+
+// VerifyChain - verifies token and all tokens it is signed on behalf of, returns claims starting from the origin
+func VerifyChain(token string, roots map[string]*x509.CertPool) ([]*PathClaims, error) {
+	var chain []*PathClaims
+	for token != "" {
+		if len(chain) == maxChainLength {
+			return nil, errors.Errorf("token chain is longer than %d", maxChainLength)
+		}
+		claims, err := VerifyToken(token, roots)
+		if err != nil {
+			return nil, err
+		}
+		chain = append([]*PathClaims{claims}, chain...)
+		token = claims.Obo
+	}
+	return chain, nil
+}
+
+// ParseUnverified - returns claims of token without verifying it
+func ParseUnverified(token string) (*PathClaims, error) {
+	claims := &PathClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// SpiffeID - returns SPIFFE ID of SVID
+func SpiffeID(cert *x509.Certificate) (string, error) {
+	if len(cert.URIs) != 1 {
+		return "", errors.Errorf("certificate should contain exactly one URI SAN, found %d", len(cert.URIs))
+	}
+	id := cert.URIs[0]
+	if err := spiffe.ValidateURI(id, spiffe.AllowAny()); err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func signingMethod(cert *tls.Certificate) (jwt.SigningMethod, error) {
+	switch key := cert.PrivateKey.(type) {
+	case *ecdsa.PrivateKey:
+		switch key.Curve.Params().BitSize {
+		case 256:
+			return jwt.SigningMethodES256, nil
+		case 384:
+			return jwt.SigningMethodES384, nil
+		case 521:
+			return jwt.SigningMethodES512, nil
+		}
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	}
+	return nil, errors.Errorf("unsupported private key type %T", cert.PrivateKey)
+}
+
+func parseX5C(header interface{}) ([]*x509.Certificate, error) {
+	values, ok := header.([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, errors.Errorf("token has no %s header", x5cHeader)
+	}
+	chain := make([]*x509.Certificate, 0, len(values))
+	for _, value := range values {
+		encoded, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid %s header", x5cHeader)
+		}
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s header", x5cHeader)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s header", x5cHeader)
+		}
+		chain = append(chain, cert)
+	}
+	return chain, nil
+}
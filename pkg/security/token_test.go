@@ -0,0 +1,114 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/gomega"
+)
+
+func newClaims(obo string, expiresIn time.Duration) *PathClaims {
+	return &PathClaims{
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(expiresIn).Unix(),
+		},
+		Obo: obo,
+	}
+}
+
+func TestTokenChain(t *testing.T) {
+	g := NewWithT(t)
+
+	ca, err := NewSelfSignedCA("test.com")
+	g.Expect(err).To(BeNil())
+	client, err := ca.NewSVID("client")
+	g.Expect(err).To(BeNil())
+	nsmgr, err := ca.NewSVID("nsmgr")
+	g.Expect(err).To(BeNil())
+
+	clientToken, err := GenerateToken(client, newClaims("", time.Hour))
+	g.Expect(err).To(BeNil())
+	nsmgrToken, err := GenerateToken(nsmgr, newClaims(clientToken, time.Hour))
+	g.Expect(err).To(BeNil())
+
+	claims, err := VerifyToken(nsmgrToken, ca.Roots())
+	g.Expect(err).To(BeNil())
+	g.Expect(claims.Subject).To(Equal("spiffe://test.com/nsmgr"))
+
+	chain, err := VerifyChain(nsmgrToken, ca.Roots())
+	g.Expect(err).To(BeNil())
+	g.Expect(len(chain)).To(Equal(2))
+	g.Expect(chain[0].Subject).To(Equal("spiffe://test.com/client"))
+	g.Expect(chain[1].Subject).To(Equal("spiffe://test.com/nsmgr"))
+}
+
+func TestTokenVerificationFailures(t *testing.T) {
+	g := NewWithT(t)
+
+	ca, err := NewSelfSignedCA("test.com")
+	g.Expect(err).To(BeNil())
+	otherCA, err := NewSelfSignedCA("test.com")
+	g.Expect(err).To(BeNil())
+	nsmgr, err := ca.NewSVID("nsmgr")
+	g.Expect(err).To(BeNil())
+	forger, err := otherCA.NewSVID("client")
+	g.Expect(err).To(BeNil())
+
+	// Untrusted signer
+	forged, err := GenerateToken(forger, newClaims("", time.Hour))
+	g.Expect(err).To(BeNil())
+	_, err = VerifyToken(forged, ca.Roots())
+	g.Expect(err).NotTo(BeNil())
+
+	// Untrusted token somewhere in chain
+	token, err := GenerateToken(nsmgr, newClaims(forged, time.Hour))
+	g.Expect(err).To(BeNil())
+	_, err = VerifyToken(token, ca.Roots())
+	g.Expect(err).To(BeNil())
+	_, err = VerifyChain(token, ca.Roots())
+	g.Expect(err).NotTo(BeNil())
+
+	// Expired token
+	expired, err := GenerateToken(nsmgr, newClaims("", -time.Minute))
+	g.Expect(err).To(BeNil())
+	_, err = VerifyToken(expired, ca.Roots())
+	g.Expect(err).NotTo(BeNil())
+
+	// Tampered claims
+	parts := strings.Split(token, ".")
+	tampered, err := GenerateToken(nsmgr, newClaims("", 2*time.Hour))
+	g.Expect(err).To(BeNil())
+	parts[1] = strings.Split(tampered, ".")[1]
+	_, err = VerifyToken(strings.Join(parts, "."), ca.Roots())
+	g.Expect(err).NotTo(BeNil())
+
+	// Subject is not matching SVID
+	claims := newClaims("", time.Hour)
+	claims.Subject = "spiffe://test.com/client"
+	impersonated := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	impersonated.Header[x5cHeader] = []string{base64.StdEncoding.EncodeToString(nsmgr.Certificate[0])}
+	signed, err := impersonated.SignedString(nsmgr.PrivateKey)
+	g.Expect(err).To(BeNil())
+	_, err = VerifyToken(signed, ca.Roots())
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("does not match"))
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 	"github.com/networkservicemesh/networkservicemesh/sdk/common"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
 )
 
 const (
@@ -55,6 +56,7 @@ type NsmClient struct {
 	OutgoingConnections  []*connection.Connection
 	NscInterfaceName     string
 	tracerCloser         io.Closer
+	refreshCancels       map[string]context.CancelFunc
 }
 
 // Connect with no retry and delay
@@ -102,6 +104,7 @@ func (nsmc *NsmClient) ConnectRetry(ctx context.Context, name, mechanism, descri
 					DstIpRequired: true,
 					SrcRoutes:     routes,
 				},
+				Mtu: nsmc.Configuration.MTU,
 			},
 			Labels: nsmc.ClientLabels,
 		},
@@ -109,6 +112,11 @@ func (nsmc *NsmClient) ConnectRetry(ctx context.Context, name, mechanism, descri
 			outgoingMechanism,
 		},
 	}
+	obo := pathtoken.ChainToken(ctx)
+	if err := nsmc.signRequest(span.Context(), outgoingRequest, obo); err != nil {
+		span.LogError(err)
+		return nil, err
+	}
 	var outgoingConnection *connection.Connection
 	maxRetry := retryCount
 	for retryCount >= 0 {
@@ -141,9 +149,114 @@ func (nsmc *NsmClient) ConnectRetry(ctx context.Context, name, mechanism, descri
 	span.Logger().Infof("Success connection")
 	span.LogObject("connection", outgoingConnection)
 	nsmc.OutgoingConnections = append(nsmc.OutgoingConnections, outgoingConnection)
+	nsmc.startRefresh(outgoingConnection, obo)
 	return outgoingConnection, nil
 }
 
+// Refresh requests connection again to extend a lease it is granted for, nothing is changed by NSM for a connection
+// requested again with the same parameters
+func (nsmc *NsmClient) Refresh(ctx context.Context, conn *connection.Connection) (*connection.Connection, error) {
+	return nsmc.refresh(ctx, conn, pathtoken.ChainToken(ctx))
+}
+
+func (nsmc *NsmClient) refresh(ctx context.Context, conn *connection.Connection, obo string) (*connection.Connection, error) {
+	span := spanhelper.FromContext(ctx, "nsmClient.Refresh")
+	defer span.Finish()
+	span.LogObject("connection", conn)
+
+	request := &networkservice.NetworkServiceRequest{
+		Connection: conn.Clone(),
+		MechanismPreferences: []*connection.Mechanism{
+			conn.GetMechanism(),
+		},
+	}
+	if err := nsmc.signRequest(span.Context(), request, obo); err != nil {
+		span.LogError(err)
+		return nil, err
+	}
+
+	requestCtx, cancel := context.WithTimeout(span.Context(), ConnectTimeout)
+	defer cancel()
+	refreshed, err := nsmc.NsClient.Request(requestCtx, request)
+	if err != nil {
+		err = errors.Wrapf(err, "nsm client: failed to refresh connection %s", conn.GetId())
+		span.LogError(err)
+		return nil, err
+	}
+	if refreshed.GetPath() == nil {
+		refreshed.Path = request.GetConnection().GetPath()
+	}
+	span.LogObject("refreshed", refreshed)
+	return refreshed, nil
+}
+
+// startRefresh starts refreshing of connection until it is closed, should be called under lock
+func (nsmc *NsmClient) startRefresh(conn *connection.Connection, obo string) {
+	if _, ok := conn.GetPath().GetExpireTime(); !ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if nsmc.refreshCancels == nil {
+		nsmc.refreshCancels = map[string]context.CancelFunc{}
+	}
+	nsmc.stopRefresh(conn.GetId())
+	nsmc.refreshCancels[conn.GetId()] = cancel
+
+	conn = conn.Clone()
+	go func() {
+		for {
+			expires, ok := conn.GetPath().GetExpireTime()
+			if !ok {
+				return
+			}
+			// Refresh after 2/3 of a lease, keep retrying until the lease lapses
+			delay := time.Until(expires) * 2 / 3
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(delay):
+				}
+				refreshed, err := nsmc.refresh(ctx, conn, obo)
+				if err == nil {
+					conn = refreshed
+					break
+				}
+				remaining := time.Until(expires)
+				if remaining <= 0 {
+					logrus.Errorf("nsm client: lease of connection %s has lapsed: %v", conn.GetId(), err)
+					return
+				}
+				if delay = remaining / 3; delay > RequestDelay {
+					delay = RequestDelay
+				}
+			}
+		}
+	}()
+}
+
+// stopRefresh stops refreshing of connection, should be called under lock
+func (nsmc *NsmClient) stopRefresh(id string) {
+	if cancel, ok := nsmc.refreshCancels[id]; ok {
+		cancel()
+		delete(nsmc.refreshCancels, id)
+	}
+}
+
+// signRequest sets a lease for path segment of the client and signs it, in case client is a part of an endpoint
+// it signs on behalf of an incoming request
+func (nsmc *NsmClient) signRequest(ctx context.Context, request *networkservice.NetworkServiceRequest, obo string) error {
+	provider := tools.GetConfig().SecurityProvider
+	name := nsmc.Configuration.PodName
+	if name == "" {
+		name = nsmc.Configuration.Workspace
+	}
+	request.Connection.Path = &connection.Path{
+		PathSegments: []*connection.PathSegment{{Name: name}},
+	}
+	return pathtoken.Sign(ctx, provider, request.Connection.Path, obo)
+}
+
 // Close will terminate a particular connection
 func (nsmc *NsmClient) Close(ctx context.Context, outgoingConnection *connection.Connection) error {
 	nsmc.Lock()
@@ -153,6 +266,7 @@ func (nsmc *NsmClient) Close(ctx context.Context, outgoingConnection *connection
 	defer span.Finish()
 	span.LogObject("connection", outgoingConnection)
 
+	nsmc.stopRefresh(outgoingConnection.GetId())
 	_, err := nsmc.NsClient.Close(span.Context(), outgoingConnection)
 
 	span.LogError(err)
@@ -162,7 +276,8 @@ func (nsmc *NsmClient) Close(ctx context.Context, outgoingConnection *connection
 		if c == outgoingConnection {
 			copy(arr[i:], arr[i+1:])
 			arr[len(arr)-1] = nil
-			arr = arr[:len(arr)-1]
+			nsmc.OutgoingConnections = arr[:len(arr)-1]
+			break
 		}
 	}
 	return err
@@ -176,6 +291,10 @@ func (nsmc *NsmClient) Destroy(ctx context.Context) error {
 	span := spanhelper.FromContext(ctx, "Client.Destroy")
 	defer span.Finish()
 
+	for id := range nsmc.refreshCancels {
+		nsmc.stopRefresh(id)
+	}
+
 	err := nsmc.NsmConnection.Close()
 	span.LogError(errors.Wrap(err, "failed to close opentracing context"))
 	if nsmc.tracerCloser != nil {
@@ -197,6 +316,7 @@ func NewNSMClient(ctx context.Context, configuration *common.NSConfiguration) (*
 		ClientNetworkService: configuration.ClientNetworkService,
 		ClientLabels:         tools.ParseKVStringToMap(configuration.ClientLabels, ",", "="),
 		NscInterfaceName:     configuration.NscInterfaceName,
+		refreshCancels:       map[string]context.CancelFunc{},
 	}
 
 	client.tracerCloser = jaeger.InitJaeger("nsm-client")
@@ -16,8 +16,11 @@
 package common
 
 import (
+	"strconv"
 	"strings"
 
+	"github.com/sirupsen/logrus"
+
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 )
 
@@ -32,6 +35,7 @@ const (
 	ipAddressEnv              = "IP_ADDRESS"
 	routesEnv                 = "ROUTES"
 	podNameEnv                = "POD_NAME"
+	mtuEnv                    = "MTU"
 )
 
 // NSConfiguration contains the full configuration used in the SDK
@@ -49,6 +53,8 @@ type NSConfiguration struct {
 	Routes                 []string
 	PodName                string
 	Namespace              string
+	// MTU - MTU requested by client or set by endpoint, 0 means the forwarder default
+	MTU uint32
 }
 
 // FromEnv creates a new NSConfiguration and fills all unset options from the env variables
@@ -110,6 +116,16 @@ func (configuration *NSConfiguration) FromEnv() *NSConfiguration {
 		configuration.Namespace = getEnv(namespaceEnv, "Namespace", false)
 	}
 
+	if configuration.MTU == 0 {
+		if raw := getEnv(mtuEnv, "MTU", false); raw != "" {
+			mtu, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil {
+				logrus.Errorf("Invalid %s %q: %v", mtuEnv, raw, err)
+			}
+			configuration.MTU = uint32(mtu)
+		}
+	}
+
 	if len(configuration.Routes) == 0 {
 		raw := getEnv(routesEnv, "Routes", false)
 		if len(raw) > 1 {
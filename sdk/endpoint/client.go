@@ -17,6 +17,7 @@ package endpoint
 
 import (
 	"context"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/sirupsen/logrus"
@@ -31,9 +32,26 @@ import (
 
 // ClientEndpoint - opens a Client connection to another Network Service
 type ClientEndpoint struct {
+	sync.Mutex
 	mechanismType string
 	ioConnMap     map[string]*connection.Connection
 	configuration *common.NSConfiguration
+	// nsmClient is kept between requests to refresh outgoing connections
+	nsmClient *client.NsmClient
+}
+
+func (cce *ClientEndpoint) getNSMClient() (*client.NsmClient, error) {
+	cce.Lock()
+	defer cce.Unlock()
+
+	if cce.nsmClient == nil {
+		nsmClient, err := client.NewNSMClient(context.Background(), cce.configuration)
+		if err != nil {
+			return nil, err
+		}
+		cce.nsmClient = nsmClient
+	}
+	return cce.nsmClient, nil
 }
 
 // Request implements the request handler
@@ -42,16 +60,11 @@ type ClientEndpoint struct {
 func (cce *ClientEndpoint) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
 	name := request.GetConnection().GetId()
 
-	nsmClient, err := client.NewNSMClient(ctx, cce.configuration)
+	nsmClient, err := cce.getNSMClient()
 	if err != nil {
 		logrus.Fatalf("Unable to create the NSM client %v", err)
 		return nil, err
 	}
-	defer func() {
-		if deleteErr := nsmClient.Destroy(ctx); deleteErr != nil {
-			logrus.Errorf("error destroying nsm client %v", deleteErr)
-		}
-	}()
 
 	outgoingConnection, err := nsmClient.Connect(ctx, name, cce.mechanismType, "Describe "+name)
 	if err != nil {
@@ -82,16 +95,11 @@ func (cce *ClientEndpoint) Request(ctx context.Context, request *networkservice.
 func (cce *ClientEndpoint) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
 	var result error
 
-	nsmClient, err := client.NewNSMClient(ctx, cce.configuration)
+	nsmClient, err := cce.getNSMClient()
 	if err != nil {
 		logrus.Fatalf("Unable to create the NSM client %v", err)
 		return nil, err
 	}
-	defer func() {
-		if err := nsmClient.Destroy(ctx); err != nil {
-			logrus.Errorf("error destroy nsm client %v", err)
-		}
-	}()
 	if outgoingConnection, ok := cce.ioConnMap[connection.GetId()]; ok {
 		if err := nsmClient.Close(ctx, outgoingConnection); err != nil {
 			result = multierror.Append(result, err)
@@ -36,6 +36,7 @@ import (
 // ConnectionEndpoint makes basic Mechanism selection for the incoming connection
 type ConnectionEndpoint struct {
 	mechanismType string
+	mtu           uint32
 	// TODO - id doesn't seem to be used, and should be
 	id *shortid.Shortid
 }
@@ -58,6 +59,13 @@ func (cce *ConnectionEndpoint) Request(ctx context.Context, request *networkserv
 
 	request.GetConnection().Mechanism = mechanism
 
+	// Endpoint limits MTU requested by client
+	if connCtx := request.GetConnection().GetContext(); connCtx != nil && cce.mtu != 0 {
+		if connCtx.GetMtu() == 0 || connCtx.GetMtu() > cce.mtu {
+			connCtx.Mtu = cce.mtu
+		}
+	}
+
 	if Next(ctx) != nil {
 		return Next(ctx).Request(ctx, request)
 	}
@@ -98,6 +106,7 @@ func NewConnectionEndpoint(configuration *common.NSConfiguration) *ConnectionEnd
 
 	self := &ConnectionEndpoint{
 		mechanismType: configuration.MechanismType,
+		mtu:           configuration.MTU,
 		id:            shortid.MustNew(1, shortid.DefaultABC, rand.Uint64()),
 	}
 	if self.mechanismType == "" {
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -29,6 +30,8 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
@@ -36,6 +39,7 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/registry"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 	"github.com/networkservicemesh/networkservicemesh/sdk/common"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
 )
 
 // NsmEndpoint  provides the grpc mechanics for an NsmEndpoint
@@ -51,6 +55,8 @@ type nsmEndpoint struct {
 	registryClient registry.NetworkServiceRegistryClient
 	endpointName   string
 	tracerCloser   io.Closer
+	leases         *leases
+	cancelLeases   context.CancelFunc
 }
 
 func (nsme *nsmEndpoint) setupNSEServerConnection() (net.Listener, error) {
@@ -100,6 +106,10 @@ func (nsme *nsmEndpoint) Start() error {
 	// spawn the listnening thread
 	nsme.serve(listener)
 
+	leaseCtx, cancelLeases := context.WithCancel(context.Background())
+	nsme.cancelLeases = cancelLeases
+	go nsme.closeLapsed(leaseCtx)
+
 	span := spanhelper.FromContext(nsme.Context, fmt.Sprintf("Endpoint-%v-Start", nsme.Configuration.EndpointNetworkService))
 	span.LogObject("labels", nsme.Configuration.EndpointLabels)
 	defer span.Finish()
@@ -146,6 +156,9 @@ func (nsme *nsmEndpoint) Delete() error {
 		span.Logger().Errorf("Failed removing NSE: %v, with %v", removeNSE, err)
 	}
 	nsme.grpcServer.Stop()
+	if nsme.cancelLeases != nil {
+		nsme.cancelLeases()
+	}
 	_ = nsme.tracerCloser.Close()
 
 	return err
@@ -159,11 +172,32 @@ func (nsme *nsmEndpoint) Request(ctx context.Context, request *networkservice.Ne
 	logger := span.Logger()
 	logger.Infof("Request for Network Service received %v", request)
 
+	if provider := tools.GetConfig().SecurityProvider; provider != nil {
+		chain, err := pathtoken.Verify(span.Context(), provider, request.GetConnection().GetPath())
+		if err != nil {
+			err = status.Errorf(codes.PermissionDenied, "request path is not verified: %v", err)
+			span.LogError(err)
+			return nil, err
+		}
+		ctx = pathtoken.WithChain(ctx, pathtoken.Token(request.GetConnection().GetPath()), chain)
+		logger.Infof("Request is verified for client %s", pathtoken.ClientIdentity(ctx))
+	}
+
+	if refreshed := nsme.leases.refresh(request.GetConnection()); refreshed != nil {
+		logger.Infof("Lease of connection %s is refreshed", refreshed.GetId())
+		span.LogObject("response", refreshed)
+		return refreshed, nil
+	}
+
 	incomingConnection, err := nsme.service.Request(ctx, request)
 	if err != nil {
 		logger.Errorf("The composite returned an error: %v", err)
 		return nil, err
 	}
+	if incomingConnection.GetPath() == nil {
+		incomingConnection.Path = request.GetConnection().GetPath()
+	}
+	nsme.leases.store(incomingConnection)
 
 	logger.Infof("Responding to NetworkService.Request(%v): %v", request, incomingConnection)
 	span.LogObject("response", incomingConnection)
@@ -174,12 +208,30 @@ func (nsme *nsmEndpoint) Close(ctx context.Context, incomingConnection *connecti
 	span := spanhelper.FromContext(ctx, "Endpoint.Close")
 	defer span.Finish()
 	span.LogObject("connection", incomingConnection)
+	nsme.leases.remove(incomingConnection.GetId())
 	_, _ = nsme.service.Close(ctx, incomingConnection)
 	_, _ = nsme.NsClient.Close(ctx, incomingConnection)
 
 	return &empty.Empty{}, nil
 }
 
+// closeLapsed closes connections NSM has not refreshed a lease for
+func (nsme *nsmEndpoint) closeLapsed(ctx context.Context) {
+	ticker := time.NewTicker(leaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, conn := range nsme.leases.lapsed(now) {
+				logrus.Infof("nse: lease of connection %s has lapsed, closing", conn.GetId())
+				_, _ = nsme.Close(ctx, conn)
+			}
+		}
+	}
+}
+
 // NewNSMEndpoint creates a new NSM endpoint
 func NewNSMEndpoint(ctx context.Context, configuration *common.NSConfiguration, service networkservice.NetworkServiceServer) (NsmEndpoint, error) {
 	if configuration == nil {
@@ -199,6 +251,7 @@ func NewNSMEndpoint(ctx context.Context, configuration *common.NSConfiguration,
 	endpoint := &nsmEndpoint{
 		NsmConnection: nsmConnection,
 		service:       service,
+		leases:        newLeases(),
 	}
 
 	return endpoint, nil
@@ -18,6 +18,8 @@ package endpoint
 import (
 	"context"
 	"math/rand"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -32,60 +34,113 @@ import (
 // IpamEndpoint - provides Ipam functionality
 type IpamEndpoint struct {
 	PrefixPool prefix_pool.PrefixPool
+	// PrefixPools - pools of different IP families for dual-stack connections, PrefixPool is used if empty.
+	// Addresses from the first pool are set to src_ip_addr/dst_ip_addr, from the others to src_ip_addrs/dst_ip_addrs.
+	PrefixPools []prefix_pool.PrefixPool
 }
 
 // Request implements the request handler
 // Consumes from ctx context.Context:
 //	   Next
 func (ice *IpamEndpoint) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	pools := ice.pools()
+	ipContext := request.GetConnection().GetContext().GetIpContext()
+	srcIPs, dstIPs, extraPrefixes := []string{}, []string{}, []string{}
+	for i, pool := range pools {
+		srcIP, dstIP, prefixes, err := ice.extract(request.GetConnection(), pool, i == 0)
+		if err != nil {
+			for _, extracted := range pools[:i] {
+				_ = extracted.Release(request.GetConnection().GetId())
+			}
+			return nil, err
+		}
+		srcIPs = append(srcIPs, srcIP.String())
+		dstIPs = append(dstIPs, dstIP.String())
+		extraPrefixes = append(extraPrefixes, prefixes...)
+	}
+
+	// Update source/dst IP's
+	ipContext.SrcIpAddr, ipContext.SrcIpAddrs = srcIPs[0], srcIPs[1:]
+	ipContext.DstIpAddr, ipContext.DstIpAddrs = dstIPs[0], dstIPs[1:]
+
+	ipContext.ExtraPrefixes = extraPrefixes
+	if Next(ctx) != nil {
+		return Next(ctx).Request(ctx, request)
+	}
+	return request.GetConnection(), nil
+}
+
+// extract allocates addresses and extra prefixes of the pool family for the connection, extra prefix requests of
+// families without a pool are served by the first pool
+func (ice *IpamEndpoint) extract(conn *connection.Connection, pool prefix_pool.PrefixPool, first bool) (srcIP, dstIP *net.IPNet, prefixes []string, err error) {
 	/* Exclude the prefixes from the pool of available prefixes */
-	excludedPrefixes, err := ice.PrefixPool.ExcludePrefixes(request.Connection.GetContext().GetIpContext().GetExcludedPrefixes())
+	excludedPrefixes, err := pool.ExcludePrefixes(conn.GetContext().GetIpContext().GetExcludedPrefixes())
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	/* Determine whether the pool is IPv4 or IPv6 */
-	currentIPFamily := connectioncontext.IpFamily_IPV4
-	if common.IsIPv6(ice.PrefixPool.GetPrefixes()[0]) {
-		currentIPFamily = connectioncontext.IpFamily_IPV6
+	currentIPFamily := poolFamily(pool)
+
+	requests := []*connectioncontext.ExtraPrefixRequest{}
+	for _, request := range conn.GetContext().GetIpContext().GetExtraPrefixRequest() {
+		family := request.GetAddrFamily().GetFamily()
+		if family == currentIPFamily || (first && !ice.hasFamily(family)) {
+			requests = append(requests, request)
+		}
 	}
 
-	srcIP, dstIP, prefixes, err := ice.PrefixPool.Extract(request.Connection.Id, currentIPFamily, request.Connection.GetContext().GetIpContext().GetExtraPrefixRequest()...)
+	srcIP, dstIP, prefixes, err = pool.Extract(conn.GetId(), currentIPFamily, requests...)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	/* Release the actual prefixes that were excluded during IPAM */
 	// TODO - this will vary per Request... so releasing Prefixes globally that are excluded locally
 	// is incorrect behavior.  It should simply *not* draw on those prefixes for this connection.
-	err = ice.PrefixPool.ReleaseExcludedPrefixes(excludedPrefixes)
-	if err != nil {
-		return nil, err
+	if err = pool.ReleaseExcludedPrefixes(excludedPrefixes); err != nil {
+		return nil, nil, nil, err
 	}
+	return srcIP, dstIP, prefixes, nil
+}
 
-	// Update source/dst IP's
-	request.GetConnection().GetContext().GetIpContext().SrcIpAddr = srcIP.String()
-	request.GetConnection().GetContext().GetIpContext().DstIpAddr = dstIP.String()
+func (ice *IpamEndpoint) pools() []prefix_pool.PrefixPool {
+	if len(ice.PrefixPools) == 0 {
+		return []prefix_pool.PrefixPool{ice.PrefixPool}
+	}
+	return ice.PrefixPools
+}
 
-	request.GetConnection().GetContext().GetIpContext().ExtraPrefixes = prefixes
-	if Next(ctx) != nil {
-		return Next(ctx).Request(ctx, request)
+func (ice *IpamEndpoint) hasFamily(family connectioncontext.IpFamily_Family) bool {
+	for _, pool := range ice.pools() {
+		if poolFamily(pool) == family {
+			return true
+		}
 	}
-	return request.GetConnection(), nil
+	return false
+}
+
+func poolFamily(pool prefix_pool.PrefixPool) connectioncontext.IpFamily_Family {
+	if prefixes := pool.GetPrefixes(); len(prefixes) > 0 && common.IsIPv6(prefixes[0]) {
+		return connectioncontext.IpFamily_IPV6
+	}
+	return connectioncontext.IpFamily_IPV4
 }
 
 // Close implements the close handler
 // Consumes from ctx context.Context:
 //	   Next
 func (ice *IpamEndpoint) Close(ctx context.Context, connection *connection.Connection) (*empty.Empty, error) {
-	prefix, requests, err := ice.PrefixPool.GetConnectionInformation(connection.GetId())
-	Log(ctx).Infof("Release connection prefixes network: %s extra requests: %v", prefix, requests)
-	if err != nil {
-		Log(ctx).Errorf("Error: %v", err)
-	}
-	err = ice.PrefixPool.Release(connection.GetId())
-	if err != nil {
-		Log(ctx).Error("Release error: ", err)
+	for _, pool := range ice.pools() {
+		prefix, requests, err := pool.GetConnectionInformation(connection.GetId())
+		Log(ctx).Infof("Release connection prefixes network: %s extra requests: %v", prefix, requests)
+		if err != nil {
+			Log(ctx).Errorf("Error: %v", err)
+		}
+		err = pool.Release(connection.GetId())
+		if err != nil {
+			Log(ctx).Error("Release error: ", err)
+		}
 	}
 	if Next(ctx) != nil {
 		return Next(ctx).Close(ctx, connection)
@@ -105,15 +160,32 @@ func NewIpamEndpoint(configuration *common.NSConfiguration) *IpamEndpoint {
 		configuration = &common.NSConfiguration{}
 	}
 
-	pool, err := prefix_pool.NewPrefixPool(configuration.IPAddress)
-	if err != nil {
-		panic(err.Error())
+	/* IP_ADDRESS may list prefixes of both IP families separated by comma, a pool is created per family */
+	prefixes := map[bool][]string{}
+	families := []bool{}
+	for _, prefix := range strings.Split(configuration.IPAddress, ",") {
+		prefix = strings.TrimSpace(prefix)
+		ipv6 := common.IsIPv6(prefix)
+		if _, ok := prefixes[ipv6]; !ok {
+			families = append(families, ipv6)
+		}
+		prefixes[ipv6] = append(prefixes[ipv6], prefix)
+	}
+
+	pools := []prefix_pool.PrefixPool{}
+	for _, ipv6 := range families {
+		pool, err := prefix_pool.NewPrefixPool(prefixes[ipv6]...)
+		if err != nil {
+			panic(err.Error())
+		}
+		pools = append(pools, pool)
 	}
 
 	rand.Seed(time.Now().UTC().UnixNano())
 
 	self := &IpamEndpoint{
-		PrefixPool: pool,
+		PrefixPool:  pools[0],
+		PrefixPools: pools,
 	}
 
 	return self
@@ -0,0 +1,62 @@
+package endpoint
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+	"github.com/networkservicemesh/networkservicemesh/sdk/common"
+)
+
+func newIpamTestRequest(requests ...*connectioncontext.ExtraPrefixRequest) *networkservice.NetworkServiceRequest {
+	return &networkservice.NetworkServiceRequest{
+		Connection: &connection.Connection{
+			Id: "1",
+			Context: &connectioncontext.ConnectionContext{
+				IpContext: &connectioncontext.IPContext{
+					ExtraPrefixRequest: requests,
+				},
+			},
+		},
+	}
+}
+
+func TestIpamSingleStack(t *testing.T) {
+	g := NewWithT(t)
+
+	ipam := NewIpamEndpoint(&common.NSConfiguration{IPAddress: "10.20.1.0/24"})
+	conn, err := ipam.Request(context.Background(), newIpamTestRequest())
+	g.Expect(err).To(BeNil())
+	g.Expect(conn.GetContext().GetIpContext().GetSrcIpAddr()).To(Equal("10.20.1.1/30"))
+	g.Expect(conn.GetContext().GetIpContext().GetDstIpAddr()).To(Equal("10.20.1.2/30"))
+	g.Expect(conn.GetContext().GetIpContext().GetSrcIpAddrs()).To(BeEmpty())
+}
+
+func TestIpamDualStack(t *testing.T) {
+	g := NewWithT(t)
+
+	ipam := NewIpamEndpoint(&common.NSConfiguration{IPAddress: "10.20.1.0/24, fd00::/120"})
+	g.Expect(len(ipam.PrefixPools)).To(Equal(2))
+
+	conn, err := ipam.Request(context.Background(), newIpamTestRequest(&connectioncontext.ExtraPrefixRequest{
+		AddrFamily:      &connectioncontext.IpFamily{Family: connectioncontext.IpFamily_IPV6},
+		PrefixLen:       124,
+		RequiredNumber:  1,
+		RequestedNumber: 1,
+	}))
+	g.Expect(err).To(BeNil())
+
+	ipContext := conn.GetContext().GetIpContext()
+	g.Expect(ipContext.SrcIPAddresses()).To(Equal([]string{"10.20.1.1/30", "fd00::1/126"}))
+	g.Expect(ipContext.DstIPAddresses()).To(Equal([]string{"10.20.1.2/30", "fd00::2/126"}))
+	g.Expect(ipContext.GetExtraPrefixes()).To(Equal([]string{"fd00::10/124"}))
+
+	_, err = ipam.Close(context.Background(), conn)
+	g.Expect(err).To(BeNil())
+	_, _, err = ipam.PrefixPools[1].GetConnectionInformation("1")
+	g.Expect(err).NotTo(BeNil())
+}
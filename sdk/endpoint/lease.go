@@ -0,0 +1,82 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoint
+
+import (
+	"sync"
+	"time"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+)
+
+const leaseCheckInterval = 500 * time.Millisecond
+
+// leases - keeps connections established by an endpoint with leases they are granted for
+type leases struct {
+	sync.Mutex
+	connections map[string]*connection.Connection
+}
+
+func newLeases() *leases {
+	return &leases{
+		connections: map[string]*connection.Connection{},
+	}
+}
+
+// refresh - extends a lease of established connection if it is requested again with nothing changed,
+// returns established connection with a path of the request, nil if request is not a refresh
+func (l *leases) refresh(requested *connection.Connection) *connection.Connection {
+	l.Lock()
+	defer l.Unlock()
+
+	established, ok := l.connections[requested.GetId()]
+	if !ok || !requested.IsRefreshOf(established) {
+		return nil
+	}
+	established.Path = requested.GetPath().Clone()
+	return established.Clone()
+}
+
+// store - remembers established connection and a lease it is granted for
+func (l *leases) store(established *connection.Connection) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.connections[established.GetId()] = established.Clone()
+}
+
+// remove - forgets closed connection
+func (l *leases) remove(id string) {
+	l.Lock()
+	defer l.Unlock()
+
+	delete(l.connections, id)
+}
+
+// lapsed - returns connections with lapsed leases, connections without a lease never lapse
+func (l *leases) lapsed(now time.Time) []*connection.Connection {
+	l.Lock()
+	defer l.Unlock()
+
+	var result []*connection.Connection
+	for _, conn := range l.connections {
+		if expires, ok := conn.GetPath().GetExpireTime(); ok && expires.Before(now) {
+			result = append(result, conn.Clone())
+		}
+	}
+	return result
+}
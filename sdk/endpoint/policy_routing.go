@@ -0,0 +1,80 @@
+package endpoint
+
+import (
+	"context"
+	"net"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
+)
+
+// PolicyRoutingEndpoint -
+//   Installs the client routes in a separate routing table, looked up only by the traffic from
+//   the client addresses of the connection and by the traffic marked with fwmark if it is set
+type PolicyRoutingEndpoint struct {
+	table  uint32
+	fwmark uint32
+}
+
+// Request handler
+//  Consumes from ctx context.Context:
+//    Next
+func (p *PolicyRoutingEndpoint) Request(ctx context.Context, request *networkservice.NetworkServiceRequest) (*connection.Connection, error) {
+	conn := request.GetConnection()
+	if Next(ctx) != nil {
+		var err error
+		conn, err = Next(ctx).Request(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+	policy := &connectioncontext.PolicyRoutingContext{
+		Table: p.table,
+	}
+	for _, addr := range conn.GetContext().GetIpContext().SrcIPAddresses() {
+		ip, _, err := net.ParseCIDR(addr)
+		if err != nil {
+			return nil, err
+		}
+		bits := net.IPv6len * 8
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), net.IPv4len*8
+		}
+		policy.Rules = append(policy.Rules, &connectioncontext.PolicyRule{
+			From: (&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}).String(),
+		})
+	}
+	if p.fwmark != 0 {
+		policy.Rules = append(policy.Rules, &connectioncontext.PolicyRule{
+			Fwmark: p.fwmark,
+		})
+	}
+	conn.GetContext().PolicyRoutingContext = policy
+	return conn, nil
+}
+
+// Close handler
+//   Consumes from ctx context.Context:
+//     Next
+func (p *PolicyRoutingEndpoint) Close(ctx context.Context, conn *connection.Connection) (*empty.Empty, error) {
+	if Next(ctx) != nil {
+		return Next(ctx).Close(ctx, conn)
+	}
+	return &empty.Empty{}, nil
+}
+
+// Name returns the composite name
+func (p *PolicyRoutingEndpoint) Name() string {
+	return "policy-routing"
+}
+
+// NewPolicyRoutingEndpoint creates New PolicyRoutingEndpoint, fwmark 0 adds no fwmark rule
+func NewPolicyRoutingEndpoint(table, fwmark uint32) *PolicyRoutingEndpoint {
+	return &PolicyRoutingEndpoint{
+		table:  table,
+		fwmark: fwmark,
+	}
+}
@@ -0,0 +1,32 @@
+package endpoint
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connectioncontext"
+	"github.com/networkservicemesh/networkservicemesh/sdk/common"
+)
+
+func TestPolicyRoutingEndpoint(t *testing.T) {
+	g := NewWithT(t)
+
+	ipam := NewIpamEndpoint(&common.NSConfiguration{IPAddress: "10.20.1.0/24,fd00::/120"})
+	request := newIpamTestRequest()
+	_, err := ipam.Request(context.Background(), request)
+	g.Expect(err).To(BeNil())
+
+	conn, err := NewPolicyRoutingEndpoint(100, 7).Request(context.Background(), request)
+	g.Expect(err).To(BeNil())
+
+	policy := conn.GetContext().GetPolicyRoutingContext()
+	g.Expect(policy.GetTable()).To(Equal(uint32(100)))
+	g.Expect(policy.GetRules()).To(Equal([]*connectioncontext.PolicyRule{
+		{From: "10.20.1.1/32"},
+		{From: "fd00::1/128"},
+		{Fwmark: 7},
+	}))
+	g.Expect(conn.GetContext().IsValid()).To(BeNil())
+}
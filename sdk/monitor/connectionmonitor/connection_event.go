@@ -43,6 +43,7 @@ func (e *event) Message() (interface{}, error) {
 	return &connection.ConnectionEvent{
 		Type:        eventType,
 		Connections: connections,
+		Revision:    e.Revision(),
 	}, nil
 }
 
@@ -72,9 +73,11 @@ func (m *eventFactory) EventFromMessage(ctx context.Context, message interface{}
 
 	entities := entitiesFromConnections(connectionEvent.Connections)
 
-	return &event{
+	rv := &event{
 		BaseEvent: monitor.NewBaseEvent(ctx, eventType, entities),
-	}, nil
+	}
+	rv.SetRevision(connectionEvent.GetRevision())
+	return rv, nil
 }
 
 func eventTypeToConnectionEventType(eventType monitor.EventType) (connection.ConnectionEventType, error) {
@@ -22,32 +22,65 @@ type monitorConnectionFilter struct {
 	connection.MonitorConnection_MonitorConnectionsServer
 
 	selector *connection.MonitorScopeSelector
+	// sent - ids of connections sent to the recipient and not deleted yet
+	sent map[string]bool
 }
 
 // NewMonitorConnectionFilter - create a connection montior server filter
 func NewMonitorConnectionFilter(selector *connection.MonitorScopeSelector, monitor connection.MonitorConnection_MonitorConnectionsServer) connection.MonitorConnection_MonitorConnectionsServer {
 	return &monitorConnectionFilter{
 		selector: selector,
+		sent:     map[string]bool{},
 		MonitorConnection_MonitorConnectionsServer: monitor,
 	}
 }
 
-// Send filters event connections and pass it to the next sending layer
 func (d *monitorConnectionFilter) Send(in *connection.ConnectionEvent) error {
+	return d.SendMsg(in)
+}
+
+// SendMsg - sends selected connections of an event, connections updated to not match the selector anymore are sent
+// as deleted
+func (d *monitorConnectionFilter) SendMsg(msg interface{}) error {
+	in, ok := msg.(*connection.ConnectionEvent)
+	if !ok {
+		return d.MonitorConnection_MonitorConnectionsServer.SendMsg(msg)
+	}
+	if in.GetType() == connection.ConnectionEventType_INITIAL_STATE_TRANSFER {
+		d.sent = map[string]bool{}
+	}
 	out := &connection.ConnectionEvent{
-		Type:        in.Type,
+		Type:        in.GetType(),
+		Connections: make(map[string]*connection.Connection),
+		Revision:    in.GetRevision(),
+	}
+	deleted := &connection.ConnectionEvent{
+		Type:        connection.ConnectionEventType_DELETE,
 		Connections: make(map[string]*connection.Connection),
+		Revision:    in.GetRevision(),
 	}
 	for key, value := range in.GetConnections() {
-		if len(d.selector.GetPathSegments()) > 0 && value.GetSourceNetworkServiceManagerName() == d.selector.GetPathSegments()[0].GetName() {
-			out.Connections[key] = value
-		}
-		if len(d.selector.GetPathSegments()) > 1 && value.GetDestinationNetworkServiceManagerName() == d.selector.GetPathSegments()[1].GetName() {
+		switch {
+		case in.GetType() == connection.ConnectionEventType_DELETE:
+			if d.sent[key] || d.selector.MatchesConnection(value) {
+				out.Connections[key] = value
+			}
+			delete(d.sent, key)
+		case d.selector.MatchesConnection(value):
 			out.Connections[key] = value
+			d.sent[key] = true
+		case d.sent[key]:
+			deleted.Connections[key] = value
+			delete(d.sent, key)
 		}
 	}
 	if len(out.Connections) > 0 || out.Type == connection.ConnectionEventType_INITIAL_STATE_TRANSFER {
-		return d.MonitorConnection_MonitorConnectionsServer.Send(out)
+		if err := d.MonitorConnection_MonitorConnectionsServer.SendMsg(out); err != nil {
+			return err
+		}
+	}
+	if len(deleted.Connections) > 0 {
+		return d.MonitorConnection_MonitorConnectionsServer.SendMsg(deleted)
 	}
 	return nil
 }
@@ -49,10 +49,12 @@ func NewMonitorServer(factoryName string) MonitorServer {
 
 // MonitorConnections adds recipient for MonitorServer events
 func (s *monitorServer) MonitorConnections(in *connection.MonitorScopeSelector, recipient connection.MonitorConnection_MonitorConnectionsServer) error {
-	if in != nil {
+	revision := in.GetResumeFromRevision()
+	if !in.IsEmpty() {
 		logrus.Infof("%sMonitor using filter %v", s.factoryName, in)
 		recipient = NewMonitorConnectionFilter(in, recipient)
+		// A new filter doesn't know which entities were sent before reconnect, so it starts from a full state
+		revision = 0
 	}
-	s.MonitorEntities(recipient)
-	return nil
+	return s.ResumeMonitorEntities(recipient, revision)
 }
@@ -34,6 +34,7 @@ func (e *Event) Message() (interface{}, error) {
 		Type:          eventType,
 		CrossConnects: xcons,
 		Metrics:       e.Statistics,
+		Revision:      e.Revision(),
 	}, nil
 }
 
@@ -69,10 +70,12 @@ func (m *eventFactory) EventFromMessage(ctx context.Context, message interface{}
 
 	entities := entitiesFromXcons(xconEvent.CrossConnects)
 
-	return &Event{
+	rv := &Event{
 		BaseEvent:  monitor.NewBaseEvent(ctx, eventType, entities),
 		Statistics: xconEvent.Metrics,
-	}, nil
+	}
+	rv.SetRevision(xconEvent.GetRevision())
+	return rv, nil
 }
 
 func eventTypeToXconEventType(eventType monitor.EventType) (crossconnect.CrossConnectEventType, error) {
@@ -0,0 +1,111 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crossconnect
+
+import (
+	"strings"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
+)
+
+// Metrics names are prefixed with a side of a cross connect they are collected for or with datapath probe prefix
+var metricsSidePrefixes = []string{"SRC-", "DST-", "PROBE-"}
+
+type monitorCrossConnectFilter struct {
+	crossconnect.MonitorCrossConnect_MonitorCrossConnectsServer
+
+	selector *connection.MonitorScopeSelector
+	// sent - ids of cross connects sent to the recipient and not deleted yet
+	sent map[string]bool
+}
+
+// NewMonitorCrossConnectFilter - creates a cross connect monitor server filter
+func NewMonitorCrossConnectFilter(selector *connection.MonitorScopeSelector, monitor crossconnect.MonitorCrossConnect_MonitorCrossConnectsServer) crossconnect.MonitorCrossConnect_MonitorCrossConnectsServer {
+	return &monitorCrossConnectFilter{
+		selector: selector,
+		sent:     map[string]bool{},
+		MonitorCrossConnect_MonitorCrossConnectsServer: monitor,
+	}
+}
+
+func (d *monitorCrossConnectFilter) Send(in *crossconnect.CrossConnectEvent) error {
+	return d.SendMsg(in)
+}
+
+// SendMsg - sends selected cross connects of an event with their metrics, cross connects updated to not match the
+// selector anymore are sent as deleted
+func (d *monitorCrossConnectFilter) SendMsg(msg interface{}) error {
+	in, ok := msg.(*crossconnect.CrossConnectEvent)
+	if !ok {
+		return d.MonitorCrossConnect_MonitorCrossConnectsServer.SendMsg(msg)
+	}
+	if in.GetType() == crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER {
+		d.sent = map[string]bool{}
+	}
+	out := &crossconnect.CrossConnectEvent{
+		Type:          in.GetType(),
+		CrossConnects: make(map[string]*crossconnect.CrossConnect),
+		Revision:      in.GetRevision(),
+	}
+	deleted := &crossconnect.CrossConnectEvent{
+		Type:          crossconnect.CrossConnectEventType_DELETE,
+		CrossConnects: make(map[string]*crossconnect.CrossConnect),
+		Revision:      in.GetRevision(),
+	}
+	for key, value := range in.GetCrossConnects() {
+		switch {
+		case in.GetType() == crossconnect.CrossConnectEventType_DELETE:
+			if d.sent[key] || value.MatchesMonitorScopeSelector(d.selector) {
+				out.CrossConnects[key] = value
+			}
+			delete(d.sent, key)
+		case value.MatchesMonitorScopeSelector(d.selector):
+			out.CrossConnects[key] = value
+			d.sent[key] = true
+		case d.sent[key]:
+			deleted.CrossConnects[key] = value
+			delete(d.sent, key)
+		}
+	}
+	for name, metrics := range in.GetMetrics() {
+		if _, ok := out.CrossConnects[metricsCrossConnectID(name)]; ok {
+			if out.Metrics == nil {
+				out.Metrics = make(map[string]*crossconnect.Metrics)
+			}
+			out.Metrics[name] = metrics
+		}
+	}
+	if len(out.CrossConnects) > 0 || out.Type == crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER {
+		if err := d.MonitorCrossConnect_MonitorCrossConnectsServer.SendMsg(out); err != nil {
+			return err
+		}
+	}
+	if len(deleted.CrossConnects) > 0 {
+		return d.MonitorCrossConnect_MonitorCrossConnectsServer.SendMsg(deleted)
+	}
+	return nil
+}
+
+func metricsCrossConnectID(name string) string {
+	for _, prefix := range metricsSidePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return strings.TrimPrefix(name, prefix)
+		}
+	}
+	return name
+}
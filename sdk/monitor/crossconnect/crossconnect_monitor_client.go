@@ -3,9 +3,9 @@ package crossconnect
 import (
 	"context"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/sdk/monitor"
 )
@@ -18,14 +18,24 @@ func (s *eventStream) Recv() (interface{}, error) {
 	return s.MonitorCrossConnect_MonitorCrossConnectsClient.Recv()
 }
 
-func newEventStream(ctx context.Context, cc *grpc.ClientConn) (monitor.EventStream, error) {
-	stream, err := crossconnect.NewMonitorCrossConnectClient(cc).MonitorCrossConnects(ctx, &empty.Empty{})
-	return &eventStream{
-		MonitorCrossConnect_MonitorCrossConnectsClient: stream,
-	}, err
+func newEventStream(revision uint64) monitor.EventStreamConstructor {
+	return func(ctx context.Context, cc *grpc.ClientConn) (monitor.EventStream, error) {
+		stream, err := crossconnect.NewMonitorCrossConnectClient(cc).MonitorCrossConnects(ctx, &connection.MonitorScopeSelector{
+			ResumeFromRevision: revision,
+		})
+		return &eventStream{
+			MonitorCrossConnect_MonitorCrossConnectsClient: stream,
+		}, err
+	}
 }
 
 // NewMonitorClient creates a new monitor.Client for crossconnect GRPC API
 func NewMonitorClient(cc *grpc.ClientConn) (monitor.Client, error) {
-	return monitor.NewClient(cc, &eventFactory{}, newEventStream)
+	return ResumeMonitorClient(cc, 0)
+}
+
+// ResumeMonitorClient creates a new monitor.Client for crossconnect GRPC API resuming from the given revision,
+// zero revision requests a full state transfer
+func ResumeMonitorClient(cc *grpc.ClientConn, revision uint64) (monitor.Client, error) {
+	return monitor.NewClient(cc, &eventFactory{}, newEventStream(revision))
 }
@@ -3,8 +3,9 @@ package crossconnect
 import (
 	"context"
 
-	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/sirupsen/logrus"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/sdk/monitor"
 	"github.com/networkservicemesh/networkservicemesh/sdk/monitor/metrics"
@@ -34,10 +35,11 @@ func NewMonitorServer() MonitorServer {
 }
 
 func (s *monitorServer) serveMetrics() {
-	for {
+	for statistics := range s.statsCh {
+		// Entities are taken once statistics are received, so filters see cross connects metrics are sent for
 		s.SendAll(&Event{
 			BaseEvent:  monitor.NewBaseEvent(context.Background(), monitor.EventTypeUpdate, s.Entities()),
-			Statistics: <-s.statsCh,
+			Statistics: statistics,
 		})
 	}
 }
@@ -48,7 +50,13 @@ func (s *monitorServer) HandleMetrics(statistics map[string]*crossconnect.Metric
 }
 
 // MonitorCrossConnects adds recipient for MonitorServer events
-func (s *monitorServer) MonitorCrossConnects(_ *empty.Empty, recipient crossconnect.MonitorCrossConnect_MonitorCrossConnectsServer) error {
-	s.MonitorEntities(recipient)
-	return nil
+func (s *monitorServer) MonitorCrossConnects(selector *connection.MonitorScopeSelector, recipient crossconnect.MonitorCrossConnect_MonitorCrossConnectsServer) error {
+	revision := selector.GetResumeFromRevision()
+	if !selector.IsEmpty() {
+		logrus.Infof("CrossConnectMonitor using filter %v", selector)
+		recipient = NewMonitorCrossConnectFilter(selector, recipient)
+		// A new filter doesn't know which entities were sent before reconnect, so it starts from a full state
+		revision = 0
+	}
+	return s.ResumeMonitorEntities(recipient, revision)
 }
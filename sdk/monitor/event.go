@@ -26,6 +26,10 @@ type Event interface {
 
 	Message() (interface{}, error)
 
+	// Revision of the server state after the event, 0 if the event can't be resumed from
+	Revision() uint64
+	SetRevision(revision uint64)
+
 	// A caller context to use with opentracing.
 	Context() context.Context
 }
@@ -41,6 +45,7 @@ type EventFactory interface {
 type BaseEvent struct {
 	eventType EventType
 	entities  map[string]Entity
+	revision  uint64
 	ctx       context.Context
 }
 
@@ -67,3 +72,13 @@ func (e BaseEvent) Entities() map[string]Entity {
 func (e BaseEvent) Context() context.Context {
 	return e.ctx
 }
+
+// Revision returns BaseEvent revision
+func (e BaseEvent) Revision() uint64 {
+	return e.revision
+}
+
+// SetRevision sets BaseEvent revision
+func (e *BaseEvent) SetRevision(revision uint64) {
+	e.revision = revision
+}
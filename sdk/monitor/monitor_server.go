@@ -3,15 +3,21 @@ package monitor
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools/spanhelper"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	defaultSize = 10
+	// historySize - amount of last events kept to resume recipients from
+	historySize = 1000
 )
 
 // Recipient is an unified interface for receiving stream
@@ -26,19 +32,32 @@ type Server interface {
 
 	AddRecipient(recipient Recipient)
 	DeleteRecipient(recipient Recipient)
-	MonitorEntities(stream grpc.ServerStream)
+	MonitorEntities(stream grpc.ServerStream) error
+	ResumeMonitorEntities(stream grpc.ServerStream, revision uint64) error
 	SendAll(event Event)
 	Serve()
 	Entities() map[string]Entity
 }
 
+type newRecipient struct {
+	queue *recipientQueue
+	// revision - recipient is resumed from, 0 for a full state transfer
+	revision uint64
+}
+
 type server struct {
 	eventFactory             EventFactory
 	eventCh                  chan Event
-	newMonitorRecipientCh    chan Recipient
+	newMonitorRecipientCh    chan newRecipient
 	closedMonitorRecipientCh chan Recipient
 	entities                 map[string]Entity
-	recipients               []Recipient
+	entitiesLock             sync.RWMutex
+	recipients               []*recipientQueue
+	recipientsLock           sync.RWMutex
+	// revision - revision of the last event, it starts from a server start time, so revisions of a restarted server
+	// are never mistaken for ones of a previous server
+	revision uint64
+	history  []Event
 }
 
 // NewServer creates a new Server with given EventFactory
@@ -46,10 +65,11 @@ func NewServer(eventFactory EventFactory) Server {
 	return &server{
 		eventFactory:             eventFactory,
 		eventCh:                  make(chan Event, defaultSize),
-		newMonitorRecipientCh:    make(chan Recipient, defaultSize),
+		newMonitorRecipientCh:    make(chan newRecipient, defaultSize),
 		closedMonitorRecipientCh: make(chan Recipient, defaultSize),
 		entities:                 make(map[string]Entity),
-		recipients:               make([]Recipient, 0, defaultSize),
+		recipients:               make([]*recipientQueue, 0, defaultSize),
+		revision:                 uint64(time.Now().UnixNano()),
 	}
 }
 
@@ -63,10 +83,10 @@ func (s *server) Delete(ctx context.Context, entity Entity) {
 	s.eventCh <- s.eventFactory.NewEvent(ctx, EventTypeDelete, map[string]Entity{entity.GetId(): entity})
 }
 
-// AddRecipient adds server recipient
+// AddRecipient adds in-process server recipient, it is never dropped for falling behind as it could not resync
 func (s *server) AddRecipient(recipient Recipient) {
 	logrus.Infof("MonitorServerImpl.AddRecipient: %v-%v", s.eventFactory.FactoryName(), recipient)
-	s.newMonitorRecipientCh <- recipient
+	s.newMonitorRecipientCh <- newRecipient{queue: newRecipientQueue(s.eventFactory.FactoryName(), recipient, false)}
 }
 
 // DeleteRecipient deletes server recipient
@@ -76,17 +96,34 @@ func (s *server) DeleteRecipient(recipient Recipient) {
 }
 
 // MonitorEntities adds stream as server recipient and blocks until it get closed
-func (s *server) MonitorEntities(stream grpc.ServerStream) {
-	s.AddRecipient(stream)
+func (s *server) MonitorEntities(stream grpc.ServerStream) error {
+	return s.ResumeMonitorEntities(stream, 0)
+}
+
+// ResumeMonitorEntities adds stream as server recipient sending it events after revision instead of the full
+// state if they are still kept, and blocks until it get closed or falls too far behind
+func (s *server) ResumeMonitorEntities(stream grpc.ServerStream, revision uint64) error {
+	logrus.Infof("MonitorServerImpl.ResumeMonitorEntities: %v-%v from revision %v", s.eventFactory.FactoryName(), stream, revision)
+	queue := newRecipientQueue(s.eventFactory.FactoryName(), stream, true)
+	s.newMonitorRecipientCh <- newRecipient{queue: queue, revision: revision}
 	defer s.DeleteRecipient(stream)
 
 	// We need to wait until it will be done and do not exit
-	<-stream.Context().Done()
+	select {
+	case <-stream.Context().Done():
+		return nil
+	case <-queue.dropped:
+		return status.Errorf(codes.ResourceExhausted, "%v monitor recipient has fallen too far behind, resync is required", s.eventFactory.FactoryName())
+	}
 }
 
 // SendAll sends event to all server recipients
 func (s *server) SendAll(event Event) {
-	s.send(event, s.recipients...)
+	s.recipientsLock.RLock()
+	recipients := append([]*recipientQueue(nil), s.recipients...)
+	s.recipientsLock.RUnlock()
+
+	s.send(event, recipients...)
 }
 
 // Serve starts a main loop for server
@@ -95,18 +132,22 @@ func (s *server) Serve() {
 	for {
 		select {
 		case newRecipient := <-s.newMonitorRecipientCh:
-			initialStateTransferEvent := s.eventFactory.NewEvent(context.Background(), EventTypeInitialStateTransfer, s.entities)
-			s.send(initialStateTransferEvent, newRecipient)
-			s.recipients = append(s.recipients, newRecipient)
-		case closedRecipient := <-s.closedMonitorRecipientCh:
-			for j, r := range s.recipients {
-				if r == closedRecipient {
-					s.recipients = append(s.recipients[:j], s.recipients[j+1:]...)
-					break
-				}
+			events, ok := s.eventsAfter(newRecipient.revision)
+			if ok && len(events) < newRecipient.queue.size {
+				logrus.Infof("%v - Resuming recipient from revision %v with %v events", s.eventFactory.FactoryName(), newRecipient.revision, len(events))
+			} else {
+				initialStateTransferEvent := s.eventFactory.NewEvent(context.Background(), EventTypeInitialStateTransfer, s.Entities())
+				initialStateTransferEvent.SetRevision(s.revision)
+				events = []Event{initialStateTransferEvent}
 			}
+			s.addRecipient(newRecipient.queue, events)
+		case closedRecipient := <-s.closedMonitorRecipientCh:
+			s.removeRecipient(func(queue *recipientQueue) bool {
+				return queue.recipient == closedRecipient
+			})
 		case event := <-s.eventCh:
 			logrus.Infof("%v-New event: %v", s.eventFactory.FactoryName(), event)
+			s.entitiesLock.Lock()
 			for _, entity := range event.Entities() {
 				if event.EventType() == EventTypeUpdate {
 					s.sendTrace(event, fmt.Sprintf("%v-send-update", s.eventFactory.FactoryName()))
@@ -117,11 +158,34 @@ func (s *server) Serve() {
 					delete(s.entities, entity.GetId())
 				}
 			}
+			s.entitiesLock.Unlock()
+			s.addToHistory(event)
 			s.SendAll(event)
 		}
 	}
 }
 
+func (s *server) addToHistory(event Event) {
+	s.revision++
+	event.SetRevision(s.revision)
+	if len(s.history) == historySize {
+		s.history = append(s.history[:0], s.history[1:]...)
+	}
+	s.history = append(s.history, event)
+}
+
+// eventsAfter returns events after revision, false if some of them are already trimmed from the history
+func (s *server) eventsAfter(revision uint64) ([]Event, bool) {
+	if revision == 0 || revision > s.revision {
+		return nil, false
+	}
+	first := s.revision - uint64(len(s.history)) + 1
+	if revision+1 < first {
+		return nil, false
+	}
+	return s.history[revision+1-first:], true
+}
+
 func (s *server) sendTrace(event Event, operation string) {
 	span := spanhelper.FromContext(event.Context(), operation)
 	defer span.Finish()
@@ -131,21 +195,55 @@ func (s *server) sendTrace(event Event, operation string) {
 	span.LogError(err)
 }
 
-// Entities returns server entities
+// Entities returns a copy of server entities
 func (s *server) Entities() map[string]Entity {
-	return s.entities
+	s.entitiesLock.RLock()
+	defer s.entitiesLock.RUnlock()
+
+	entities := make(map[string]Entity, len(s.entities))
+	for id, entity := range s.entities {
+		entities[id] = entity
+	}
+	return entities
 }
 
-func (s *server) send(event Event, recipients ...Recipient) {
+// send queues event to recipients, recipients falling too far behind are removed
+func (s *server) send(event Event, recipients ...*recipientQueue) {
 	for _, recipient := range recipients {
-		msg, err := event.Message()
-		logrus.Debugf("Try to send message %v", msg)
-		if err != nil {
-			logrus.Errorf("An error during conversion event: %v", err)
-			continue
+		if !recipient.push(event) {
+			dropped := recipient
+			s.removeRecipient(func(queue *recipientQueue) bool {
+				return queue == dropped
+			})
 		}
-		if err := recipient.SendMsg(msg); err != nil {
-			logrus.Errorf("An error during send: %v", err)
+	}
+}
+
+// addRecipient adds queue to recipients after its first events, so events sent to all recipients meanwhile are
+// queued after them
+func (s *server) addRecipient(queue *recipientQueue, events []Event) {
+	s.recipientsLock.Lock()
+	defer s.recipientsLock.Unlock()
+
+	for _, event := range events {
+		if !queue.push(event) {
+			return
+		}
+	}
+	s.recipients = append(s.recipients, queue)
+	recipientsCount.WithLabelValues(s.eventFactory.FactoryName()).Inc()
+}
+
+func (s *server) removeRecipient(matches func(queue *recipientQueue) bool) {
+	s.recipientsLock.Lock()
+	defer s.recipientsLock.Unlock()
+
+	for j, r := range s.recipients {
+		if matches(r) {
+			r.stop()
+			s.recipients = append(s.recipients[:j], s.recipients[j+1:]...)
+			recipientsCount.WithLabelValues(r.name).Dec()
+			return
 		}
 	}
 }
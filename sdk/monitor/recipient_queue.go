@@ -0,0 +1,204 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const (
+	defaultRecipientQueueSize = 1000
+	monitorLabel              = "monitor"
+)
+
+// RecipientQueueSizeEnv - max amount of events waiting to be sent to a recipient, a gRPC recipient falling behind
+// further is disconnected
+var RecipientQueueSizeEnv = utils.EnvVar("MONITOR_RECIPIENT_QUEUE_SIZE")
+
+var (
+	recipientsCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "monitor_recipients",
+		Help: "Recipients subscribed to monitor events",
+	}, []string{monitorLabel})
+	queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "monitor_recipient_queue_depth",
+		Help: "Events waiting to be sent to monitor recipients",
+	}, []string{monitorLabel})
+	coalescedEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_coalesced_events_total",
+		Help: "Events replaced by a newer update of the same entity before sent to a monitor recipient",
+	}, []string{monitorLabel})
+	droppedRecipients = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_dropped_recipients_total",
+		Help: "Monitor recipients disconnected for falling too far behind",
+	}, []string{monitorLabel})
+)
+
+// RegisterMetrics registers metrics of monitor recipients in registerer, metrics are tracked even if not registered
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{recipientsCount, queueDepth, coalescedEvents, droppedRecipients} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recipientQueue - sends events to a recipient on its own, so a slow recipient doesn't stall others
+type recipientQueue struct {
+	recipient Recipient
+	name      string
+	size      int
+	// droppable - recipient falling too far behind is disconnected, otherwise events keep being queued for it
+	droppable bool
+
+	lock    sync.Mutex
+	events  []Event
+	stopped bool
+	signal  chan struct{}
+	// dropped - closed if recipient is disconnected for falling too far behind
+	dropped chan struct{}
+	done    chan struct{}
+}
+
+func newRecipientQueue(name string, recipient Recipient, droppable bool) *recipientQueue {
+	q := &recipientQueue{
+		recipient: recipient,
+		name:      name,
+		size:      RecipientQueueSizeEnv.GetIntOrDefault(defaultRecipientQueueSize),
+		droppable: droppable,
+		signal:    make(chan struct{}, 1),
+		dropped:   make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+// push queues event replacing a queued update of the same entity, returns false if droppable recipient is too far
+// behind and is dropped
+func (q *recipientQueue) push(event Event) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.stopped {
+		return true
+	}
+	if i := q.coalescedIndex(event); i >= 0 {
+		q.events = append(q.events[:i], q.events[i+1:]...)
+		coalescedEvents.WithLabelValues(q.name).Inc()
+	} else if len(q.events) == q.size && q.droppable {
+		logrus.Errorf("%v - Recipient %v is %v events behind, dropping it", q.name, q.recipient, len(q.events))
+		droppedRecipients.WithLabelValues(q.name).Inc()
+		q.stopLocked()
+		close(q.dropped)
+		return false
+	} else {
+		if len(q.events) == q.size {
+			logrus.Warnf("%v - Recipient %v is %v events behind, keep queueing events for it", q.name, q.recipient, len(q.events))
+		}
+		queueDepth.WithLabelValues(q.name).Inc()
+	}
+	q.events = append(q.events, event)
+
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// coalescedIndex returns an index of queued update event superseded by event, -1 if there is no such event
+func (q *recipientQueue) coalescedIndex(event Event) int {
+	id, ok := singleEntityUpdate(event)
+	if !ok {
+		return -1
+	}
+	for i, queued := range q.events {
+		if queuedID, ok := singleEntityUpdate(queued); ok && queuedID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func singleEntityUpdate(event Event) (string, bool) {
+	if event.EventType() != EventTypeUpdate || len(event.Entities()) != 1 {
+		return "", false
+	}
+	for id := range event.Entities() {
+		return id, true
+	}
+	return "", false
+}
+
+func (q *recipientQueue) pop() Event {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.stopped || len(q.events) == 0 {
+		return nil
+	}
+	event := q.events[0]
+	q.events = q.events[1:]
+	queueDepth.WithLabelValues(q.name).Dec()
+	return event
+}
+
+func (q *recipientQueue) run() {
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-q.signal:
+		}
+		for event := q.pop(); event != nil; event = q.pop() {
+			msg, err := event.Message()
+			logrus.Debugf("Try to send message %v", msg)
+			if err != nil {
+				logrus.Errorf("An error during conversion event: %v", err)
+				continue
+			}
+			if err := q.recipient.SendMsg(msg); err != nil {
+				logrus.Errorf("An error during send: %v", err)
+			}
+		}
+	}
+}
+
+// stop stops sending events to recipient
+func (q *recipientQueue) stop() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.stopLocked()
+}
+
+func (q *recipientQueue) stopLocked() {
+	if q.stopped {
+		return
+	}
+	q.stopped = true
+	queueDepth.WithLabelValues(q.name).Sub(float64(len(q.events)))
+	q.events = nil
+	close(q.done)
+}
@@ -0,0 +1,134 @@
+package monitor
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type testEntity string
+
+func (e testEntity) GetId() string {
+	return string(e)
+}
+
+type testEvent struct {
+	BaseEvent
+	payload string
+}
+
+func (e *testEvent) Message() (interface{}, error) {
+	return e.payload, nil
+}
+
+func newTestEvent(eventType EventType, payload string, ids ...string) Event {
+	entities := map[string]Entity{}
+	for _, id := range ids {
+		entities[id] = testEntity(id)
+	}
+	return &testEvent{
+		BaseEvent: NewBaseEvent(context.Background(), eventType, entities),
+		payload:   payload,
+	}
+}
+
+// blockingRecipient - receives messages only when released
+type blockingRecipient struct {
+	entered  chan interface{}
+	released chan struct{}
+}
+
+func newBlockingRecipient() *blockingRecipient {
+	return &blockingRecipient{
+		entered:  make(chan interface{}, 100),
+		released: make(chan struct{}),
+	}
+}
+
+func (r *blockingRecipient) SendMsg(msg interface{}) error {
+	r.entered <- msg
+	<-r.released
+	return nil
+}
+
+func TestRecipientQueueCoalescesUpdates(t *testing.T) {
+	g := NewWithT(t)
+
+	recipient := newBlockingRecipient()
+	q := newRecipientQueue("test", recipient, true)
+	defer q.stop()
+
+	g.Expect(q.push(newTestEvent(EventTypeInitialStateTransfer, "initial"))).To(BeTrue())
+	g.Expect(<-recipient.entered).To(Equal("initial"))
+
+	g.Expect(q.push(newTestEvent(EventTypeUpdate, "a-1", "a"))).To(BeTrue())
+	g.Expect(q.push(newTestEvent(EventTypeUpdate, "b-1", "b"))).To(BeTrue())
+	g.Expect(q.push(newTestEvent(EventTypeUpdate, "a-2", "a"))).To(BeTrue())
+	g.Expect(q.push(newTestEvent(EventTypeDelete, "b-2", "b"))).To(BeTrue())
+	g.Expect(q.push(newTestEvent(EventTypeUpdate, "a-b", "a", "b"))).To(BeTrue())
+	close(recipient.released)
+
+	for _, expected := range []string{"b-1", "a-2", "b-2", "a-b"} {
+		g.Expect(<-recipient.entered).To(Equal(expected))
+	}
+}
+
+func TestRecipientQueueDropsSlowRecipient(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(RecipientQueueSizeEnv.Name(), "2")
+	defer func() {
+		_ = os.Unsetenv(RecipientQueueSizeEnv.Name())
+	}()
+
+	recipient := newBlockingRecipient()
+	q := newRecipientQueue("test", recipient, true)
+	defer close(recipient.released)
+
+	g.Expect(q.push(newTestEvent(EventTypeInitialStateTransfer, "initial"))).To(BeTrue())
+	g.Expect(<-recipient.entered).To(Equal("initial"))
+
+	g.Expect(q.push(newTestEvent(EventTypeUpdate, "a", "a"))).To(BeTrue())
+	g.Expect(q.push(newTestEvent(EventTypeUpdate, "b", "b"))).To(BeTrue())
+	g.Expect(q.dropped).NotTo(BeClosed())
+
+	g.Expect(q.push(newTestEvent(EventTypeUpdate, "c", "c"))).To(BeFalse())
+	g.Expect(q.dropped).To(BeClosed())
+}
+
+func TestRecipientQueueKeepsNotDroppableRecipient(t *testing.T) {
+	g := NewWithT(t)
+
+	_ = os.Setenv(RecipientQueueSizeEnv.Name(), "2")
+	defer func() {
+		_ = os.Unsetenv(RecipientQueueSizeEnv.Name())
+	}()
+
+	recipient := newBlockingRecipient()
+	q := newRecipientQueue("test", recipient, false)
+	defer q.stop()
+
+	g.Expect(q.push(newTestEvent(EventTypeInitialStateTransfer, "initial"))).To(BeTrue())
+	g.Expect(<-recipient.entered).To(Equal("initial"))
+
+	for _, id := range []string{"a", "b", "c"} {
+		g.Expect(q.push(newTestEvent(EventTypeUpdate, id, id))).To(BeTrue())
+	}
+	g.Expect(q.dropped).NotTo(BeClosed())
+	close(recipient.released)
+
+	for _, expected := range []string{"a", "b", "c"} {
+		g.Expect(<-recipient.entered).To(Equal(expected))
+	}
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := prometheus.NewRegistry()
+	g.Expect(RegisterMetrics(registry)).To(Succeed())
+	g.Expect(RegisterMetrics(registry)).NotTo(Succeed())
+}
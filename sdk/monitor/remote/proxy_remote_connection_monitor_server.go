@@ -54,7 +54,7 @@ func (s *proxyMonitorServer) MonitorConnections(selector *connection.MonitorScop
 
 	go s.monitorConnection(
 		ctx,
-		selector.GetPathSegments()[0].GetName(), remotePeerName, remotePeerURL,
+		selector.GetPathSegments()[0].GetName(), remotePeerName, remotePeerURL, selector.GetResumeFromRevision(),
 		s.handleRemoteConnection, filtered, quit)
 
 	select {
@@ -74,7 +74,7 @@ func (s *proxyMonitorServer) MonitorConnections(selector *connection.MonitorScop
 
 func (s *proxyMonitorServer) monitorConnection(
 	ctx context.Context,
-	name, remotePeerName, remotePeerURL string,
+	name, remotePeerName, remotePeerURL string, revision uint64,
 	entityHandler entityHandler, connectionServer connection.MonitorConnection_MonitorConnectionsServer,
 	quit chan error) {
 	logrus.Infof(proxyLogFormat, name, "Added")
@@ -97,6 +97,7 @@ func (s *proxyMonitorServer) monitorConnection(
 				Name: remotePeerName,
 			},
 		},
+		ResumeFromRevision: revision,
 	})
 	if err != nil {
 		logrus.Errorf(proxyLogWithParamFormat, name, "Failed to start monitor", err)
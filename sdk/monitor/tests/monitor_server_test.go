@@ -9,13 +9,14 @@ import (
 	"testing"
 	"time"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
+	monitor_types "github.com/networkservicemesh/networkservicemesh/sdk/monitor"
 	monitor_crossconnect "github.com/networkservicemesh/networkservicemesh/sdk/monitor/crossconnect"
 )
 
@@ -32,7 +33,7 @@ func startClient(g *WithT, target string, ids ...string) {
 
 	g.Expect(err).To(BeNil())
 	monitorClient := crossconnect.NewMonitorCrossConnectClient(conn)
-	stream, err := monitorClient.MonitorCrossConnects(context.Background(), &empty.Empty{})
+	stream, err := monitorClient.MonitorCrossConnects(context.Background(), &connection.MonitorScopeSelector{})
 	g.Expect(err).To(BeNil())
 	for {
 		select {
@@ -119,3 +120,239 @@ func TestSeveralRecipient(t *testing.T) {
 	wg.Wait()
 	logrus.Infof("######END")
 }
+
+func TestClientGetsOnlySelectedEvents(t *testing.T) {
+	g := NewWithT(t)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	defer listener.Close()
+	g.Expect(err).To(BeNil())
+
+	grpcServer := grpc.NewServer()
+	monitor := monitor_crossconnect.NewMonitorServer()
+	crossconnect.RegisterMonitorCrossConnectServer(grpcServer, monitor)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	for id, ns := range []string{"ns-a", "ns-b", "ns-a"} {
+		monitor.Update(context.Background(), &crossconnect.CrossConnect{
+			Id: fmt.Sprint(id),
+			Source: &connection.Connection{
+				Id:             fmt.Sprint(id),
+				NetworkService: ns,
+			},
+		})
+	}
+
+	_ = os.Setenv(tools.InsecureEnv, "true")
+	conn, err := tools.DialTCP(listenerAddress(listener))
+	g.Expect(err).To(BeNil())
+	defer conn.Close()
+
+	monitorClient := crossconnect.NewMonitorCrossConnectClient(conn)
+	stream, err := monitorClient.MonitorCrossConnects(context.Background(), &connection.MonitorScopeSelector{
+		NetworkService: "ns-a",
+	})
+	g.Expect(err).To(BeNil())
+
+	event, err := stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER))
+	g.Expect(event.CrossConnects).To(HaveLen(2))
+	g.Expect(event.CrossConnects).To(HaveKey("0"))
+	g.Expect(event.CrossConnects).To(HaveKey("2"))
+
+	monitor.HandleMetrics(map[string]*crossconnect.Metrics{
+		"SRC-0": {},
+		"SRC-1": {},
+	})
+	event, err = stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Metrics).To(HaveLen(1))
+	g.Expect(event.Metrics).To(HaveKey("SRC-0"))
+
+	// Events of not selected cross connects are not sent
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{
+		Id:     "1",
+		Source: &connection.Connection{Id: "1", NetworkService: "ns-b", Labels: map[string]string{"app": "b"}},
+	})
+	monitor.Delete(context.Background(), &crossconnect.CrossConnect{
+		Id:     "2",
+		Source: &connection.Connection{Id: "2", NetworkService: "ns-a"},
+	})
+	event, err = stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_DELETE))
+	g.Expect(event.CrossConnects).To(HaveKey("2"))
+
+	// Cross connects updated to not match the selector anymore are sent as deleted
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{
+		Id:     "0",
+		Source: &connection.Connection{Id: "0", NetworkService: "ns-b"},
+	})
+	event, err = stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_DELETE))
+	g.Expect(event.CrossConnects).To(HaveLen(1))
+	g.Expect(event.CrossConnects).To(HaveKey("0"))
+}
+
+func TestClientResumesFromRevision(t *testing.T) {
+	g := NewWithT(t)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	defer listener.Close()
+	g.Expect(err).To(BeNil())
+
+	grpcServer := grpc.NewServer()
+	monitor := monitor_crossconnect.NewMonitorServer()
+	crossconnect.RegisterMonitorCrossConnectServer(grpcServer, monitor)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{Id: "1"})
+
+	_ = os.Setenv(tools.InsecureEnv, "true")
+	conn, err := tools.DialTCP(listenerAddress(listener))
+	g.Expect(err).To(BeNil())
+	defer conn.Close()
+	monitorClient := crossconnect.NewMonitorCrossConnectClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := monitorClient.MonitorCrossConnects(ctx, &connection.MonitorScopeSelector{})
+	g.Expect(err).To(BeNil())
+	event, err := stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER))
+	revision := event.Revision
+	g.Expect(revision).NotTo(BeZero())
+	cancel()
+
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{Id: "2"})
+	monitor.Delete(context.Background(), &crossconnect.CrossConnect{Id: "1"})
+
+	stream, err = monitorClient.MonitorCrossConnects(context.Background(), &connection.MonitorScopeSelector{
+		ResumeFromRevision: revision,
+	})
+	g.Expect(err).To(BeNil())
+	event, err = stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_UPDATE))
+	g.Expect(event.CrossConnects).To(HaveKey("2"))
+	g.Expect(event.Revision).To(Equal(revision + 1))
+	event, err = stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_DELETE))
+	g.Expect(event.CrossConnects).To(HaveKey("1"))
+	g.Expect(event.Revision).To(Equal(revision + 2))
+
+	// Unknown revision falls back to a full state transfer
+	stream, err = monitorClient.MonitorCrossConnects(context.Background(), &connection.MonitorScopeSelector{
+		ResumeFromRevision: 1,
+	})
+	g.Expect(err).To(BeNil())
+	event, err = stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER))
+	g.Expect(event.CrossConnects).To(HaveLen(1))
+	g.Expect(event.CrossConnects).To(HaveKey("2"))
+	g.Expect(event.Revision).To(Equal(revision + 2))
+}
+
+func TestFilteredClientIsNotResumed(t *testing.T) {
+	g := NewWithT(t)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	defer listener.Close()
+	g.Expect(err).To(BeNil())
+
+	grpcServer := grpc.NewServer()
+	monitor := monitor_crossconnect.NewMonitorServer()
+	crossconnect.RegisterMonitorCrossConnectServer(grpcServer, monitor)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{
+		Id:     "1",
+		Source: &connection.Connection{Id: "1", NetworkService: "ns-a"},
+	})
+
+	_ = os.Setenv(tools.InsecureEnv, "true")
+	conn, err := tools.DialTCP(listenerAddress(listener))
+	g.Expect(err).To(BeNil())
+	defer conn.Close()
+	monitorClient := crossconnect.NewMonitorCrossConnectClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := monitorClient.MonitorCrossConnects(ctx, &connection.MonitorScopeSelector{
+		NetworkService: "ns-a",
+	})
+	g.Expect(err).To(BeNil())
+	event, err := stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER))
+	g.Expect(event.CrossConnects).To(HaveKey("1"))
+	revision := event.Revision
+	cancel()
+
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{
+		Id:     "1",
+		Source: &connection.Connection{Id: "1", NetworkService: "ns-b"},
+	})
+
+	// A resumed filter can't tell that "1" stopped matching, so it gets a full state instead
+	stream, err = monitorClient.MonitorCrossConnects(context.Background(), &connection.MonitorScopeSelector{
+		NetworkService:     "ns-a",
+		ResumeFromRevision: revision,
+	})
+	g.Expect(err).To(BeNil())
+	event, err = stream.Recv()
+	g.Expect(err).To(BeNil())
+	g.Expect(event.Type).To(Equal(crossconnect.CrossConnectEventType_INITIAL_STATE_TRANSFER))
+	g.Expect(event.CrossConnects).To(BeEmpty())
+	g.Expect(event.Revision).To(Equal(revision + 1))
+}
+
+func TestResumeMonitorClient(t *testing.T) {
+	g := NewWithT(t)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	defer listener.Close()
+	g.Expect(err).To(BeNil())
+
+	grpcServer := grpc.NewServer()
+	monitor := monitor_crossconnect.NewMonitorServer()
+	crossconnect.RegisterMonitorCrossConnectServer(grpcServer, monitor)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{Id: "1"})
+
+	_ = os.Setenv(tools.InsecureEnv, "true")
+	conn, err := tools.DialTCP(listenerAddress(listener))
+	g.Expect(err).To(BeNil())
+	defer conn.Close()
+
+	client, err := monitor_crossconnect.NewMonitorClient(conn)
+	g.Expect(err).To(BeNil())
+	event := <-client.EventChannel()
+	g.Expect(event.EventType()).To(Equal(monitor_types.EventTypeInitialStateTransfer))
+	revision := event.Revision()
+	g.Expect(revision).NotTo(BeZero())
+	client.Close()
+
+	monitor.Update(context.Background(), &crossconnect.CrossConnect{Id: "2"})
+
+	client, err = monitor_crossconnect.ResumeMonitorClient(conn, revision)
+	g.Expect(err).To(BeNil())
+	defer client.Close()
+	event = <-client.EventChannel()
+	g.Expect(event.EventType()).To(Equal(monitor_types.EventTypeUpdate))
+	g.Expect(event.Entities()).To(HaveLen(1))
+	g.Expect(event.Entities()).To(HaveKey("2"))
+	g.Expect(event.Revision()).To(Equal(revision + 1))
+}
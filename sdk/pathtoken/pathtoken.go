@@ -0,0 +1,181 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pathtoken - signs and verifies connection path segments with JWT tokens tied to SPIFFE identities.
+//
+// Every hop (client, NSM, NSE acting as a client) signs a segment naming it in an outgoing request on behalf of
+// a token of a previous hop, so a token of a segment carries a whole chain of identities up to an original client.
+// Expiration of a segment is a lease a connection is requested for, a hop refreshes it by requesting again before
+// the lease lapses.
+package pathtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
+	"github.com/networkservicemesh/networkservicemesh/pkg/security"
+	"github.com/networkservicemesh/networkservicemesh/utils"
+)
+
+const (
+	// TokenExpireEnv - environment variable name - lifetime of tokens path segments are signed with and leases
+	// connections are requested for
+	TokenExpireEnv = utils.EnvVar("PATH_TOKEN_EXPIRE")
+
+	defaultTokenExpire = 10 * time.Minute
+)
+
+type contextKeyType string
+
+const chainKey contextKeyType = "PathChain"
+
+type verifiedChain struct {
+	token  string
+	claims []*security.PathClaims
+}
+
+// Sign - sets expiration of path segment at path index and signs it with SVID of provider on behalf of obo token,
+// segment is not signed if provider is nil and is not signed on behalf of an invalid or expired obo token
+func Sign(ctx context.Context, provider security.Provider, path *connection.Path, obo string) error {
+	segment, err := currentSegment(path)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	expires := now.Add(Expire())
+	if segment.Expires, err = ptypes.TimestampProto(expires); err != nil {
+		return err
+	}
+	if provider == nil {
+		return nil
+	}
+	if obo != "" {
+		claims, err := security.ParseUnverified(obo)
+		if err == nil {
+			err = claims.Valid()
+		}
+		if err != nil {
+			return errors.Wrapf(err, "token of previous hop is not valid, path segment %s is not signed", segment.GetName())
+		}
+	}
+	cert, err := provider.GetCertificate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get SVID")
+	}
+
+	token, err := security.GenerateToken(cert, &security.PathClaims{
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expires.Unix(),
+		},
+		Obo:     obo,
+		Segment: segment.GetName(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to sign path segment %s", segment.GetName())
+	}
+	segment.Token = token
+	return nil
+}
+
+// Expire - returns configured lifetime of tokens and connection leases
+func Expire() time.Duration {
+	return TokenExpireEnv.GetOrDefaultDuration(defaultTokenExpire)
+}
+
+// Verify - verifies token of path segment at path index and all tokens it is signed on behalf of, returns claims
+// starting from an original client. Tokens should be signed for the path segments they are found at, tokens of hops
+// preceding the path are not checked.
+func Verify(ctx context.Context, provider security.Provider, path *connection.Path) ([]*security.PathClaims, error) {
+	segment, err := currentSegment(path)
+	if err != nil {
+		return nil, err
+	}
+	if segment.GetToken() == "" {
+		return nil, errors.Errorf("path segment %s is not signed", segment.GetName())
+	}
+	roots, err := provider.GetRoots(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get trust bundle")
+	}
+	chain, err := security.VerifyChain(segment.GetToken(), roots)
+	if err != nil {
+		return nil, errors.Wrapf(err, "path segment %s", segment.GetName())
+	}
+	segments := path.GetPathSegments()[:path.GetIndex()+1]
+	for i := 1; i <= len(chain) && i <= len(segments); i++ {
+		if name := segments[len(segments)-i].GetName(); chain[len(chain)-i].Segment != name {
+			return nil, errors.Errorf("path segment %s has a token of %s signed for %q", name, chain[len(chain)-i].Subject, chain[len(chain)-i].Segment)
+		}
+	}
+	return chain, nil
+}
+
+// Token - returns token of path segment at path index
+func Token(path *connection.Path) string {
+	segment, err := currentSegment(path)
+	if err != nil {
+		return ""
+	}
+	return segment.GetToken()
+}
+
+// WithChain - returns context with verified token of a previous hop and its claims chain
+func WithChain(parent context.Context, token string, chain []*security.PathClaims) context.Context {
+	return context.WithValue(parent, chainKey, &verifiedChain{
+		token:  token,
+		claims: chain,
+	})
+}
+
+// ChainToken - returns verified token of a previous hop to sign next hop on behalf of, empty if none
+func ChainToken(ctx context.Context) string {
+	if v, ok := ctx.Value(chainKey).(*verifiedChain); ok {
+		return v.token
+	}
+	return ""
+}
+
+// Chain - returns verified claims of hops starting from an original client, nil if request was not verified
+func Chain(ctx context.Context) []*security.PathClaims {
+	if v, ok := ctx.Value(chainKey).(*verifiedChain); ok {
+		return v.claims
+	}
+	return nil
+}
+
+// ClientIdentity - returns verified SPIFFE ID of an original client, empty if request was not verified
+func ClientIdentity(ctx context.Context) string {
+	if chain := Chain(ctx); len(chain) > 0 {
+		return chain[0].Subject
+	}
+	return ""
+}
+
+func currentSegment(path *connection.Path) (*connection.PathSegment, error) {
+	if len(path.GetPathSegments()) == 0 {
+		return nil, errors.New("path is empty")
+	}
+	if err := path.IsValid(); err != nil {
+		return nil, err
+	}
+	return path.GetCurrentPathSegment(), nil
+}
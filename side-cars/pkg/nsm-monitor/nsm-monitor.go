@@ -17,6 +17,7 @@ package nsmmonitor
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools/jaeger"
@@ -29,6 +30,7 @@ import (
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/networkservice"
 	"github.com/networkservicemesh/networkservicemesh/sdk/client"
 	"github.com/networkservicemesh/networkservicemesh/sdk/common"
+	"github.com/networkservicemesh/networkservicemesh/sdk/pathtoken"
 )
 
 const (
@@ -36,6 +38,8 @@ const (
 	nsmMonitorLogWithParamFormat = "NSM Monitor: %v: %v"
 
 	nsmMonitorRetryDelay = 5 * time.Second
+
+	nsmMonitorLeaseCheckInterval = time.Second
 )
 
 // Handler - handler to perform configuration of monitoring app
@@ -84,6 +88,10 @@ type nsmMonitorApp struct {
 	initRecieved  bool
 	recovery      bool
 	configuration *common.NSConfiguration
+
+	// leases are connections of the workspace to refresh, since init container is not running to refresh them
+	leasesLock sync.Mutex
+	leases     map[string]*connection.Connection
 }
 
 func (c *nsmMonitorApp) Stop() {
@@ -109,6 +117,7 @@ func NewNSMMonitorApp(configuration *common.NSConfiguration) App {
 	return &nsmMonitorApp{
 		connections:   map[string]*connection.Connection{},
 		configuration: configuration,
+		leases:        map[string]*connection.Connection{},
 	}
 }
 
@@ -139,6 +148,9 @@ func (c *nsmMonitorApp) beginMonitoring() {
 		c.cancelFunc = cancelFunc
 		defer cancelFunc()
 
+		refreshCtx, cancelRefresh := context.WithCancel(ctx)
+		go c.refreshLeases(refreshCtx, nsmClient)
+
 		for {
 			if c.initRecieved && !c.recovery {
 				// Performing recovery if required.
@@ -159,6 +171,7 @@ func (c *nsmMonitorApp) beginMonitoring() {
 			}
 		}
 
+		cancelRefresh()
 		// Close current NSM client connection.
 		if err := nsmClient.Destroy(context.Background()); err != nil {
 			logrus.Errorf("failed to close NSM client connection")
@@ -173,6 +186,7 @@ func (c *nsmMonitorApp) readEvents(monitorClient connection.MonitorConnection_Mo
 		for _, c := range c.connections {
 			c.State = connection.State_DOWN // Mark all as down.
 		}
+		c.clearLeases()
 		return false
 	}
 	if event.Type == connection.ConnectionEventType_INITIAL_STATE_TRANSFER {
@@ -186,6 +200,7 @@ func (c *nsmMonitorApp) readEvents(monitorClient connection.MonitorConnection_Mo
 			c.updateConnection(conn)
 		case connection.ConnectionEventType_DELETE:
 			logrus.Infof(nsmMonitorLogFormat, "Connection closed")
+			c.removeLease(conn.GetId())
 			if c.helper != nil {
 				c.helper.Closed(conn)
 			}
@@ -195,7 +210,12 @@ func (c *nsmMonitorApp) readEvents(monitorClient connection.MonitorConnection_Mo
 }
 
 func (c *nsmMonitorApp) updateConnection(conn *connection.Connection) {
+	c.storeLease(conn)
 	if existingConn, exists := c.connections[conn.GetId()]; exists {
+		if isLeaseRefreshed(existingConn, conn) {
+			c.connections[conn.GetId()] = conn
+			return
+		}
 		logrus.Infof(nsmMonitorLogWithParamFormat, "Connection updated", fmt.Sprintf("%v %v", existingConn, conn))
 		c.helper.Updated(existingConn, conn)
 	} else {
@@ -204,6 +224,69 @@ func (c *nsmMonitorApp) updateConnection(conn *connection.Connection) {
 	c.connections[conn.GetId()] = conn
 }
 
+// isLeaseRefreshed returns if connection is updated only with a refreshed lease
+func isLeaseRefreshed(old, new *connection.Connection) bool {
+	old, new = old.Clone(), new.Clone()
+	old.Path, new.Path = nil, nil
+	return old.Equals(new)
+}
+
+func (c *nsmMonitorApp) storeLease(conn *connection.Connection) {
+	c.leasesLock.Lock()
+	defer c.leasesLock.Unlock()
+	if _, ok := conn.GetPath().GetExpireTime(); ok && conn.GetState() == connection.State_UP {
+		c.leases[conn.GetId()] = conn.Clone()
+	} else {
+		delete(c.leases, conn.GetId())
+	}
+}
+
+func (c *nsmMonitorApp) removeLease(id string) {
+	c.leasesLock.Lock()
+	defer c.leasesLock.Unlock()
+	delete(c.leases, id)
+}
+
+func (c *nsmMonitorApp) clearLeases() {
+	c.leasesLock.Lock()
+	defer c.leasesLock.Unlock()
+	c.leases = map[string]*connection.Connection{}
+}
+
+// expiringLeases returns connections with less than a third of a lease left
+func (c *nsmMonitorApp) expiringLeases() []*connection.Connection {
+	c.leasesLock.Lock()
+	defer c.leasesLock.Unlock()
+	var result []*connection.Connection
+	for _, conn := range c.leases {
+		if expires, ok := conn.GetPath().GetExpireTime(); ok && time.Until(expires) < pathtoken.Expire()/3 {
+			result = append(result, conn.Clone())
+		}
+	}
+	return result
+}
+
+// refreshLeases refreshes connections of the workspace before their leases lapse
+func (c *nsmMonitorApp) refreshLeases(ctx context.Context, nsmClient *client.NsmClient) {
+	ticker := time.NewTicker(nsmMonitorLeaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, conn := range c.expiringLeases() {
+				refreshed, err := nsmClient.Refresh(ctx, conn)
+				if err != nil {
+					logrus.Errorf(nsmMonitorLogWithParamFormat, "failed to refresh connection", err)
+					continue
+				}
+				c.storeLease(refreshed)
+			}
+		}
+	}
+}
+
 func (c *nsmMonitorApp) waitRetry() {
 	logrus.Errorf(nsmMonitorLogWithParamFormat, "Retry delay", nsmMonitorRetryDelay)
 	<-time.After(nsmMonitorRetryDelay)
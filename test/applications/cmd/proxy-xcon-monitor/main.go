@@ -5,10 +5,10 @@ import (
 	"flag"
 	"net"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
+	"github.com/networkservicemesh/networkservicemesh/controlplane/api/connection"
 	"github.com/networkservicemesh/networkservicemesh/controlplane/api/crossconnect"
 	"github.com/networkservicemesh/networkservicemesh/pkg/tools"
 	"github.com/networkservicemesh/networkservicemesh/utils"
@@ -29,7 +29,7 @@ type proxyMonitor struct {
 	address string
 }
 
-func (p *proxyMonitor) MonitorCrossConnects(empty *empty.Empty, src crossconnect.MonitorCrossConnect_MonitorCrossConnectsServer) error {
+func (p *proxyMonitor) MonitorCrossConnects(selector *connection.MonitorScopeSelector, src crossconnect.MonitorCrossConnect_MonitorCrossConnectsServer) error {
 	logrus.Infof("MonitorCrossConnects called, address - %v", p.address)
 
 	conn, err := tools.DialTCP(p.address)
@@ -44,7 +44,7 @@ func (p *proxyMonitor) MonitorCrossConnects(empty *empty.Empty, src crossconnect
 	dstCtx, dstCancel := context.WithCancel(src.Context())
 	defer dstCancel()
 
-	dst, err := monitorClient.MonitorCrossConnects(dstCtx, empty)
+	dst, err := monitorClient.MonitorCrossConnects(dstCtx, selector)
 	if err != nil {
 		logrus.Error(err)
 		return err
@@ -12,7 +12,6 @@ import (
 
 	"github.com/pkg/errors"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -261,7 +260,7 @@ func CreateCrossConnectClient(k8s *K8s, address string) (MonitorClient, func(),
 
 	monitorClient := crossconnect.NewMonitorCrossConnectClient(conn)
 	ctx, cancel := context.WithCancel(context.Background())
-	stream, err := monitorClient.MonitorCrossConnects(ctx, &empty.Empty{})
+	stream, err := monitorClient.MonitorCrossConnects(ctx, &connection.MonitorScopeSelector{})
 	if err != nil {
 		k8s.g.Expect(err).To(BeNil())
 		cancel()
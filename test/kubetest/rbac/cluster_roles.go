@@ -139,6 +139,16 @@ func CreateRoleAdmin(name, namespace string) Role {
 					Resources: []string{"nodes", "services", "namespaces"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods"},
+					Verbs:     []string{"get"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     []string{"create", "patch"},
+				},
 			},
 		},
 	}